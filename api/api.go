@@ -1,12 +1,15 @@
 package api
 
 import (
+	"context"
 	"github.com/davidji99/terraform-provider-herokux/api/connect"
 	"github.com/davidji99/terraform-provider-herokux/api/data"
 	"github.com/davidji99/terraform-provider-herokux/api/kafka"
 	"github.com/davidji99/terraform-provider-herokux/api/kolkrabbi"
 	"github.com/davidji99/terraform-provider-herokux/api/metrics"
 	config2 "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/retry"
 	"github.com/davidji99/terraform-provider-herokux/api/platform"
 	"github.com/davidji99/terraform-provider-herokux/api/postgres"
 	"github.com/davidji99/terraform-provider-herokux/api/redis"
@@ -33,10 +36,10 @@ const (
 	// DefaultConnectCentralBaseURL is the default base URL for Connect Central API.
 	DefaultConnectCentralBaseURL = "https://hc-central.heroku.com"
 
-	// DefaultConnectAPIBaseURL is the default base URL for the Connect APIs.
-	// Setting to the 3-virginia endpoint by default.
+	// DefaultConnectAPIBaseURL is the 3-virginia base URL for the Connect APIs. By default, the regional URL
+	// of a connection is retrieved from Connect Central instead.
 	// Reference: https://devcenter.heroku.com/articles/heroku-connect-api#endpoints
-	DefaultConnectAPIBaseURL = "https://connect-3-virginia.heroku.com/api/v3"
+	DefaultConnectAPIBaseURL = "https://connect-3-virginia.heroku.com"
 
 	// DefaultRegistryBaseURL is the default base URL for the Heroku Registry.
 	DefaultRegistryBaseURL = "https://registry.heroku.com"
@@ -65,20 +68,24 @@ var (
 )
 
 // Client manages communication with various Heroku APIs.
+//
+// A Client is safe for concurrent use by multiple goroutines. Request specific headers are set on each request
+// instead of on the underlying HTTP clients shared by all requests, and Connect requests take the regional base URL
+// of their connection instead of changing a shared one.
 type Client struct {
 	config *config2.Config
 
-	// API endpoints
-	Connect   *connect.Connect
-	Data      *data.Data
-	Kafka     *kafka.Kafka
-	Kolkrabbi *kolkrabbi.Kolkrabbi
-	Metrics   *metrics.Metrics
-	Platform  *platform.Platform
-	Postgres  *postgres.Postgres
-	Redis     *redis.Redis
-	Registry  *registry.Registry
-	Scheduler *scheduler.Scheduler
+	// API endpoints. Each can be replaced with a mock from the mock package in unit tests.
+	Connect   ConnectService
+	Data      DataService
+	Kafka     KafkaService
+	Kolkrabbi KolkrabbiService
+	Metrics   MetricsService
+	Platform  PlatformService
+	Postgres  PostgresService
+	Redis     RedisService
+	Registry  RegistryService
+	Scheduler SchedulerService
 }
 
 // New constructs a new client to interact with Heroku APIs.
@@ -91,7 +98,6 @@ func New(opts ...config2.Option) (*Client, error) {
 		DataBaseURL:           DefaultDataAPIBaseURL,
 		PlatformBaseURL:       DefaultPlatformAPIBaseURL,
 		RedisBaseURL:          DefaultRedisAPIBaseURL,
-		ConnectBaseURL:        DefaultConnectAPIBaseURL,
 		ConnectCentralBaseURL: DefaultConnectCentralBaseURL,
 		RegistryBaseURL:       DefaultRegistryBaseURL,
 		KolkrabbiURL:          DefaultKolkrabbiAPIBaseURL,
@@ -101,6 +107,9 @@ func New(opts ...config2.Option) (*Client, error) {
 		BasicAuth:             "",
 		ContentTypeHeader:     DefaultContentTypeHeader,
 		AcceptHeader:          DefaultAcceptHeader,
+		RetryPolicy:           retry.DefaultPolicy(),
+		Tracer:                config2.NoopTracer{},
+		Meter:                 config2.NoopMeter{},
 	}
 
 	// Define any user custom Client settings
@@ -125,3 +134,9 @@ func New(opts ...config2.Option) (*Client, error) {
 
 	return client, nil
 }
+
+// WithRequestHeaders returns a copy of ctx that adds the given headers to every request made with it.
+// These headers override the client wide headers, including CustomHTTPHeaders, for those requests only.
+func WithRequestHeaders(ctx context.Context, headers map[string]string) context.Context {
+	return rest.ContextWithHeaders(ctx, headers)
+}
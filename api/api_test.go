@@ -0,0 +1,267 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/platform"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestClient_ConcurrentRequestHeaders issues requests that need different Accept headers from many goroutines
+// and verifies every request reaches the server with its own headers. Run with -race to detect shared state.
+func TestClient_ConcurrentRequestHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := platform.DefaultAcceptHeader
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/webhooks"):
+			want = platform.WebhooksAcceptHeader
+		case strings.HasSuffix(r.URL.Path, "/formation"):
+			want = platform.DockerReleasesAcceptHeader
+		}
+
+		if got := r.Header.Get("Accept"); got != want {
+			http.Error(w, fmt.Sprintf("unexpected Accept header %q", got), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasPrefix(r.URL.Path, "/data/kafka/") {
+			cluster := strings.Split(r.URL.Path, "/")[5]
+			if got := r.Header.Get("X-Request-Scope"); got != cluster {
+				http.Error(w, fmt.Sprintf("unexpected X-Request-Scope header %q", got), http.StatusBadRequest)
+				return
+			}
+
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, err := New(config.PlatformBaseURL(server.URL), config.KafkaBaseURL(server.URL), config.APIToken("token"))
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 300)
+
+	for i := 0; i < 100; i++ {
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+			_, _, err := client.Platform.ListAppWebhooks(context.Background(), "app")
+			errs <- err
+		}()
+
+		go func() {
+			defer wg.Done()
+			_, _, err := client.Platform.FormationContainerBatchUpdate(context.Background(), "app",
+				&platform.FormationDockerBatchUpdateOpts{})
+			errs <- err
+		}()
+
+		go func(i int) {
+			defer wg.Done()
+			cluster := fmt.Sprintf("cluster-%d", i)
+			ctx := WithRequestHeaders(context.Background(), map[string]string{"X-Request-Scope": cluster})
+			_, _, err := client.Kafka.ListTopics(ctx, cluster)
+			errs <- err
+		}(i)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.Nil(t, err)
+	}
+}
+
+// TestClient_ConcurrentConnectRegions issues Connect requests for two connections in different regions
+// from many goroutines and verifies every request reaches the region of its connection.
+func TestClient_ConcurrentConnectRegions(t *testing.T) {
+	newRegion := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if want := "/api/v3/mappings/mapping-" + name; r.URL.Path != want {
+				http.Error(w, fmt.Sprintf("unexpected path %q in region %s", r.URL.Path, name), http.StatusBadRequest)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(fmt.Sprintf(`{"id":"mapping-%s"}`, name)))
+		}))
+	}
+
+	virginia := newRegion("virginia")
+	defer virginia.Close()
+
+	dublin := newRegion("dublin")
+	defer dublin.Close()
+
+	central := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"connections":[{"id":"virginia","region_url":%q},{"id":"dublin","region_url":%q}]}`,
+			virginia.URL, dublin.URL)))
+	}))
+	defer central.Close()
+
+	client, err := New(config.ConnectCentralBaseURL(central.URL), config.APIToken("token"))
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 200)
+
+	for i := 0; i < 100; i++ {
+		for _, region := range []string{"virginia", "dublin"} {
+			wg.Add(1)
+
+			go func(region string) {
+				defer wg.Done()
+
+				baseURL, err := client.Connect.RegionalBaseURL(context.Background(), "app", region)
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				mapping, _, err := client.Connect.GetMapping(context.Background(), baseURL, "mapping-"+region)
+				if err == nil && mapping.GetID() != "mapping-"+region {
+					err = fmt.Errorf("unexpected mapping %s for region %s", mapping.GetID(), region)
+				}
+				errs <- err
+			}(region)
+		}
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.Nil(t, err)
+	}
+}
+
+func TestClient_ConnectBaseURLOverrideSkipsCentral(t *testing.T) {
+	var centralCalls int32
+	central := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&centralCalls, 1)
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer central.Close()
+
+	client, err := New(config.ConnectCentralBaseURL(central.URL), config.ConnectBaseURL("https://connect.example.com"),
+		config.APIToken("token"))
+	assert.Nil(t, err)
+
+	baseURL, err := client.Connect.RegionalBaseURL(context.Background(), "app", "connection")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://connect.example.com", baseURL)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&centralCalls))
+}
+
+type recordingTransport struct {
+	mu    sync.Mutex
+	hosts []string
+}
+
+func (rt *recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rt.mu.Lock()
+	rt.hosts = append(rt.hosts, r.URL.Host)
+	rt.mu.Unlock()
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`[]`)),
+		Request:    r,
+	}, nil
+}
+
+func TestClient_Transport(t *testing.T) {
+	rt := &recordingTransport{}
+
+	client, err := New(config.Transport(rt), config.MetricsBaseURL("https://metrics.test"),
+		config.PostgresBaseURL("https://postgres.test"))
+	assert.Nil(t, err)
+
+	_, _, err = client.Postgres.ListCredentials(context.Background(), "db")
+	assert.Nil(t, err)
+
+	_, _, err = client.Metrics.ListMonitors(context.Background(), "app", "web")
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"postgres.test", "metrics.test"}, rt.hosts)
+}
+
+func TestClient_ServiceTimeout(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(config.PostgresBaseURL(server.URL), config.MaxRetries(0),
+		config.ServiceTimeout(config.ServicePostgres, 50*time.Millisecond))
+	assert.Nil(t, err)
+
+	start := time.Now()
+	_, _, err = client.Postgres.ListCredentials(context.Background(), "db")
+	assert.NotNil(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+type testSpan struct {
+	config.NoopSpan
+	name  string
+	attrs map[string]interface{}
+}
+
+type testTracer struct {
+	mu    sync.Mutex
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, attrs map[string]interface{}) (context.Context, config.Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	span := &testSpan{name: name, attrs: attrs}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func TestClient_TracesOperations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	tracer := &testTracer{}
+	client, err := New(config.KafkaBaseURL(server.URL), config.APIToken("token"), config.RequestTracer(tracer))
+	assert.Nil(t, err)
+
+	_, _, err = client.Kafka.Get(context.Background(), "cluster")
+	assert.Nil(t, err)
+
+	assert.Len(t, tracer.spans, 1)
+	assert.Equal(t, "Kafka.Get", tracer.spans[0].name)
+	assert.Equal(t, "kafka", tracer.spans[0].attrs[config.AttributeService])
+}
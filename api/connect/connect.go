@@ -1,60 +1,70 @@
 package connect
 
 import (
+	"context"
 	"fmt"
-	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
 	config2 "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"time"
 )
 
 // Connect represents Heroku's Connect APIs.
 type Connect struct {
-	http   *simpleresty.Client
+	http   *rest.Client
 	config *config2.Config
 }
 
 // New constructs a client to interface with the Heroku Connect APIs.
 func New(config *config2.Config) *Connect {
-	c := &Connect{http: simpleresty.New(), config: config}
+	c := &Connect{http: rest.NewWithConfig(config.ConnectBaseURL, config), config: config}
 	c.setHeaders()
 	return c
 }
 
-// SetRootAPIBaseURL determines and sets the client's base URL based on the specified appID & connectID arguments.
+// RegionalBaseURL returns the base URL of the Connect APIs in the region of the specified connection.
+// If the Config's ConnectBaseURL is set, it is returned instead of the connection's regional URL
+// without calling Connect Central.
 //
-// This is required.
-func (c *Connect) SetRootAPIBaseURL(appID, connectID string) error {
-	c.setHeaders()
-	c.http.SetBaseURL(c.config.ConnectCentralBaseURL)
+// The other Connect methods take this base URL, so requests for connections in different regions
+// can be made concurrently with the same client.
+func (c *Connect) RegionalBaseURL(ctx context.Context, appID, connectID string) (string, error) {
+	// An overridden base URL is used for every connection, so Connect Central doesn't need to be called.
+	if c.config.ConnectBaseURL != "" {
+		return c.config.ConnectBaseURL, nil
+	}
 
 	var result *AuthResponse
-	urlStr := c.http.RequestURL("/auth/%s", appID)
+	urlStr := c.config.ConnectCentralBaseURL + fmt.Sprintf("/auth/%s", appID)
 
 	// Execute the request
-	_, postErr := c.http.Post(urlStr, &result, nil)
+	_, postErr := c.http.Post(ctx, urlStr, &result, nil)
 	if postErr != nil {
-		return postErr
+		return "", postErr
 	}
 
 	// Loop through all connections and find the specified one by its ID.
 	for _, connection := range result.Connections {
 		if connection.GetID() == connectID {
-			// Then when the target connection is found, set the client's base URL to the region URL.
-			c.http.SetBaseURL(connection.GetRegionURL())
-			return nil
+			return connection.GetRegionURL(), nil
 		}
 	}
 
-	return fmt.Errorf("did not find connection %s on app %s", connectID, appID)
+	return "", apierror.NewNotFound("did not find connection %s on app %s", connectID, appID)
+}
+
+// requestURL appends the template argument to the regional base URL and returns the full request URL.
+func requestURL(baseURL, template string, args ...interface{}) string {
+	return baseURL + fmt.Sprintf(template, args...)
 }
 
 func (c *Connect) setHeaders() {
 	c.http.SetHeader("Content-type", c.config.ContentTypeHeader).
 		SetHeader("Accept", c.config.ContentTypeHeader).
 		SetHeader("User-Agent", c.config.UserAgent).
-		SetHeader("Authorization", fmt.Sprintf("Bearer %s", c.config.APIToken)).
-		SetTimeout(2 * time.Minute).
+		SetTimeout(c.config.Timeout(config2.ServiceConnect, 2*time.Minute)).
 		SetAllowGetMethodPayload(true)
+	c.http.SetAuthorizer(rest.BearerAuthorizer(c.config)).SetService(config2.ServiceConnect)
 
 	// Set additional headers
 	if c.config.CustomHTTPHeaders != nil {
@@ -1,7 +1,7 @@
 package connect
 
 import (
-	"fmt"
+	"context"
 	"github.com/davidji99/simpleresty"
 	"time"
 )
@@ -128,15 +128,15 @@ type ConnectionGetQueryParams struct {
 }
 
 // GetConnection retrieves information about a connection.
-func (c *Connect) GetConnection(connectionID string, params ConnectionGetQueryParams) (*Connection, *simpleresty.Response, error) {
+func (c *Connect) GetConnection(ctx context.Context, baseURL, connectionID string, params ConnectionGetQueryParams) (*Connection, *simpleresty.Response, error) {
 	var result *Connection
-	urlStr, urlStrErr := c.http.RequestURLWithQueryParams(fmt.Sprintf("/api/v3/connections/%s", connectionID), params)
+	urlStr, urlStrErr := simpleresty.AddQueryParams(requestURL(baseURL, "/api/v3/connections/%s", connectionID), params)
 	if urlStrErr != nil {
 		return nil, nil, urlStrErr
 	}
 
 	// Execute the request
-	response, updateErr := c.http.Get(urlStr, &result, nil)
+	response, updateErr := c.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, updateErr
 }
@@ -144,12 +144,12 @@ func (c *Connect) GetConnection(connectionID string, params ConnectionGetQueryPa
 // ConfigureSettings updates a Heroku Connect connection.
 //
 // Reference: https://devcenter.heroku.com/articles/heroku-connect-api#step-4-configure-the-database-key-and-schema-for-the-connection
-func (c *Connect) ConfigureSettings(connectionID string, opts *ConnectionUpdateRequest) (*Connection, *simpleresty.Response, error) {
+func (c *Connect) ConfigureSettings(ctx context.Context, baseURL, connectionID string, opts *ConnectionUpdateRequest) (*Connection, *simpleresty.Response, error) {
 	var result *Connection
-	urlStr := c.http.RequestURL("/api/v3/connections/%s", connectionID)
+	urlStr := requestURL(baseURL, "/api/v3/connections/%s", connectionID)
 
 	// Execute the request
-	response, updateErr := c.http.Patch(urlStr, &result, opts)
+	response, updateErr := c.http.Patch(ctx, urlStr, &result, opts)
 
 	return result, response, updateErr
 }
@@ -1,6 +1,7 @@
 package connect
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -82,45 +83,45 @@ type MappingsExport struct {
 }
 
 // GetMapping retrieves information about a connection mapping.
-func (c *Connect) GetMapping(mappingID string) (*Mapping, *simpleresty.Response, error) {
+func (c *Connect) GetMapping(ctx context.Context, baseURL, mappingID string) (*Mapping, *simpleresty.Response, error) {
 	var result *Mapping
-	urlStr := c.http.RequestURL("/api/v3/mappings/%s", mappingID)
+	urlStr := requestURL(baseURL, "/api/v3/mappings/%s", mappingID)
 
 	// Execute the request
-	response, updateErr := c.http.Get(urlStr, &result, nil)
+	response, updateErr := c.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, updateErr
 }
 
 // ImportMappings takes a JSON file path and creates mappings from it.
-func (c *Connect) ImportMappings(connectID string, mappings []byte) (*simpleresty.Response, error) {
-	urlStr := c.http.RequestURL("/api/v3/connections/%s/actions/import", connectID)
+func (c *Connect) ImportMappings(ctx context.Context, baseURL, connectID string, mappings []byte) (*simpleresty.Response, error) {
+	urlStr := requestURL(baseURL, "/api/v3/connections/%s/actions/import", connectID)
 
 	// Execute the request
-	response, updateErr := c.http.Post(urlStr, nil, mappings)
+	response, updateErr := c.http.Post(ctx, urlStr, nil, mappings)
 
 	return response, updateErr
 }
 
 // ExportMappings exports mappings for an existing connection.
-func (c *Connect) ExportMappings(connectID string) (*MappingExportOutput, *simpleresty.Response, error) {
+func (c *Connect) ExportMappings(ctx context.Context, baseURL, connectID string) (*MappingExportOutput, *simpleresty.Response, error) {
 	var result *MappingExportOutput
-	urlStr := c.http.RequestURL("/api/v3/connections/%s/actions/export", connectID)
+	urlStr := requestURL(baseURL, "/api/v3/connections/%s/actions/export", connectID)
 
 	// Execute the request.
 	// Result is a map[string]interface{}.
-	response, updateErr := c.http.Get(urlStr, &result, nil)
+	response, updateErr := c.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, updateErr
 }
 
 // DeleteMapping deletes a connection mapping.
-func (c *Connect) DeleteMapping(mappingID string) (*simpleresty.Response, error) {
+func (c *Connect) DeleteMapping(ctx context.Context, baseURL, mappingID string) (*simpleresty.Response, error) {
 	var result *Mapping
-	urlStr := c.http.RequestURL("/api/v3/mappings/%s", mappingID)
+	urlStr := requestURL(baseURL, "/api/v3/mappings/%s", mappingID)
 
 	// Execute the request
-	response, updateErr := c.http.Delete(urlStr, &result, nil)
+	response, updateErr := c.http.Delete(ctx, urlStr, &result, nil)
 
 	return response, updateErr
 }
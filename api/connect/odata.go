@@ -1,6 +1,9 @@
 package connect
 
-import "github.com/davidji99/simpleresty"
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+)
 
 // ConnectionCredential represents credential for a Heroku Connect connection.
 type ConnectionCredential struct {
@@ -33,9 +36,9 @@ type ConnectionCredentialSecrets struct {
 }
 
 // CreateCredential creates a user/password for accessing your shared data sources on a connection.
-func (c *Connect) CreateCredential(odataID string) (*ConnectionCredential, *simpleresty.Response, error) {
+func (c *Connect) CreateCredential(ctx context.Context, baseURL, odataID string) (*ConnectionCredential, *simpleresty.Response, error) {
 	var result *ConnectionCredential
-	urlStr := c.http.RequestURL("/api/v3/odata/services/%s", odataID)
+	urlStr := requestURL(baseURL, "/api/v3/odata/services/%s", odataID)
 
 	opts := struct {
 		Enabled bool `json:"enabled"`
@@ -44,15 +47,15 @@ func (c *Connect) CreateCredential(odataID string) (*ConnectionCredential, *simp
 	}
 
 	// Execute the request
-	response, updateErr := c.http.Patch(urlStr, &result, opts)
+	response, updateErr := c.http.Patch(ctx, urlStr, &result, opts)
 
 	return result, response, updateErr
 }
 
 // RevokeCredential revokes (invalidates) a user/password for accessing your shared data sources on a connection.
-func (c *Connect) RevokeCredential(odataID string) (*ConnectionCredential, *simpleresty.Response, error) {
+func (c *Connect) RevokeCredential(ctx context.Context, baseURL, odataID string) (*ConnectionCredential, *simpleresty.Response, error) {
 	var result *ConnectionCredential
-	urlStr := c.http.RequestURL("/api/v3/odata/services/%s", odataID)
+	urlStr := requestURL(baseURL, "/api/v3/odata/services/%s", odataID)
 
 	opts := struct {
 		Enabled bool `json:"enabled"`
@@ -61,7 +64,7 @@ func (c *Connect) RevokeCredential(odataID string) (*ConnectionCredential, *simp
 	}
 
 	// Execute the request
-	response, updateErr := c.http.Patch(urlStr, &result, opts)
+	response, updateErr := c.http.Patch(ctx, urlStr, &result, opts)
 
 	return result, response, updateErr
 }
@@ -1,22 +1,24 @@
 package data
 
 import (
-	"fmt"
-	"github.com/davidji99/simpleresty"
 	config2 "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/graphql"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"time"
 )
 
 // Data represents Heroku's Data APIs.
 type Data struct {
-	http   *simpleresty.Client
-	config *config2.Config
+	http    *rest.Client
+	graphql *graphql.Client
+	config  *config2.Config
 }
 
 // New constructs a client to interface with the Heroku Data APIs.
 func New(config *config2.Config) *Data {
-	d := &Data{http: simpleresty.NewWithBaseURL(config.DataBaseURL), config: config}
+	d := &Data{http: rest.NewWithConfig(config.DataBaseURL, config), config: config}
 	d.setHeaders()
+	d.graphql = graphql.NewClient(d.http, "/graphql")
 
 	return d
 }
@@ -25,9 +27,9 @@ func (d *Data) setHeaders() {
 	d.http.SetHeader("Content-type", d.config.ContentTypeHeader).
 		SetHeader("Accept", d.config.AcceptHeader).
 		SetHeader("User-Agent", d.config.UserAgent).
-		SetHeader("Authorization", fmt.Sprintf("Bearer %s", d.config.APIToken)).
-		SetTimeout(2 * time.Minute).
+		SetTimeout(d.config.Timeout(config2.ServiceData, 2*time.Minute)).
 		SetAllowGetMethodPayload(true)
+	d.http.SetAuthorizer(rest.BearerAuthorizer(d.config)).SetService(config2.ServiceData)
 
 	// Set additional headers
 	if d.config.CustomHTTPHeaders != nil {
@@ -1,10 +1,9 @@
 package data
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"github.com/davidji99/simpleresty"
-	"github.com/davidji99/terraform-provider-herokux/api/pkg/graphql"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
 	"github.com/davidji99/terraform-provider-herokux/api/platform"
 	"time"
 )
@@ -82,23 +81,16 @@ type postgresDataclipsListResponse struct {
 }
 
 // ListPostgresDataclips returns all dataclips that the authenticated user has access to in Heroku.
-func (d *Data) ListPostgresDataclips() ([]*PostgresDataclip, *simpleresty.Response, error) {
+func (d *Data) ListPostgresDataclips(ctx context.Context) ([]*PostgresDataclip, *simpleresty.Response, error) {
 	resp := postgresDataclipsListResponse{}
-	respBody := &graphql.Response{Data: &resp}
 
-	urlStr, queryErr := d.http.RequestURLWithQueryParams("/graphql",
-		graphql.GetQueryParam{Query: postgresDataclipListKey})
-	if queryErr != nil {
-		return nil, nil, queryErr
-	}
-
-	response, getErr := d.http.Get(urlStr, &respBody, nil)
+	response, getErr := d.graphql.Query(ctx, postgresDataclipListKey, nil, &resp)
 	if getErr != nil {
 		return nil, response, getErr
 	}
 
 	if resp.ListClips == nil {
-		return nil, nil, errors.New(response.Body)
+		return nil, response, apierror.FromResponse(response)
 	}
 
 	return resp.ListClips, response, nil
@@ -109,23 +101,21 @@ type postgresDataclipGetResponse struct {
 }
 
 // GetPostgresDataclip returns a single dataclip.
-func (d *Data) GetPostgresDataclip(slug string) (*PostgresDataclip, *simpleresty.Response, error) {
-	resp := postgresDataclipGetResponse{}
-	respBody := &graphql.Response{Data: &resp}
-
-	urlStr, queryErr := d.http.RequestURLWithQueryParams("/graphql",
-		graphql.GetQueryParam{Query: postgresDataclipGetKey, Variables: fmt.Sprintf("{\"slug\": \"%s\"}", slug)})
-	if queryErr != nil {
-		return nil, nil, queryErr
+func (d *Data) GetPostgresDataclip(ctx context.Context, slug string) (*PostgresDataclip, *simpleresty.Response, error) {
+	vars := map[string]interface{}{
+		"slug": slug,
 	}
 
-	response, getErr := d.http.Get(urlStr, &respBody, nil)
+	resp := postgresDataclipGetResponse{}
+
+	response, getErr := d.graphql.Query(ctx, postgresDataclipGetKey, vars, &resp)
 	if getErr != nil {
 		return nil, response, getErr
 	}
 
+	// A dataclip that does not exist is null, even in a successful response.
 	if resp.Clip == nil {
-		return nil, nil, errors.New(response.Body)
+		return nil, response, apierror.NewNotFound("dataclip %s not found", slug)
 	}
 
 	return resp.Clip, response, nil
@@ -145,29 +135,22 @@ type postgresDataclipCreateResponse struct {
 }
 
 // CreatePostgresDataclip creates a new data clip.
-func (d *Data) CreatePostgresDataclip(opts *PostgresDataclipCreateRequest) (*PostgresDataclip, *simpleresty.Response, error) {
+func (d *Data) CreatePostgresDataclip(ctx context.Context, opts *PostgresDataclipCreateRequest) (*PostgresDataclip, *simpleresty.Response, error) {
 	vars := map[string]interface{}{
 		"attachmentId": opts.AttachmentID,
 		"sql":          opts.Sql,
 		"title":        opts.Title,
 	}
 
-	reqBody := &graphql.Request{
-		Query:     postgresDataclipCreateKey,
-		Variables: vars,
-	}
-
 	resp := postgresDataclipCreateResponse{}
-	respBody := &graphql.Response{Data: &resp}
 
-	urlStr := d.http.RequestURL("/graphql")
-	response, createErr := d.http.Post(urlStr, &respBody, reqBody)
+	response, createErr := d.graphql.Mutate(ctx, postgresDataclipCreateKey, vars, &resp)
 	if createErr != nil {
 		return nil, response, createErr
 	}
 
 	if resp.CreateClip == nil {
-		return nil, nil, errors.New(response.Body)
+		return nil, response, apierror.FromResponse(response)
 	}
 
 	return resp.CreateClip, response, nil
@@ -185,7 +168,7 @@ type postgresDataclipUpdateResponse struct {
 	UpdateClip *PostgresDataclip `json:"updateClip"`
 }
 
-func (d *Data) UpdatePostgresDataclip(opts *PostgresDataclipUpdateRequest) (*PostgresDataclip, *simpleresty.Response, error) {
+func (d *Data) UpdatePostgresDataclip(ctx context.Context, opts *PostgresDataclipUpdateRequest) (*PostgresDataclip, *simpleresty.Response, error) {
 	vars := map[string]interface{}{
 		"attachmentId": opts.AttachmentID,
 		"sql":          opts.Sql,
@@ -193,22 +176,15 @@ func (d *Data) UpdatePostgresDataclip(opts *PostgresDataclipUpdateRequest) (*Pos
 		"clipId":       opts.ClipID,
 	}
 
-	reqBody := &graphql.Request{
-		Query:     postgresDataclipUpdateKey,
-		Variables: vars,
-	}
-
 	resp := postgresDataclipUpdateResponse{}
-	respBody := &graphql.Response{Data: &resp}
 
-	urlStr := d.http.RequestURL("/graphql")
-	response, createErr := d.http.Post(urlStr, &respBody, reqBody)
-	if createErr != nil {
-		return nil, response, createErr
+	response, updateErr := d.graphql.Mutate(ctx, postgresDataclipUpdateKey, vars, &resp)
+	if updateErr != nil {
+		return nil, response, updateErr
 	}
 
 	if resp.UpdateClip == nil {
-		return nil, nil, errors.New(response.Body)
+		return nil, response, apierror.FromResponse(response)
 	}
 
 	return resp.UpdateClip, response, nil
@@ -219,27 +195,20 @@ type PostgresDataclipDeleteResponse struct {
 }
 
 // DeletePostgresDataclip deletes a Postgres dataclip.
-func (d *Data) DeletePostgresDataclip(id string) (*PostgresDataclipDeleteResponse, *simpleresty.Response, error) {
+func (d *Data) DeletePostgresDataclip(ctx context.Context, id string) (*PostgresDataclipDeleteResponse, *simpleresty.Response, error) {
 	vars := map[string]interface{}{
 		"clipId": id,
 	}
 
-	reqBody := &graphql.Request{
-		Query:     postgresDataclipDeleteKey,
-		Variables: vars,
-	}
-
 	resp := PostgresDataclipDeleteResponse{}
-	respBody := &graphql.Response{Data: &resp}
 
-	urlStr := d.http.RequestURL("/graphql")
-	response, deleteErr := d.http.Post(urlStr, &respBody, reqBody)
+	response, deleteErr := d.graphql.Mutate(ctx, postgresDataclipDeleteKey, vars, &resp)
 	if deleteErr != nil {
 		return nil, response, deleteErr
 	}
 
 	if resp.DeleteClip == "" {
-		return nil, nil, errors.New(response.Body)
+		return nil, response, apierror.FromResponse(response)
 	}
 
 	return &resp, response, nil
@@ -249,7 +218,7 @@ type PostgresDataclipSharingResponse struct {
 	TogglePublicClipShare *PostgresDataclip `json:"togglePublicClipShare"`
 }
 
-func (d *Data) TogglePostgresDataclipSharing(slug string, enabled bool) (*PostgresDataclip, *simpleresty.Response, error) {
+func (d *Data) TogglePostgresDataclipSharing(ctx context.Context, slug string, enabled bool) (*PostgresDataclip, *simpleresty.Response, error) {
 	vars := map[string]interface{}{
 		"slug": slug,
 	}
@@ -259,22 +228,15 @@ func (d *Data) TogglePostgresDataclipSharing(slug string, enabled bool) (*Postgr
 		query = postgresDataclipEnableShareKey
 	}
 
-	reqBody := &graphql.Request{
-		Query:     query,
-		Variables: vars,
-	}
-
 	resp := PostgresDataclipSharingResponse{}
-	respBody := &graphql.Response{Data: &resp}
 
-	urlStr := d.http.RequestURL("/graphql")
-	response, deleteErr := d.http.Post(urlStr, &respBody, reqBody)
-	if deleteErr != nil {
-		return nil, response, deleteErr
+	response, toggleErr := d.graphql.Mutate(ctx, query, vars, &resp)
+	if toggleErr != nil {
+		return nil, response, toggleErr
 	}
 
 	if resp.TogglePublicClipShare == nil {
-		return nil, nil, errors.New(response.Body)
+		return nil, response, apierror.FromResponse(response)
 	}
 
 	return resp.TogglePublicClipShare, response, nil
@@ -284,28 +246,21 @@ type postgresDataclipShareWithUserResponse struct {
 	ShareClipWithUser *PostgresDataclipUserShare `json:"shareClipWithUser"`
 }
 
-func (d *Data) SharePostgresDataclipWithUser(dataclipID, userEmail string) (*PostgresDataclipUserShare, *simpleresty.Response, error) {
+func (d *Data) SharePostgresDataclipWithUser(ctx context.Context, dataclipID, userEmail string) (*PostgresDataclipUserShare, *simpleresty.Response, error) {
 	vars := map[string]interface{}{
 		"clipId": dataclipID,
 		"email":  userEmail,
 	}
 
-	reqBody := &graphql.Request{
-		Query:     postgresDataclipShareWithUserKey,
-		Variables: vars,
-	}
-
 	resp := postgresDataclipShareWithUserResponse{}
-	respBody := &graphql.Response{Data: &resp}
 
-	urlStr := d.http.RequestURL("/graphql")
-	response, deleteErr := d.http.Post(urlStr, &respBody, reqBody)
-	if deleteErr != nil {
-		return nil, response, deleteErr
+	response, shareErr := d.graphql.Mutate(ctx, postgresDataclipShareWithUserKey, vars, &resp)
+	if shareErr != nil {
+		return nil, response, shareErr
 	}
 
 	if resp.ShareClipWithUser == nil {
-		return nil, nil, errors.New(response.Body)
+		return nil, response, apierror.FromResponse(response)
 	}
 
 	return resp.ShareClipWithUser, response, nil
@@ -315,28 +270,21 @@ type postgresDataclipUnshareWithUserResponse struct {
 	UnshareClipWithUser *bool `json:"unshareClipWithUser,omitempty"`
 }
 
-func (d *Data) UnsharePostgresDataclipWithUser(dataclipID, dataclipShareID string) (bool, *simpleresty.Response, error) {
+func (d *Data) UnsharePostgresDataclipWithUser(ctx context.Context, dataclipID, dataclipShareID string) (bool, *simpleresty.Response, error) {
 	vars := map[string]interface{}{
 		"clipId":      dataclipID,
 		"clipShareId": dataclipShareID,
 	}
 
-	reqBody := &graphql.Request{
-		Query:     postgresDataclipUnshareWithUserKey,
-		Variables: vars,
-	}
-
 	resp := postgresDataclipUnshareWithUserResponse{}
-	respBody := &graphql.Response{Data: &resp}
 
-	urlStr := d.http.RequestURL("/graphql")
-	response, deleteErr := d.http.Post(urlStr, &respBody, reqBody)
-	if deleteErr != nil {
-		return false, response, deleteErr
+	response, unshareErr := d.graphql.Mutate(ctx, postgresDataclipUnshareWithUserKey, vars, &resp)
+	if unshareErr != nil {
+		return false, response, unshareErr
 	}
 
 	if resp.UnshareClipWithUser == nil {
-		return false, nil, errors.New(response.Body)
+		return false, response, apierror.FromResponse(response)
 	}
 
 	return *resp.UnshareClipWithUser, response, nil
@@ -346,28 +294,21 @@ type postgresDataclipShareWithTeamResponse struct {
 	ShareClipWithTeam *PostgresDataclipTeamShare `json:"shareClipWithTeam"`
 }
 
-func (d *Data) SharePostgresDataclipWithTeam(dataclipID, teamID string) (*PostgresDataclipTeamShare, *simpleresty.Response, error) {
+func (d *Data) SharePostgresDataclipWithTeam(ctx context.Context, dataclipID, teamID string) (*PostgresDataclipTeamShare, *simpleresty.Response, error) {
 	vars := map[string]interface{}{
 		"clipId": dataclipID,
 		"teamId": teamID,
 	}
 
-	reqBody := &graphql.Request{
-		Query:     postgresDataclipShareWithTeamKey,
-		Variables: vars,
-	}
-
 	resp := postgresDataclipShareWithTeamResponse{}
-	respBody := &graphql.Response{Data: &resp}
 
-	urlStr := d.http.RequestURL("/graphql")
-	response, deleteErr := d.http.Post(urlStr, &respBody, reqBody)
-	if deleteErr != nil {
-		return nil, response, deleteErr
+	response, shareErr := d.graphql.Mutate(ctx, postgresDataclipShareWithTeamKey, vars, &resp)
+	if shareErr != nil {
+		return nil, response, shareErr
 	}
 
 	if resp.ShareClipWithTeam == nil {
-		return nil, nil, errors.New(response.Body)
+		return nil, response, apierror.FromResponse(response)
 	}
 
 	return resp.ShareClipWithTeam, response, nil
@@ -377,28 +318,21 @@ type postgresDataclipUnshareWithTeamResponse struct {
 	UnshareClipWithTeam *bool `json:"unshareClipWithTeam,omitempty"`
 }
 
-func (d *Data) UnsharePostgresDataclipWithTeam(dataclipID, dataclipShareID string) (bool, *simpleresty.Response, error) {
+func (d *Data) UnsharePostgresDataclipWithTeam(ctx context.Context, dataclipID, dataclipShareID string) (bool, *simpleresty.Response, error) {
 	vars := map[string]interface{}{
 		"clipId":      dataclipID,
 		"clipShareId": dataclipShareID,
 	}
 
-	reqBody := &graphql.Request{
-		Query:     postgresDataclipUnshareWithTeamKey,
-		Variables: vars,
-	}
-
 	resp := postgresDataclipUnshareWithTeamResponse{}
-	respBody := &graphql.Response{Data: &resp}
 
-	urlStr := d.http.RequestURL("/graphql")
-	response, deleteErr := d.http.Post(urlStr, &respBody, reqBody)
-	if deleteErr != nil {
-		return false, response, deleteErr
+	response, unshareErr := d.graphql.Mutate(ctx, postgresDataclipUnshareWithTeamKey, vars, &resp)
+	if unshareErr != nil {
+		return false, response, unshareErr
 	}
 
 	if resp.UnshareClipWithTeam == nil {
-		return false, nil, errors.New(response.Body)
+		return false, response, apierror.FromResponse(response)
 	}
 
 	return *resp.UnshareClipWithTeam, response, nil
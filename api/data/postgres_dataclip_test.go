@@ -0,0 +1,27 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	config2 "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestData_GetPostgresDataclip_NullClip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"clip":null}}`))
+	}))
+	defer server.Close()
+
+	d := New(&config2.Config{DataBaseURL: server.URL})
+
+	clip, _, err := d.GetPostgresDataclip(context.Background(), "missing")
+	assert.Nil(t, clip)
+	assert.True(t, errors.Is(err, apierror.ErrNotFound))
+	assert.Equal(t, "404 [not_found] dataclip missing not found", err.Error())
+}
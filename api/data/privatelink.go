@@ -1,8 +1,9 @@
 package data
 
 import (
-	"errors"
+	"context"
 	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
 	"github.com/davidji99/terraform-provider-herokux/api/pkg/graphql"
 )
 
@@ -36,27 +37,20 @@ type privatelinkGetResponse struct {
 	Privatelink *Privatelink `json:"privatelink"`
 }
 
-func (d *Data) GetPrivatelink(addonID string) (*Privatelink, *simpleresty.Response, error) {
+func (d *Data) GetPrivatelink(ctx context.Context, addonID string) (*Privatelink, *simpleresty.Response, error) {
 	vars := map[string]interface{}{
 		"addonUUID": addonID,
 	}
 
-	reqBody := &graphql.Request{
-		Query:     privatelinkGetKey,
-		Variables: vars,
-	}
-
 	resp := privatelinkGetResponse{}
-	respBody := &graphql.Response{Data: &resp}
 
-	urlStr := d.http.RequestURL("/graphql")
-	response, getErr := d.http.Post(urlStr, &respBody, reqBody)
+	response, getErr := d.graphql.Query(ctx, privatelinkGetKey, vars, &resp)
 	if getErr != nil {
 		return nil, response, getErr
 	}
 
 	if resp.Privatelink == nil {
-		return nil, nil, errors.New(response.Body)
+		return nil, response, apierror.FromResponse(response)
 	}
 
 	return resp.Privatelink, response, nil
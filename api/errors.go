@@ -0,0 +1,28 @@
+package api
+
+import (
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+)
+
+// Error is returned by every sub-client method when a Heroku API responds with an unsuccessful status code
+// or when a finder method is unable to locate the requested resource.
+//
+// Use errors.As to inspect the status code, Heroku error ID, message, request ID and any GraphQL validation errors.
+type Error = apierror.Error
+
+// ValidationError represents a single GraphQL validation error.
+type ValidationError = apierror.ValidationError
+
+var (
+	// ErrNotFound is matched by errors.Is when the requested resource does not exist remotely.
+	ErrNotFound = apierror.ErrNotFound
+
+	// ErrRateLimited is matched by errors.Is when the API rejected the request due to rate limiting.
+	ErrRateLimited = apierror.ErrRateLimited
+
+	// ErrConflict is matched by errors.Is when the request conflicts with the current state of the resource.
+	ErrConflict = apierror.ErrConflict
+
+	// ErrUnauthorized is matched by errors.Is when the request was not authenticated.
+	ErrUnauthorized = apierror.ErrUnauthorized
+)
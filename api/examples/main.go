@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/kafka"
@@ -24,7 +25,7 @@ func main() {
 	opts.Compaction = true
 	opts.Partitions = 6
 
-	r, response, err := api.Kafka.CreateTopic("SOME_CLUSTER_ID", opts)
+	r, response, err := api.Kafka.CreateTopic(context.Background(), "SOME_CLUSTER_ID", opts)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,319 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/data"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/graphql"
+	"github.com/davidji99/terraform-provider-herokux/api/platform"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+// operationNameRegex matches the operation name of a GraphQL query or mutation.
+var operationNameRegex = regexp.MustCompile(`(?:query|mutation)\s+(\w+)`)
+
+// dataclip represents the state of a Postgres dataclip.
+type dataclip struct {
+	data.PostgresDataclip
+	pending *transition
+}
+
+// currentUser is the user that creates and shares dataclips.
+var currentUser = &platform.User{ID: stringPtr("00000000-0000-4000-8000-000000000000"), Email: stringPtr("user@example.com")}
+
+// graphqlError represents a GraphQL error response body.
+type graphqlError struct {
+	Data   interface{} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+	Extensions struct {
+		Code string `json:"code"`
+	} `json:"extensions"`
+}
+
+// graphqlHandler handles a single GraphQL operation.
+type graphqlHandler func(w http.ResponseWriter, vars map[string]interface{})
+
+func (s *Server) registerData(mux *http.ServeMux) {
+	operations := map[string]graphqlHandler{
+		"ListClips":               s.listDataclips,
+		"FetchClipDetails":        s.getDataclip,
+		"CreatePostgresDataclip":  s.createDataclip,
+		"UpdateDataclip":          s.updateDataclip,
+		"DeletePostgresDataclip":  s.deleteDataclip,
+		"SharePublicDataclip":     s.toggleDataclipSharing(true),
+		"UnsharePublicDataclip":   s.toggleDataclipSharing(false),
+		"ShareDataclipWithUser":   s.shareDataclipWithUser,
+		"UnshareDataclipWithUser": s.unshareDataclip("unshareClipWithUser"),
+		"ShareDataclipWithTeam":   s.shareDataclipWithTeam,
+		"UnshareDataclipWithTeam": s.unshareDataclip("unshareClipWithTeam"),
+	}
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req graphql.Request
+
+		switch r.Method {
+		case http.MethodGet:
+			req.Query = r.URL.Query().Get("query")
+			if v := r.URL.Query().Get("variables"); v != "" {
+				if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+					writeGraphQLError(w, http.StatusBadRequest, "BAD_USER_INPUT", "Invalid variables: %s", err)
+					return
+				}
+			}
+		case http.MethodPost:
+			if !decode(w, r, &req) {
+				return
+			}
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		match := operationNameRegex.FindStringSubmatch(req.Query)
+		if match == nil || operations[match[1]] == nil {
+			writeGraphQLError(w, http.StatusBadRequest, "GRAPHQL_VALIDATION_FAILED", "Unknown operation")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		operations[match[1]](w, req.Variables)
+	})
+}
+
+func writeGraphQLError(w http.ResponseWriter, statusCode int, code, format string, args ...interface{}) {
+	body := &graphqlError{}
+	body.Errors = append(body.Errors, struct {
+		Message string `json:"message"`
+	}{Message: fmt.Sprintf(format, args...)})
+	body.Extensions.Code = code
+
+	writeJSON(w, statusCode, body)
+}
+
+func writeGraphQLData(w http.ResponseWriter, field string, v interface{}) {
+	writeJSON(w, http.StatusOK, &graphql.Response{Data: map[string]interface{}{field: v}})
+}
+
+func variable(vars map[string]interface{}, name string) string {
+	v, _ := vars[name].(string)
+	return v
+}
+
+// newSlug returns a unique, deterministic dataclip slug. It must be called with s.mu held.
+func (s *Server) newSlug() string {
+	s.seq++
+
+	slug := []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	for i, n := len(slug)-1, s.seq; n > 0; i, n = i-1, n/26 {
+		slug[i] = byte('a' + n%26)
+	}
+
+	return string(slug)
+}
+
+func (s *Server) findDataclip(id, slug string) *dataclip {
+	for _, c := range s.dataclips {
+		if (id != "" && c.GetID() == id) || (slug != "" && c.GetSlug() == slug) {
+			return c
+		}
+	}
+	return nil
+}
+
+// addDataclipVersion adds a new version of the clip whose query completes after the configured number of reads.
+func (s *Server) addDataclipVersion(c *dataclip, sql string) {
+	result := &data.PostgresDataclipVersionResult{ID: stringPtr(s.newID()), QueryStartAt: now()}
+
+	c.Versions = append([]*data.PostgresDataclipVersion{{
+		ID:        stringPtr(s.newID()),
+		Sql:       stringPtr(sql),
+		CreatorID: currentUser.ID,
+		Creator:   currentUser,
+		CreatedAt: now(),
+		Result:    result,
+	}}, c.Versions...)
+	c.EditedAt = now()
+
+	c.pending = s.newTransition(func() {
+		result.QueryFinishAt = now()
+		result.CompletedAt = now()
+		result.Duration = intPtr(int(result.CompletedAt.Sub(*result.QueryStartAt) / time.Millisecond))
+	})
+}
+
+func (s *Server) listDataclips(w http.ResponseWriter, vars map[string]interface{}) {
+	result := make([]data.PostgresDataclip, 0, len(s.dataclips))
+	for _, c := range s.dataclips {
+		result = append(result, c.PostgresDataclip)
+	}
+
+	writeGraphQLData(w, "listClips", result)
+}
+
+func (s *Server) getDataclip(w http.ResponseWriter, vars map[string]interface{}) {
+	c := s.findDataclip("", variable(vars, "slug"))
+	if c == nil {
+		writeGraphQLError(w, http.StatusNotFound, "NOT_FOUND", "Couldn't find that clip.")
+		return
+	}
+
+	writeGraphQLData(w, "clip", c.PostgresDataclip)
+	c.pending = c.pending.settle()
+}
+
+func (s *Server) createDataclip(w http.ResponseWriter, vars map[string]interface{}) {
+	attachmentID, title, sql := variable(vars, "attachmentId"), variable(vars, "title"), variable(vars, "sql")
+	if attachmentID == "" || title == "" || sql == "" {
+		writeGraphQLError(w, http.StatusBadRequest, "BAD_USER_INPUT", "attachmentId, title and sql are required")
+		return
+	}
+
+	c := &dataclip{PostgresDataclip: data.PostgresDataclip{
+		ID:         stringPtr(s.newID()),
+		Slug:       stringPtr(s.newSlug()),
+		Title:      stringPtr(title),
+		Detached:   boolPtr(false),
+		Editable:   boolPtr(true),
+		CreatedAt:  now(),
+		Creator:    currentUser,
+		Datasource: &data.PostgresDataclipDatasource{ID: stringPtr(s.newID()), AttachmentID: stringPtr(attachmentID)},
+		UserShares: []*data.PostgresDataclipUserShare{},
+		TeamShares: []*data.PostgresDataclipTeamShare{},
+	}}
+	if teamID := variable(vars, "teamId"); teamID != "" {
+		c.TeamID = stringPtr(teamID)
+	}
+	s.addDataclipVersion(c, sql)
+	s.dataclips = append(s.dataclips, c)
+
+	writeGraphQLData(w, "createClip", c.PostgresDataclip)
+}
+
+func (s *Server) updateDataclip(w http.ResponseWriter, vars map[string]interface{}) {
+	c := s.findDataclip(variable(vars, "clipId"), "")
+	if c == nil {
+		writeGraphQLError(w, http.StatusNotFound, "NOT_FOUND", "Couldn't find that clip.")
+		return
+	}
+
+	c.Title = stringPtr(variable(vars, "title"))
+	c.Datasource.AttachmentID = stringPtr(variable(vars, "attachmentId"))
+	s.addDataclipVersion(c, variable(vars, "sql"))
+
+	writeGraphQLData(w, "updateClip", c.PostgresDataclip)
+}
+
+func (s *Server) deleteDataclip(w http.ResponseWriter, vars map[string]interface{}) {
+	id := variable(vars, "clipId")
+	if s.findDataclip(id, "") == nil {
+		writeGraphQLError(w, http.StatusNotFound, "NOT_FOUND", "Couldn't find that clip.")
+		return
+	}
+
+	var dataclips []*dataclip
+	for _, c := range s.dataclips {
+		if c.GetID() != id {
+			dataclips = append(dataclips, c)
+		}
+	}
+	s.dataclips = dataclips
+
+	writeGraphQLData(w, "deleteClip", id)
+}
+
+func (s *Server) toggleDataclipSharing(enabled bool) graphqlHandler {
+	return func(w http.ResponseWriter, vars map[string]interface{}) {
+		c := s.findDataclip("", variable(vars, "slug"))
+		if c == nil {
+			writeGraphQLError(w, http.StatusNotFound, "NOT_FOUND", "Couldn't find that clip.")
+			return
+		}
+
+		c.PublicSlug, c.PublicSlugBy = nil, nil
+		if enabled {
+			c.PublicSlug = stringPtr(s.newSlug())
+			c.PublicSlugBy = currentUser.Email
+		}
+
+		writeGraphQLData(w, "togglePublicClipShare", c.PostgresDataclip)
+	}
+}
+
+func (s *Server) shareDataclipWithUser(w http.ResponseWriter, vars map[string]interface{}) {
+	c := s.findDataclip(variable(vars, "clipId"), "")
+	if c == nil {
+		writeGraphQLError(w, http.StatusNotFound, "NOT_FOUND", "Couldn't find that clip.")
+		return
+	}
+
+	share := &data.PostgresDataclipUserShare{
+		ID:         stringPtr(s.newID()),
+		ClipID:     c.ID,
+		SharedBy:   currentUser,
+		SharedWith: &platform.User{ID: stringPtr(s.newID()), Email: stringPtr(variable(vars, "email"))},
+	}
+	c.UserShares = append(c.UserShares, share)
+
+	writeGraphQLData(w, "shareClipWithUser", share)
+}
+
+func (s *Server) shareDataclipWithTeam(w http.ResponseWriter, vars map[string]interface{}) {
+	c := s.findDataclip(variable(vars, "clipId"), "")
+	if c == nil {
+		writeGraphQLError(w, http.StatusNotFound, "NOT_FOUND", "Couldn't find that clip.")
+		return
+	}
+
+	teamID := variable(vars, "teamId")
+	share := &data.PostgresDataclipTeamShare{
+		ID:         stringPtr(s.newID()),
+		ClipID:     c.ID,
+		SharedBy:   currentUser,
+		SharedWith: &platform.Team{ID: stringPtr(teamID), Name: stringPtr(fmt.Sprintf("team-%s", teamID))},
+	}
+	c.TeamShares = append(c.TeamShares, share)
+
+	writeGraphQLData(w, "shareClipWithTeam", share)
+}
+
+// unshareDataclip removes a user or team share. field is the name of the mutation's response field.
+func (s *Server) unshareDataclip(field string) graphqlHandler {
+	return func(w http.ResponseWriter, vars map[string]interface{}) {
+		c := s.findDataclip(variable(vars, "clipId"), "")
+		if c == nil {
+			writeGraphQLError(w, http.StatusNotFound, "NOT_FOUND", "Couldn't find that clip.")
+			return
+		}
+
+		shareID := variable(vars, "clipShareId")
+		found := false
+
+		var userShares []*data.PostgresDataclipUserShare
+		for _, share := range c.UserShares {
+			if share.GetID() == shareID {
+				found = true
+				continue
+			}
+			userShares = append(userShares, share)
+		}
+
+		var teamShares []*data.PostgresDataclipTeamShare
+		for _, share := range c.TeamShares {
+			if share.GetID() == shareID {
+				found = true
+				continue
+			}
+			teamShares = append(teamShares, share)
+		}
+
+		c.UserShares, c.TeamShares = userShares, teamShares
+
+		writeGraphQLData(w, field, found)
+	}
+}
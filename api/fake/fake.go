@@ -0,0 +1,221 @@
+// Package fake provides an in-memory fake of the Heroku APIs used by the api package.
+//
+// A Server keeps the state of Postgres credentials, MTLS configurations, IP rules, backup schedules and data links,
+// Kafka topics and consumer groups, formation monitors, scheduler jobs, Redis configurations and dataclips,
+// so full create, read, update and delete cycles can be exercised without network access:
+//
+//	server := fake.NewServer()
+//	defer server.Close()
+//
+//	server.AddPostgresDatabase("postgresql-fluffy-12345")
+//
+//	client, err := api.New(append(server.Options(), config.APIToken("token"))...)
+//
+// Asynchronous operations, such as provisioning a credential or creating a Kafka topic, stay in a transitional
+// state such as `provisioning` or `pending` for a number of reads before settling into their final state.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/metrics"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/scheduler"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultPendingReads is the default number of reads an asynchronous operation stays in its transitional state.
+const DefaultPendingReads = 1
+
+// Server is an in-memory fake of the Heroku APIs backed by a httptest.Server.
+//
+// A Server is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	seq          int
+	pendingReads int
+
+	databases      map[string]*database
+	clusters       map[string]*cluster
+	redisDatabases map[string]*redisDatabase
+	monitors       map[string][]*metrics.FormationMonitor
+	jobs           map[string][]*scheduler.Job
+	dataclips      []*dataclip
+}
+
+// Option is a functional option for configuring a Server.
+type Option func(*Server)
+
+// PendingReads sets the number of reads an asynchronous operation stays in its transitional state.
+//
+// Set to zero for operations to complete immediately.
+func PendingReads(n int) Option {
+	return func(s *Server) {
+		s.pendingReads = n
+	}
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		pendingReads:   DefaultPendingReads,
+		databases:      make(map[string]*database),
+		clusters:       make(map[string]*cluster),
+		redisDatabases: make(map[string]*redisDatabase),
+		monitors:       make(map[string][]*metrics.FormationMonitor),
+		jobs:           make(map[string][]*scheduler.Job),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	mux := http.NewServeMux()
+	s.registerPostgres(mux)
+	s.registerKafka(mux)
+	s.registerMetrics(mux)
+	s.registerScheduler(mux)
+	s.registerRedis(mux)
+	s.registerData(mux)
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+
+	return s
+}
+
+// Options returns the config options that point the Postgres, Kafka, Metrics, Scheduler, Redis and Data
+// API clients at the Server.
+func (s *Server) Options() []config.Option {
+	return []config.Option{
+		config.PostgresBaseURL(s.URL),
+		config.KafkaBaseURL(s.URL),
+		config.MetricsBaseURL(s.URL),
+		config.SchedulerBaseURL(s.URL),
+		config.RedisBaseURL(s.URL),
+		config.DataBaseURL(s.URL),
+	}
+}
+
+// authenticate rejects requests without credentials and sets the Request-Id header on every response.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		w.Header().Set("Request-Id", s.newID())
+		s.mu.Unlock()
+
+		if scheme, credentials, _ := strings.Cut(r.Header.Get("Authorization"), " "); scheme == "" || credentials == "" {
+			writeError(w, http.StatusUnauthorized, "unauthorized", "Invalid credentials provided.")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// newID returns a unique, deterministic UUID. It must be called with s.mu held.
+func (s *Server) newID() string {
+	s.seq++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.seq)
+}
+
+// transition is an asynchronous state change that is applied once it has been read a number of times.
+type transition struct {
+	reads int
+	apply func()
+}
+
+// newTransition returns a transition that calls apply after the configured number of reads.
+// If no reads are configured, apply is called immediately and nil is returned.
+func (s *Server) newTransition(apply func()) *transition {
+	if s.pendingReads <= 0 {
+		apply()
+		return nil
+	}
+
+	return &transition{reads: s.pendingReads, apply: apply}
+}
+
+// settle records a read of the transitional state and applies the transition once it has been read enough times.
+// It returns the transition if still pending or nil otherwise.
+func (t *transition) settle() *transition {
+	if t == nil {
+		return nil
+	}
+
+	t.reads--
+	if t.reads > 0 {
+		return t
+	}
+
+	t.apply()
+	return nil
+}
+
+// errorBody represents the default Heroku API error response body.
+type errorBody struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	writeContent(w, "application/json", statusCode, v)
+}
+
+func writeContent(w http.ResponseWriter, contentType string, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, id, format string, args ...interface{}) {
+	writeJSON(w, statusCode, &errorBody{ID: id, Message: fmt.Sprintf(format, args...)})
+}
+
+func writeNotFound(w http.ResponseWriter, format string, args ...interface{}) {
+	writeError(w, http.StatusNotFound, "not_found", format, args...)
+}
+
+func writeInvalidParams(w http.ResponseWriter, format string, args ...interface{}) {
+	writeError(w, http.StatusUnprocessableEntity, "invalid_params", format, args...)
+}
+
+// decode unmarshals the request body into v. It writes a 400 response and returns false if the body is invalid.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Unable to parse request body: %s", err)
+		return false
+	}
+
+	return true
+}
+
+func now() *time.Time {
+	t := time.Now().UTC().Truncate(time.Second)
+	return &t
+}
+
+func timestamp() *string {
+	return stringPtr(now().Format(time.RFC3339))
+}
+
+func stringPtr(v string) *string {
+	return &v
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
@@ -0,0 +1,366 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/data"
+	"github.com/davidji99/terraform-provider-herokux/api/general"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/metrics"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/postgres"
+	"github.com/davidji99/terraform-provider-herokux/api/redis"
+	"github.com/davidji99/terraform-provider-herokux/api/scheduler"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newTestClient(t *testing.T, opts ...Option) (*Server, *api.Client) {
+	server := NewServer(opts...)
+	t.Cleanup(server.Close)
+
+	client, err := api.New(append(server.Options(), config.APIToken("token"), config.BasicAuth("user", "token"),
+		config.MaxRetries(0))...)
+	assert.Nil(t, err)
+
+	return server, client
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.AddPostgresDatabase("db")
+
+	client, err := api.New(append(server.Options(), config.MaxRetries(0))...)
+	assert.Nil(t, err)
+
+	_, _, err = client.Postgres.ListCredentials(context.Background(), "db")
+	assert.True(t, errors.Is(err, api.ErrUnauthorized))
+}
+
+func TestServer_PostgresCredential(t *testing.T) {
+	server, client := newTestClient(t)
+	server.AddPostgresDatabase("db")
+	ctx := context.Background()
+
+	_, _, err := client.Postgres.ListCredentials(ctx, "missing")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+
+	_, _, err = client.Postgres.CreateCredential(ctx, "db", "reader")
+	assert.Nil(t, err)
+
+	cred, _, err := client.Postgres.GetCredential(ctx, "db", "reader")
+	assert.Nil(t, err)
+	assert.Equal(t, postgres.CredentialStates.PROVISIONING, cred.State)
+
+	cred, _, err = client.Postgres.GetCredential(ctx, "db", "reader")
+	assert.Nil(t, err)
+	assert.Equal(t, postgres.CredentialStates.ACTIVE, cred.State)
+
+	_, _, err = client.Postgres.CreateCredential(ctx, "db", "reader")
+	assert.NotNil(t, err)
+
+	_, _, err = client.Postgres.DeleteCredential(ctx, "db", "reader")
+	assert.Nil(t, err)
+
+	cred, _, err = client.Postgres.GetCredential(ctx, "db", "reader")
+	assert.Nil(t, err)
+	assert.Equal(t, postgres.CredentialStates.REVOKING, cred.State)
+
+	_, _, err = client.Postgres.GetCredential(ctx, "db", "reader")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+
+	creds, _, err := client.Postgres.ListCredentials(ctx, "db")
+	assert.Nil(t, err)
+	assert.Len(t, creds, 1)
+	assert.Equal(t, "default", creds[0].GetName())
+}
+
+func TestServer_PostgresMTLS(t *testing.T) {
+	server, client := newTestClient(t)
+	server.AddPostgresDatabase("db")
+	ctx := context.Background()
+
+	m, _, err := client.Postgres.ProvisionMTLS(ctx, "db")
+	assert.Nil(t, err)
+	assert.Equal(t, postgres.MTLSConfigStatuses.PROVISIONING, *m.GetStatus())
+
+	rule, _, err := client.Postgres.CreateMTLSIPRule(ctx, "db", &general.MTLSIPRuleRequest{CIDR: "1.2.3.4/32"})
+	assert.Nil(t, err)
+	assert.Equal(t, general.MTLSIPRuleStatuses.AUTHORIZING, *rule.GetStatus())
+
+	rule, _, err = client.Postgres.GetMTLSIPRule(ctx, "db", rule.GetID())
+	assert.Nil(t, err)
+
+	rule, _, err = client.Postgres.GetMTLSIPRule(ctx, "db", rule.GetID())
+	assert.Nil(t, err)
+	assert.Equal(t, general.MTLSIPRuleStatuses.AUTHORIZED, *rule.GetStatus())
+
+	_, _, err = client.Postgres.GetMTLS(ctx, "db")
+	assert.Nil(t, err)
+
+	m, _, err = client.Postgres.GetMTLS(ctx, "db")
+	assert.Nil(t, err)
+	assert.Equal(t, postgres.MTLSConfigStatuses.OPERATIONAL, *m.GetStatus())
+	assert.Len(t, m.ActiveIPRules, 1)
+
+	_, err = client.Postgres.DeleteMTLSIPRule(ctx, "db", rule.GetID())
+	assert.Nil(t, err)
+
+	m, _, err = client.Postgres.DeprovisionMTLS(ctx, "db")
+	assert.Nil(t, err)
+	assert.Equal(t, postgres.MTLSConfigStatuses.DEPROVISIONING, *m.GetStatus())
+
+	_, _, err = client.Postgres.GetMTLS(ctx, "db")
+	assert.Nil(t, err)
+
+	_, _, err = client.Postgres.GetMTLS(ctx, "db")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestServer_PostgresBackupScheduleAndDataLink(t *testing.T) {
+	server, client := newTestClient(t)
+	server.AddPostgresDatabase("db")
+	ctx := context.Background()
+
+	schedule, _, err := client.Postgres.CreateBackupSchedule(ctx, "db",
+		&postgres.BackupScheduleRequest{Hour: "2", Timezone: "America/Los_Angeles"})
+	assert.Nil(t, err)
+
+	schedule, _, err = client.Postgres.CreateBackupSchedule(ctx, "db", &postgres.BackupScheduleRequest{Hour: "5"})
+	assert.Nil(t, err)
+
+	schedules, _, err := client.Postgres.ListBackupSchedules(ctx, "db")
+	assert.Nil(t, err)
+	assert.Len(t, schedules, 1)
+	assert.Equal(t, "5", schedules[0].GetHour().String())
+
+	_, _, err = client.Postgres.CreateBackupSchedule(ctx, "db", &postgres.BackupScheduleRequest{Hour: "24"})
+	assert.NotNil(t, err)
+
+	_, err = client.Postgres.DeleteBackupSchedule(ctx, "db", schedule.GetID())
+	assert.Nil(t, err)
+
+	link, _, err := client.Postgres.CreateDataLink(ctx, "db",
+		&postgres.DataLinkCreateOpts{Remote: "remote-db", Name: "analytics"})
+	assert.Nil(t, err)
+	assert.Equal(t, "analytics", link.GetName())
+
+	link, _, err = client.Postgres.FindDataLinkByID(ctx, "db", link.GetID())
+	assert.Nil(t, err)
+	assert.Equal(t, "remote-db", link.GetRemoteName())
+
+	_, err = client.Postgres.DeleteDataLink(ctx, "db", "analytics")
+	assert.Nil(t, err)
+
+	_, _, err = client.Postgres.FindDataLinkByName(ctx, "db", "analytics")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestServer_KafkaTopic(t *testing.T) {
+	server, client := newTestClient(t)
+	server.AddKafkaCluster("cluster", nil)
+	ctx := context.Background()
+
+	retention := 86400000
+	opts := &kafka.TopicRequest{Name: "events", Partitions: 8, ReplicationFactor: 3, RetentionTimeMS: &retention}
+
+	_, _, err := client.Kafka.CreateTopic(ctx, "cluster", opts)
+	assert.Nil(t, err)
+
+	topic, _, err := client.Kafka.GetTopicByName(ctx, "cluster", "events")
+	assert.Nil(t, err)
+	assert.Equal(t, kafka.TopicStatuses.PENDING.ToString(), topic.GetStatus())
+	assert.Equal(t, 0, topic.GetPartitions())
+
+	topic, _, err = client.Kafka.GetTopicByName(ctx, "cluster", "events")
+	assert.Nil(t, err)
+	assert.Equal(t, kafka.TopicStatuses.READY.ToString(), topic.GetStatus())
+	assert.Equal(t, 8, topic.GetPartitions())
+	assert.Equal(t, "delete", topic.GetCleanupPolicy())
+
+	opts.Partitions = 16
+	opts.Compaction = true
+	_, _, err = client.Kafka.UpdateTopic(ctx, "cluster", opts)
+	assert.Nil(t, err)
+
+	topic, _, err = client.Kafka.GetTopicByName(ctx, "cluster", "events")
+	assert.Nil(t, err)
+	assert.Equal(t, kafka.TopicStatuses.UPDATING.ToString(), topic.GetStatus())
+
+	topic, _, err = client.Kafka.GetTopicByName(ctx, "cluster", "events")
+	assert.Nil(t, err)
+	assert.Equal(t, 16, topic.GetPartitions())
+	assert.Equal(t, "compact,delete", topic.GetCleanupPolicy())
+
+	_, _, err = client.Kafka.CreateTopic(ctx, "cluster",
+		&kafka.TopicRequest{Name: "too-many", Partitions: 1000, ReplicationFactor: 3, RetentionTimeMS: &retention})
+	assert.NotNil(t, err)
+
+	_, _, err = client.Kafka.DeleteTopic(ctx, "cluster", "events")
+	assert.Nil(t, err)
+
+	_, _, err = client.Kafka.GetTopicByName(ctx, "cluster", "events")
+	assert.Nil(t, err)
+
+	_, _, err = client.Kafka.GetTopicByName(ctx, "cluster", "events")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestServer_KafkaConsumerGroup(t *testing.T) {
+	server, client := newTestClient(t, PendingReads(2))
+	server.AddKafkaCluster("cluster", nil)
+	ctx := context.Background()
+
+	opts := kafka.NewConsumerGroupRequest()
+	opts.Name = "workers"
+
+	_, _, err := client.Kafka.CreateConsumerGroup(ctx, "cluster", opts)
+	assert.Nil(t, err)
+
+	for _, want := range []bool{false, false, true} {
+		created, _, err := client.Kafka.WasConsumerGroupCreated(ctx, "cluster", "workers")
+		assert.Nil(t, err)
+		assert.Equal(t, want, created)
+	}
+
+	_, _, err = client.Kafka.DeleteConsumerGroup(ctx, "cluster", opts)
+	assert.Nil(t, err)
+
+	for _, want := range []bool{false, false, true} {
+		deleted, _, err := client.Kafka.WasConsumerGroupDeleted(ctx, "cluster", "workers")
+		assert.Nil(t, err)
+		assert.Equal(t, want, deleted)
+	}
+}
+
+func TestServer_MetricsMonitor(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	m, _, err := client.Metrics.CreateFormationAutoscaling(ctx, "app", "web", &metrics.FormationAutoscalingRequest{
+		IsActive:    true,
+		MinQuantity: 1,
+		MaxQuantity: 4,
+		Period:      1,
+		ActionType:  metrics.FormationMonitorActionTypes.Scale,
+		Operation:   metrics.DefaultOperationAttrVal,
+		Name:        metrics.FormationMonitorNames.LatencyScale,
+	})
+	assert.Nil(t, err)
+
+	updated, _, err := client.Metrics.UpdateFormationAutoscaling(ctx, "app", "web", m.GetID(),
+		&metrics.FormationAutoscalingRequest{IsActive: true, MinQuantity: 2, MaxQuantity: 8})
+	assert.Nil(t, err)
+	assert.True(t, updated)
+
+	m, _, err = client.Metrics.GetMonitor(ctx, "app", "web", m.GetID())
+	assert.Nil(t, err)
+	assert.Equal(t, 8, m.GetMaxQuantity())
+
+	m, _, err = client.Metrics.FindMonitorByName(ctx, "app", "web", metrics.FormationMonitorNames.LatencyScale)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, m.GetMinQuantity())
+
+	_, err = client.Metrics.DeleteMonitor(ctx, "app", "web", m.GetID())
+	assert.Nil(t, err)
+
+	monitors, _, err := client.Metrics.ListMonitors(ctx, "app", "web")
+	assert.Nil(t, err)
+	assert.Empty(t, monitors)
+}
+
+func TestServer_SchedulerJob(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	created, _, err := client.Scheduler.Create(ctx, "app",
+		&scheduler.JobRequest{Command: "rake cleanup", DynoSize: "Standard-1X", Every: 60, At: 30})
+	assert.Nil(t, err)
+
+	_, _, err = client.Scheduler.Update(ctx, "app", created.GetData().GetID(),
+		&scheduler.JobRequest{Command: "rake cleanup", Every: 1440, At: 90})
+	assert.Nil(t, err)
+
+	job, _, err := client.Scheduler.FindByID(ctx, "app", created.GetData().GetID())
+	assert.Nil(t, err)
+	assert.Equal(t, 1440, job.GetAttributes().GetEvery())
+	assert.Equal(t, "Standard-1X", job.GetAttributes().GetDynoSize())
+
+	_, _, err = client.Scheduler.Update(ctx, "app", job.GetID(), &scheduler.JobRequest{Every: 60, At: 15})
+	assert.NotNil(t, err)
+
+	_, err = client.Scheduler.Delete(ctx, "app", job.GetID())
+	assert.Nil(t, err)
+
+	_, _, err = client.Scheduler.FindByID(ctx, "app", job.GetID())
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestServer_RedisConfig(t *testing.T) {
+	server, client := newTestClient(t)
+	server.AddRedisDatabase("redis")
+	ctx := context.Background()
+
+	events, timeout := "Ex", 60
+	c, _, err := client.Redis.UpdateConfig(ctx, "redis",
+		&redis.ConfigUpdateRequest{MaxmemoryPolicy: "allkeys-lru", NotifyKeyspaceEvents: &events, Timeout: &timeout})
+	assert.Nil(t, err)
+	assert.Equal(t, "allkeys-lru", c.GetMaxmemoryPolicy().GetValue())
+
+	c, _, err = client.Redis.GetConfig(ctx, "redis")
+	assert.Nil(t, err)
+	assert.Equal(t, "Ex", c.GetNotifyKeyspaceEvents().GetValue())
+	assert.Equal(t, 60, c.GetTimeout().GetValue())
+
+	_, _, err = client.Redis.UpdateConfig(ctx, "redis", &redis.ConfigUpdateRequest{MaxmemoryPolicy: "bogus"})
+	assert.NotNil(t, err)
+}
+
+func TestServer_Dataclip(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	clip, _, err := client.Data.CreatePostgresDataclip(ctx,
+		&data.PostgresDataclipCreateRequest{AttachmentID: "attachment", Sql: "SELECT 1", Title: "one"})
+	assert.Nil(t, err)
+	assert.Nil(t, clip.Versions[0].GetResult().CompletedAt)
+
+	_, _, err = client.Data.GetPostgresDataclip(ctx, clip.GetSlug())
+	assert.Nil(t, err)
+
+	clip, _, err = client.Data.GetPostgresDataclip(ctx, clip.GetSlug())
+	assert.Nil(t, err)
+	assert.NotNil(t, clip.Versions[0].GetResult().CompletedAt)
+
+	clip, _, err = client.Data.UpdatePostgresDataclip(ctx, &data.PostgresDataclipUpdateRequest{
+		ClipID:                        clip.GetID(),
+		PostgresDataclipCreateRequest: data.PostgresDataclipCreateRequest{AttachmentID: "attachment", Sql: "SELECT 2", Title: "two"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "two", clip.GetTitle())
+	assert.Len(t, clip.Versions, 2)
+
+	clip, _, err = client.Data.TogglePostgresDataclipSharing(ctx, clip.GetSlug(), true)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, clip.GetPublicSlug())
+
+	share, _, err := client.Data.SharePostgresDataclipWithUser(ctx, clip.GetID(), "friend@example.com")
+	assert.Nil(t, err)
+
+	unshared, _, err := client.Data.UnsharePostgresDataclipWithUser(ctx, clip.GetID(), share.GetID())
+	assert.Nil(t, err)
+	assert.True(t, unshared)
+
+	clips, _, err := client.Data.ListPostgresDataclips(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, clips, 1)
+
+	_, _, err = client.Data.DeletePostgresDataclip(ctx, clip.GetID())
+	assert.Nil(t, err)
+
+	_, _, err = client.Data.GetPostgresDataclip(ctx, clip.GetSlug())
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
@@ -0,0 +1,431 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+// topicNameRegex matches valid Kafka topic names.
+var topicNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// cluster represents the state of a Heroku Kafka cluster.
+type cluster struct {
+	kafka.Cluster
+	topics         []*topic
+	consumerGroups []*consumerGroup
+}
+
+type topic struct {
+	kafka.Topic
+	pending *transition
+}
+
+type consumerGroup struct {
+	name    string
+	created bool
+	pending *transition
+}
+
+// DefaultKafkaClusterLimits returns the limits of a standard Heroku Kafka cluster.
+func DefaultKafkaClusterLimits() *kafka.ClusterLimits {
+	return &kafka.ClusterLimits{
+		MinimumReplication:            intPtr(3),
+		MaximumReplication:            intPtr(3),
+		MinimumReplicationMS:          int64Ptr(int64(24 * time.Hour / time.Millisecond)),
+		MaximumReplicationMS:          int64Ptr(int64(14 * 24 * time.Hour / time.Millisecond)),
+		MaxPartitionReplicaCount:      intPtr(12000),
+		MaxNumberOfTotalPartitions:    intPtr(4000),
+		MaxNumberOfPartitionsPerTopic: intPtr(256),
+		MaxNumberOfTopics:             intPtr(40),
+		MaxTopics:                     intPtr(40),
+	}
+}
+
+// AddKafkaCluster adds a Heroku Kafka cluster to the Server. If limits is nil, DefaultKafkaClusterLimits is used.
+func (s *Server) AddKafkaCluster(id string, limits *kafka.ClusterLimits) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if limits == nil {
+		limits = DefaultKafkaClusterLimits()
+	}
+
+	s.clusters[id] = &cluster{Cluster: kafka.Cluster{
+		AddonID:                  stringPtr(id),
+		Name:                     stringPtr(fmt.Sprintf("kafka-%s", id)),
+		AddonAttachmentConfigVar: stringPtr("KAFKA_URL"),
+		CreatedAt:                now(),
+		State: &kafka.ClusterState{
+			Message: stringPtr("available"),
+			Waiting: boolPtr(false),
+			Healthy: boolPtr(true),
+			Status:  stringPtr("available"),
+		},
+		Limits:        limits,
+		Versions:      []string{"3.6.1"},
+		SharedCluster: boolPtr(false),
+		TopicPrefix:   stringPtr(""),
+		Defaults: &kafka.ClusterDefaults{
+			PartitionCount:  intPtr(32),
+			RetentionTimeMS: int64Ptr(int64(24 * time.Hour / time.Millisecond)),
+		},
+		Capabilities: &kafka.ClusterCapabilities{SupportsMixedCleanupPolicy: boolPtr(true)},
+	}}
+}
+
+func (s *Server) registerKafka(mux *http.ServeMux) {
+	mux.HandleFunc("GET /data/kafka/v0/clusters/{cluster}", s.kafkaHandler(s.getCluster))
+
+	mux.HandleFunc("GET /data/kafka/v0/clusters/{cluster}/topics", s.kafkaHandler(s.listTopics))
+	mux.HandleFunc("POST /data/kafka/v0/clusters/{cluster}/topics", s.kafkaHandler(s.createTopic))
+	mux.HandleFunc("PUT /data/kafka/v0/clusters/{cluster}/topics/{topic}", s.kafkaHandler(s.updateTopic))
+	mux.HandleFunc("DELETE /data/kafka/v0/clusters/{cluster}/topics/{topic}", s.kafkaHandler(s.deleteTopic))
+
+	mux.HandleFunc("GET /data/kafka/v0/clusters/{cluster}/consumer_groups", s.kafkaHandler(s.listConsumerGroups))
+	mux.HandleFunc("POST /data/kafka/v0/clusters/{cluster}/consumer_groups", s.kafkaHandler(s.createConsumerGroup))
+	mux.HandleFunc("DELETE /data/kafka/v0/clusters/{cluster}/consumer_groups", s.kafkaHandler(s.deleteConsumerGroup))
+}
+
+// kafkaHandler locks the Server and looks up the cluster in the request path before calling h.
+func (s *Server) kafkaHandler(h func(http.ResponseWriter, *http.Request, *cluster)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		c, ok := s.clusters[r.PathValue("cluster")]
+		if !ok {
+			writeNotFound(w, "Couldn't find that Kafka cluster.")
+			return
+		}
+
+		h(w, r, c)
+	}
+}
+
+func (s *Server) getCluster(w http.ResponseWriter, r *http.Request, c *cluster) {
+	result := c.Cluster
+	result.Topics = []string{}
+	for _, t := range c.topics {
+		result.Topics = append(result.Topics, t.GetName())
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (c *cluster) findTopic(name string) *topic {
+	for _, t := range c.topics {
+		if t.GetName() == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func (c *cluster) removeTopic(name string) {
+	var topics []*topic
+	for _, t := range c.topics {
+		if t.GetName() != name {
+			topics = append(topics, t)
+		}
+	}
+	c.topics = topics
+}
+
+func (s *Server) listTopics(w http.ResponseWriter, r *http.Request, c *cluster) {
+	topics := append([]*topic(nil), c.topics...)
+
+	result := &kafka.Topics{
+		AddonAttachmentConfigVar: c.AddonAttachmentConfigVar,
+		Prefix:                   c.TopicPrefix,
+		Topics:                   []*kafka.Topic{},
+		Limits:                   &kafka.TopicLimits{MaxTopics: c.Limits.MaxTopics},
+	}
+	for _, t := range topics {
+		v := t.Topic
+		result.Topics = append(result.Topics, &v)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+
+	for _, t := range topics {
+		t.pending = t.pending.settle()
+	}
+}
+
+// topicRequest represents the request body to create or update a topic.
+type topicRequest struct {
+	Topic *kafka.TopicRequest `json:"topic"`
+}
+
+// validateTopic returns an error message if the topic request exceeds the cluster limits.
+func (c *cluster) validateTopic(opts *kafka.TopicRequest) string {
+	l := c.Limits
+
+	switch {
+	case !topicNameRegex.MatchString(opts.Name):
+		return fmt.Sprintf("Topic name %q must only contain ASCII alphanumerics, '.', '_', and '-'.", opts.Name)
+	case l.MinimumReplication != nil && opts.ReplicationFactor < *l.MinimumReplication,
+		l.MaximumReplication != nil && opts.ReplicationFactor > *l.MaximumReplication:
+		return fmt.Sprintf("Replication factor must be between %d and %d.",
+			l.GetMinimumReplication(), l.GetMaximumReplication())
+	case opts.Partitions < 1,
+		l.MaxNumberOfPartitionsPerTopic != nil && opts.Partitions > *l.MaxNumberOfPartitionsPerTopic:
+		return fmt.Sprintf("Partition count must be between 1 and %d.", l.GetMaxNumberOfPartitionsPerTopic())
+	case opts.RetentionTimeMS != nil && l.MinimumReplicationMS != nil && int64(*opts.RetentionTimeMS) < *l.MinimumReplicationMS,
+		opts.RetentionTimeMS != nil && l.MaximumReplicationMS != nil && int64(*opts.RetentionTimeMS) > *l.MaximumReplicationMS:
+		return fmt.Sprintf("Retention time must be between %dms and %dms.",
+			l.GetMinimumReplicationMS(), l.GetMaximumReplicationMS())
+	}
+
+	return ""
+}
+
+// applyTopicRequest sets the configurable attributes of t from opts.
+func applyTopicRequest(t *kafka.Topic, opts *kafka.TopicRequest) {
+	t.Partitions = intPtr(opts.Partitions)
+	t.ReplicationFactor = intPtr(opts.ReplicationFactor)
+	t.Compaction = boolPtr(opts.Compaction)
+	t.CompactionEnabled = boolPtr(opts.Compaction)
+	t.RetentionEnabled = boolPtr(opts.RetentionTimeMS != nil)
+	t.RetentionTimeInMS = nil
+	if opts.RetentionTimeMS != nil {
+		t.RetentionTimeInMS = intPtr(*opts.RetentionTimeMS)
+	}
+
+	switch {
+	case opts.Compaction && opts.RetentionTimeMS != nil:
+		t.CleanupPolicy = stringPtr("compact,delete")
+	case opts.Compaction:
+		t.CleanupPolicy = stringPtr("compact")
+	default:
+		t.CleanupPolicy = stringPtr("delete")
+	}
+}
+
+func setTopicStatus(t *kafka.Topic, status kafka.TopicStatus, label string) {
+	t.Status = stringPtr(status.ToString())
+	t.StatusLabel = stringPtr(label)
+}
+
+// createTopic creates a topic that reports no partitions and a `pending` status until it is ready.
+func (s *Server) createTopic(w http.ResponseWriter, r *http.Request, c *cluster) {
+	var body topicRequest
+	if !decode(w, r, &body) {
+		return
+	}
+
+	opts := body.Topic
+	if opts == nil {
+		writeInvalidParams(w, "Topic is required.")
+		return
+	}
+
+	if c.findTopic(opts.Name) != nil {
+		writeInvalidParams(w, "Topic %s already exists.", opts.Name)
+		return
+	}
+
+	if max := c.Limits.MaxNumberOfTopics; max != nil && len(c.topics) >= *max {
+		writeInvalidParams(w, "This cluster already has the maximum number of topics (%d).", *max)
+		return
+	}
+
+	if msg := c.validateTopic(opts); msg != "" {
+		writeInvalidParams(w, "%s", msg)
+		return
+	}
+
+	zero := json.Number("0")
+	t := &topic{Topic: kafka.Topic{
+		Name:               stringPtr(opts.Name),
+		Prefix:             c.TopicPrefix,
+		MessageInPerSecond: &zero,
+		BytesInPerSecond:   &zero,
+		BytesOutPerSecond:  &zero,
+		Partitions:         intPtr(0),
+		DataSize:           intPtr(0),
+	}}
+	setTopicStatus(&t.Topic, kafka.TopicStatuses.PENDING, "Pending")
+	t.pending = s.newTransition(func() {
+		applyTopicRequest(&t.Topic, opts)
+		setTopicStatus(&t.Topic, kafka.TopicStatuses.READY, "Ready")
+	})
+	c.topics = append(c.topics, t)
+
+	writeJSON(w, http.StatusOK, &kafka.Response{
+		ID:      stringPtr(opts.Name),
+		Message: stringPtr(fmt.Sprintf("Topic %s is being created.", opts.Name)),
+	})
+}
+
+// updateTopic reports an `updating` status and the current configuration of the topic until the update is applied.
+func (s *Server) updateTopic(w http.ResponseWriter, r *http.Request, c *cluster) {
+	var body topicRequest
+	if !decode(w, r, &body) {
+		return
+	}
+
+	name := r.PathValue("topic")
+
+	t := c.findTopic(name)
+	if t == nil {
+		writeNotFound(w, "Couldn't find a topic named %s.", name)
+		return
+	}
+
+	opts := body.Topic
+	if opts == nil {
+		writeInvalidParams(w, "Topic is required.")
+		return
+	}
+	opts.Name = name
+
+	if msg := c.validateTopic(opts); msg != "" {
+		writeInvalidParams(w, "%s", msg)
+		return
+	}
+
+	if opts.Partitions < t.GetPartitions() {
+		writeInvalidParams(w, "The partition count of a topic cannot be decreased.")
+		return
+	}
+
+	setTopicStatus(&t.Topic, kafka.TopicStatuses.UPDATING, "Updating")
+	t.pending = s.newTransition(func() {
+		applyTopicRequest(&t.Topic, opts)
+		setTopicStatus(&t.Topic, kafka.TopicStatuses.READY, "Ready")
+	})
+
+	writeJSON(w, http.StatusOK, &kafka.Response{
+		ID:      stringPtr(name),
+		Message: stringPtr(fmt.Sprintf("Topic %s is being updated.", name)),
+	})
+}
+
+// deleteTopic reports a `deleted` status for the topic until it is removed.
+func (s *Server) deleteTopic(w http.ResponseWriter, r *http.Request, c *cluster) {
+	name := r.PathValue("topic")
+
+	t := c.findTopic(name)
+	if t == nil {
+		writeNotFound(w, "Couldn't find a topic named %s.", name)
+		return
+	}
+
+	setTopicStatus(&t.Topic, kafka.TopicStatuses.DELETED, "Deleted")
+	t.pending = s.newTransition(func() { c.removeTopic(name) })
+
+	writeJSON(w, http.StatusOK, &kafka.Response{
+		ID:      stringPtr(name),
+		Message: stringPtr(fmt.Sprintf("Topic %s is being deleted.", name)),
+	})
+}
+
+// consumerGroupResponse represents a consumer group in the list response.
+type consumerGroupResponse struct {
+	Name string `json:"name"`
+}
+
+// consumerGroupRequest represents the request body to create or delete a consumer group.
+type consumerGroupRequest struct {
+	ConsumerGroup *consumerGroupResponse `json:"consumer_group"`
+}
+
+func (c *cluster) findConsumerGroup(name string) *consumerGroup {
+	for _, g := range c.consumerGroups {
+		if g.name == name {
+			return g
+		}
+	}
+	return nil
+}
+
+func (c *cluster) removeConsumerGroup(name string) {
+	var groups []*consumerGroup
+	for _, g := range c.consumerGroups {
+		if g.name != name {
+			groups = append(groups, g)
+		}
+	}
+	c.consumerGroups = groups
+}
+
+// listConsumerGroups lists the consumer groups that have been created. Groups that are still being created are omitted.
+func (s *Server) listConsumerGroups(w http.ResponseWriter, r *http.Request, c *cluster) {
+	groups := append([]*consumerGroup(nil), c.consumerGroups...)
+
+	result := struct {
+		AddonAttachmentConfigVar *string                  `json:"attachment_name"`
+		ConsumerGroups           []*consumerGroupResponse `json:"consumer_groups"`
+	}{
+		AddonAttachmentConfigVar: c.AddonAttachmentConfigVar,
+		ConsumerGroups:           []*consumerGroupResponse{},
+	}
+	for _, g := range groups {
+		if g.created {
+			result.ConsumerGroups = append(result.ConsumerGroups, &consumerGroupResponse{Name: g.name})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, result)
+
+	for _, g := range groups {
+		g.pending = g.pending.settle()
+	}
+}
+
+// createConsumerGroup creates a consumer group. Requests to create an existing group are a no-op.
+func (s *Server) createConsumerGroup(w http.ResponseWriter, r *http.Request, c *cluster) {
+	var body consumerGroupRequest
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.ConsumerGroup == nil || body.ConsumerGroup.Name == "" {
+		writeInvalidParams(w, "Consumer group name is required.")
+		return
+	}
+
+	name := body.ConsumerGroup.Name
+	if c.findConsumerGroup(name) == nil {
+		g := &consumerGroup{name: name}
+		g.pending = s.newTransition(func() { g.created = true })
+		c.consumerGroups = append(c.consumerGroups, g)
+	}
+
+	writeJSON(w, http.StatusOK, &kafka.Response{
+		ID:      stringPtr(name),
+		Message: stringPtr(fmt.Sprintf("Consumer group %s is being created.", name)),
+	})
+}
+
+func (s *Server) deleteConsumerGroup(w http.ResponseWriter, r *http.Request, c *cluster) {
+	var body consumerGroupRequest
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.ConsumerGroup == nil || body.ConsumerGroup.Name == "" {
+		writeInvalidParams(w, "Consumer group name is required.")
+		return
+	}
+
+	name := body.ConsumerGroup.Name
+
+	g := c.findConsumerGroup(name)
+	if g == nil {
+		writeNotFound(w, "Couldn't find a consumer group named %s.", name)
+		return
+	}
+
+	g.pending = s.newTransition(func() { c.removeConsumerGroup(name) })
+
+	writeJSON(w, http.StatusOK, &kafka.Response{
+		ID:      stringPtr(name),
+		Message: stringPtr(fmt.Sprintf("Consumer group %s is being deleted.", name)),
+	})
+}
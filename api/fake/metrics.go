@@ -0,0 +1,180 @@
+package fake
+
+import (
+	"encoding/json"
+	"github.com/davidji99/terraform-provider-herokux/api/metrics"
+	"net/http"
+)
+
+// monitorRequest represents the union of the formation autoscaling and alert request bodies.
+type monitorRequest struct {
+	IsActive             *bool                               `json:"is_active"`
+	MinQuantity          *int                                `json:"min_quantity"`
+	MaxQuantity          *int                                `json:"max_quantity"`
+	NotificationPeriod   *int                                `json:"notification_period"`
+	Value                *json.Number                        `json:"value"`
+	Period               *int                                `json:"period"`
+	ActionType           *metrics.FormationMonitorActionType `json:"action_type"`
+	Operation            *string                             `json:"op"`
+	Name                 *metrics.FormationMonitorName       `json:"name"`
+	NotificationChannels []string                            `json:"notification_channels"`
+}
+
+func (s *Server) registerMetrics(mux *http.ServeMux) {
+	mux.HandleFunc("GET /apps/{app}/formation/{process}/monitors", s.listMonitors)
+	mux.HandleFunc("POST /apps/{app}/formation/{process}/monitors", s.createMonitor)
+	mux.HandleFunc("GET /apps/{app}/formation/{process}/monitors/{id}", s.getMonitor)
+	mux.HandleFunc("PATCH /apps/{app}/formation/{process}/monitors/{id}", s.updateMonitor)
+	mux.HandleFunc("DELETE /apps/{app}/formation/{process}/monitors/{id}", s.deleteMonitor)
+}
+
+func monitorsKey(r *http.Request) string {
+	return r.PathValue("app") + "/" + r.PathValue("process")
+}
+
+func (s *Server) findMonitor(r *http.Request) *metrics.FormationMonitor {
+	for _, m := range s.monitors[monitorsKey(r)] {
+		if m.GetID() == r.PathValue("id") {
+			return m
+		}
+	}
+	return nil
+}
+
+// applyMonitorRequest sets the attributes of m that are present in the request.
+func applyMonitorRequest(m *metrics.FormationMonitor, req *monitorRequest) {
+	if req.IsActive != nil {
+		m.IsActive = req.IsActive
+	}
+	if req.MinQuantity != nil {
+		m.MinQuantity = req.MinQuantity
+	}
+	if req.MaxQuantity != nil {
+		m.MaxQuantity = req.MaxQuantity
+	}
+	if req.NotificationPeriod != nil {
+		m.NotificationPeriod = req.NotificationPeriod
+	}
+	if req.Value != nil {
+		m.Value = req.Value
+	}
+	if req.Period != nil {
+		m.Period = req.Period
+	}
+	if req.ActionType != nil {
+		m.ActionType = req.ActionType
+	}
+	if req.Operation != nil {
+		m.Operation = req.Operation
+	}
+	if req.NotificationChannels != nil {
+		m.NotificationChannels = req.NotificationChannels
+	}
+}
+
+// listMonitors lists the monitors of a formation. Like the Metrics API, it returns an empty list for unknown apps.
+func (s *Server) listMonitors(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]*metrics.FormationMonitor, 0)
+	result = append(result, s.monitors[monitorsKey(r)]...)
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// getMonitor returns a single monitor. Like the Metrics API, the response content type is text/plain.
+func (s *Server) getMonitor(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := s.findMonitor(r)
+	if m == nil {
+		writeNotFound(w, "Couldn't find that monitor.")
+		return
+	}
+
+	writeContent(w, "text/plain; charset=utf-8", http.StatusOK, m)
+}
+
+// createMonitor creates a monitor. Like the Metrics API, the response body only has the monitor ID.
+func (s *Server) createMonitor(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var req monitorRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Name == nil || req.ActionType == nil {
+		writeInvalidParams(w, "Monitor name and action type are required.")
+		return
+	}
+
+	key := monitorsKey(r)
+	for _, m := range s.monitors[key] {
+		if *m.GetName() == *req.Name {
+			writeError(w, http.StatusConflict, "conflict", "A %s monitor already exists for this formation.",
+				req.Name.ToString())
+			return
+		}
+	}
+
+	m := &metrics.FormationMonitor{
+		ID:          stringPtr(s.newID()),
+		AppID:       stringPtr(r.PathValue("app")),
+		MetricUUID:  stringPtr(s.newID()),
+		ProcessType: stringPtr(r.PathValue("process")),
+		Name:        req.Name,
+		IsActive:    boolPtr(false),
+		State:       stringPtr("OK"),
+	}
+	applyMonitorRequest(m, &req)
+	s.monitors[key] = append(s.monitors[key], m)
+
+	writeJSON(w, http.StatusCreated, &metrics.FormationMonitor{ID: m.ID})
+}
+
+// updateMonitor updates a monitor. Like the Metrics API, it returns 202 Accepted without a response body.
+func (s *Server) updateMonitor(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var req monitorRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	m := s.findMonitor(r)
+	if m == nil {
+		writeNotFound(w, "Couldn't find that monitor.")
+		return
+	}
+
+	applyMonitorRequest(m, &req)
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) deleteMonitor(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.findMonitor(r) == nil {
+		writeNotFound(w, "Couldn't find that monitor.")
+		return
+	}
+
+	key := monitorsKey(r)
+
+	var monitors []*metrics.FormationMonitor
+	for _, m := range s.monitors[key] {
+		if m.GetID() != r.PathValue("id") {
+			monitors = append(monitors, m)
+		}
+	}
+	s.monitors[key] = monitors
+
+	w.WriteHeader(http.StatusAccepted)
+}
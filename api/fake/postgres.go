@@ -0,0 +1,489 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/general"
+	"github.com/davidji99/terraform-provider-herokux/api/postgres"
+	"net/http"
+	"strconv"
+)
+
+// database represents the state of a Heroku Postgres database.
+type database struct {
+	name        string
+	credentials []*credential
+	mtls        *mtls
+	ipRules     []*ipRule
+	schedule    *postgres.BackupSchedule
+	links       []*postgres.DataLink
+}
+
+type credential struct {
+	postgres.Credential
+	pending *transition
+}
+
+type mtls struct {
+	postgres.MTLS
+	pending *transition
+}
+
+type ipRule struct {
+	general.MtlsIPRule
+	pending *transition
+}
+
+// AddPostgresDatabase adds a Heroku Postgres database with a `default` credential to the Server.
+func (s *Server) AddPostgresDatabase(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db := &database{name: name}
+	db.credentials = append(db.credentials, &credential{Credential: db.newCredential("default")})
+	db.credentials[0].State = postgres.CredentialStates.ACTIVE
+	db.credentials[0].Credentials[0].State = stringPtr(postgres.CredentialStates.ACTIVE.ToString())
+
+	s.databases[name] = db
+}
+
+func (db *database) newCredential(name string) postgres.Credential {
+	return postgres.Credential{
+		ID:       stringPtr(fmt.Sprintf("%s-%s", db.name, name)),
+		Name:     stringPtr(name),
+		State:    postgres.CredentialStates.PROVISIONING,
+		Database: stringPtr(db.name),
+		Host:     stringPtr(fmt.Sprintf("%s.compute-1.amazonaws.com", db.name)),
+		Port:     intPtr(5432),
+		Credentials: []*postgres.CredentialSecret{
+			{
+				User:     stringPtr(name),
+				Password: stringPtr(fmt.Sprintf("%s-password", name)),
+				State:    stringPtr(postgres.CredentialStates.PROVISIONING.ToString()),
+			},
+		},
+	}
+}
+
+func (s *Server) registerPostgres(mux *http.ServeMux) {
+	mux.HandleFunc("GET /postgres/v0/databases/{db}/credentials", s.postgresHandler(s.listCredentials))
+	mux.HandleFunc("POST /postgres/v0/databases/{db}/credentials", s.postgresHandler(s.createCredential))
+	mux.HandleFunc("GET /postgres/v0/databases/{db}/credentials/{name}", s.postgresHandler(s.getCredential))
+	mux.HandleFunc("DELETE /postgres/v0/databases/{db}/credentials/{name}", s.postgresHandler(s.deleteCredential))
+
+	mux.HandleFunc("GET /postgres/v0/databases/{db}/tls-endpoint", s.postgresHandler(s.getMTLS))
+	mux.HandleFunc("POST /postgres/v0/databases/{db}/tls-endpoint", s.postgresHandler(s.provisionMTLS))
+	mux.HandleFunc("DELETE /postgres/v0/databases/{db}/tls-endpoint", s.postgresHandler(s.deprovisionMTLS))
+
+	mux.HandleFunc("GET /postgres/v0/databases/{db}/tls-endpoint/ip-rules", s.postgresHandler(s.listIPRules))
+	mux.HandleFunc("POST /postgres/v0/databases/{db}/tls-endpoint/ip-rules", s.postgresHandler(s.createIPRule))
+	mux.HandleFunc("GET /postgres/v0/databases/{db}/tls-endpoint/ip-rules/{id}", s.postgresHandler(s.getIPRule))
+	mux.HandleFunc("DELETE /postgres/v0/databases/{db}/tls-endpoint/ip-rules/{id}", s.postgresHandler(s.deleteIPRule))
+
+	mux.HandleFunc("GET /client/v11/databases/{db}/transfer-schedules", s.postgresHandler(s.listBackupSchedules))
+	mux.HandleFunc("POST /client/v11/databases/{db}/transfer-schedules", s.postgresHandler(s.createBackupSchedule))
+	mux.HandleFunc("DELETE /client/v11/databases/{db}/transfer-schedules/{id}", s.postgresHandler(s.deleteBackupSchedule))
+
+	mux.HandleFunc("GET /client/v11/databases/{db}/links", s.postgresHandler(s.listDataLinks))
+	mux.HandleFunc("POST /client/v11/databases/{db}/links", s.postgresHandler(s.createDataLink))
+	mux.HandleFunc("DELETE /client/v11/databases/{db}/links/{name}", s.postgresHandler(s.deleteDataLink))
+}
+
+// postgresHandler locks the Server and looks up the database in the request path before calling h.
+func (s *Server) postgresHandler(h func(http.ResponseWriter, *http.Request, *database)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		db, ok := s.databases[r.PathValue("db")]
+		if !ok {
+			writeNotFound(w, "Couldn't find that database.")
+			return
+		}
+
+		h(w, r, db)
+	}
+}
+
+func (db *database) findCredential(name string) *credential {
+	for _, c := range db.credentials {
+		if c.GetName() == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func (db *database) removeCredential(name string) {
+	var credentials []*credential
+	for _, c := range db.credentials {
+		if c.GetName() != name {
+			credentials = append(credentials, c)
+		}
+	}
+	db.credentials = credentials
+}
+
+func (s *Server) listCredentials(w http.ResponseWriter, r *http.Request, db *database) {
+	credentials := append([]*credential(nil), db.credentials...)
+
+	result := make([]postgres.Credential, 0, len(credentials))
+	for _, c := range credentials {
+		result = append(result, c.Credential)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+
+	for _, c := range credentials {
+		c.pending = c.pending.settle()
+	}
+}
+
+func (s *Server) getCredential(w http.ResponseWriter, r *http.Request, db *database) {
+	c := db.findCredential(r.PathValue("name"))
+	if c == nil {
+		writeNotFound(w, "Couldn't find that credential.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, c.Credential)
+	c.pending = c.pending.settle()
+}
+
+func (s *Server) createCredential(w http.ResponseWriter, r *http.Request, db *database) {
+	var body struct {
+		Name string `json:"name"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.Name == "" {
+		writeInvalidParams(w, "Credential name is required.")
+		return
+	}
+
+	if db.findCredential(body.Name) != nil {
+		writeInvalidParams(w, "Credential %s already exists.", body.Name)
+		return
+	}
+
+	c := &credential{Credential: db.newCredential(body.Name)}
+	c.pending = s.newTransition(func() {
+		c.State = postgres.CredentialStates.ACTIVE
+		c.Credentials[0].State = stringPtr(postgres.CredentialStates.ACTIVE.ToString())
+	})
+	db.credentials = append(db.credentials, c)
+
+	writeJSON(w, http.StatusCreated, &postgres.GenericResponse{
+		ID:      stringPtr(db.name),
+		Message: stringPtr(fmt.Sprintf("Credential %s was created.", body.Name)),
+	})
+}
+
+func (s *Server) deleteCredential(w http.ResponseWriter, r *http.Request, db *database) {
+	name := r.PathValue("name")
+
+	c := db.findCredential(name)
+	if c == nil {
+		writeNotFound(w, "Couldn't find that credential.")
+		return
+	}
+
+	if name == "default" {
+		writeInvalidParams(w, "You cannot destroy the default credential.")
+		return
+	}
+
+	c.State = postgres.CredentialStates.REVOKING
+	c.Credentials[0].State = stringPtr(postgres.CredentialStates.REVOKING.ToString())
+	c.pending = s.newTransition(func() { db.removeCredential(name) })
+
+	writeJSON(w, http.StatusOK, &postgres.GenericResponse{
+		ID: stringPtr(db.name),
+		Message: stringPtr(fmt.Sprintf("The credential %s has been destroyed within %s and detached from all apps.",
+			name, db.name)),
+	})
+}
+
+// activeIPRules returns the authorized IP rules of the database.
+func (db *database) activeIPRules() []general.MtlsIPRule {
+	var rules []general.MtlsIPRule
+	for _, rule := range db.ipRules {
+		if *rule.GetStatus() == general.MTLSIPRuleStatuses.AUTHORIZED {
+			rules = append(rules, rule.MtlsIPRule)
+		}
+	}
+	return rules
+}
+
+func (s *Server) getMTLS(w http.ResponseWriter, r *http.Request, db *database) {
+	if db.mtls == nil {
+		writeNotFound(w, "MTLS is not enabled for %s.", db.name)
+		return
+	}
+
+	result := db.mtls.MTLS
+	result.ActiveIPRules = db.activeIPRules()
+
+	writeJSON(w, http.StatusOK, result)
+
+	m := db.mtls
+	m.pending = m.pending.settle()
+}
+
+func (s *Server) provisionMTLS(w http.ResponseWriter, r *http.Request, db *database) {
+	if db.mtls != nil {
+		writeInvalidParams(w, "MTLS is already enabled for %s.", db.name)
+		return
+	}
+
+	provisioning := postgres.MTLSConfigStatuses.PROVISIONING
+	m := &mtls{MTLS: postgres.MTLS{
+		App:                       stringPtr(fmt.Sprintf("%s-app", db.name)),
+		Addon:                     stringPtr(db.name),
+		Status:                    &provisioning,
+		EnabledBy:                 stringPtr("user@example.com"),
+		CertificateAuthorityChain: stringPtr("-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----\n"),
+	}}
+	m.pending = s.newTransition(func() {
+		operational := postgres.MTLSConfigStatuses.OPERATIONAL
+		m.Status = &operational
+	})
+	db.mtls = m
+
+	writeJSON(w, http.StatusCreated, m.MTLS)
+}
+
+func (s *Server) deprovisionMTLS(w http.ResponseWriter, r *http.Request, db *database) {
+	if db.mtls == nil {
+		writeNotFound(w, "MTLS is not enabled for %s.", db.name)
+		return
+	}
+
+	deprovisioning := postgres.MTLSConfigStatuses.DEPROVISIONING
+
+	m := db.mtls
+	m.Status = &deprovisioning
+	m.pending = s.newTransition(func() {
+		db.mtls = nil
+		db.ipRules = nil
+	})
+
+	writeJSON(w, http.StatusAccepted, m.MTLS)
+}
+
+func (db *database) findIPRule(id string) *ipRule {
+	for _, rule := range db.ipRules {
+		if rule.GetID() == id {
+			return rule
+		}
+	}
+	return nil
+}
+
+func (s *Server) listIPRules(w http.ResponseWriter, r *http.Request, db *database) {
+	result := make([]general.MtlsIPRule, 0, len(db.ipRules))
+	for _, rule := range db.ipRules {
+		result = append(result, rule.MtlsIPRule)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+
+	for _, rule := range db.ipRules {
+		rule.pending = rule.pending.settle()
+	}
+}
+
+func (s *Server) getIPRule(w http.ResponseWriter, r *http.Request, db *database) {
+	rule := db.findIPRule(r.PathValue("id"))
+	if rule == nil {
+		writeNotFound(w, "Couldn't find that IP rule.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, rule.MtlsIPRule)
+	rule.pending = rule.pending.settle()
+}
+
+func (s *Server) createIPRule(w http.ResponseWriter, r *http.Request, db *database) {
+	var body general.MTLSIPRuleRequest
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if db.mtls == nil {
+		writeInvalidParams(w, "MTLS must be enabled for %s before adding IP rules.", db.name)
+		return
+	}
+
+	if body.CIDR == "" {
+		writeInvalidParams(w, "CIDR is required.")
+		return
+	}
+
+	authorizing := general.MTLSIPRuleStatuses.AUTHORIZING
+	rule := &ipRule{MtlsIPRule: general.MtlsIPRule{
+		ID:          stringPtr(s.newID()),
+		CIDR:        stringPtr(body.CIDR),
+		Description: stringPtr(body.Description),
+		Status:      &authorizing,
+		CreatedAt:   timestamp(),
+		UpdatedAt:   timestamp(),
+	}}
+	rule.pending = s.newTransition(func() {
+		authorized := general.MTLSIPRuleStatuses.AUTHORIZED
+		rule.Status = &authorized
+		rule.UpdatedAt = timestamp()
+	})
+	db.ipRules = append(db.ipRules, rule)
+
+	writeJSON(w, http.StatusCreated, rule.MtlsIPRule)
+}
+
+func (s *Server) deleteIPRule(w http.ResponseWriter, r *http.Request, db *database) {
+	id := r.PathValue("id")
+	if db.findIPRule(id) == nil {
+		writeNotFound(w, "Couldn't find that IP rule.")
+		return
+	}
+
+	var rules []*ipRule
+	for _, rule := range db.ipRules {
+		if rule.GetID() != id {
+			rules = append(rules, rule)
+		}
+	}
+	db.ipRules = rules
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listBackupSchedules(w http.ResponseWriter, r *http.Request, db *database) {
+	result := make([]*postgres.BackupSchedule, 0, 1)
+	if db.schedule != nil {
+		result = append(result, db.schedule)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// createBackupSchedule creates the backup schedule of the database or replaces the existing one.
+func (s *Server) createBackupSchedule(w http.ResponseWriter, r *http.Request, db *database) {
+	var body struct {
+		Hour     json.Number `json:"hour"`
+		Days     []string    `json:"days,omitempty"`
+		Timezone string      `json:"timezone"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if hour, err := strconv.Atoi(body.Hour.String()); err != nil || hour < 0 || hour > 23 {
+		writeInvalidParams(w, "Hour must be between 0 and 23.")
+		return
+	}
+
+	if body.Timezone == "" {
+		body.Timezone = "UTC"
+	}
+
+	if len(body.Days) == 0 {
+		body.Days = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	}
+
+	statusCode := http.StatusOK
+	if db.schedule == nil {
+		statusCode = http.StatusCreated
+		db.schedule = &postgres.BackupSchedule{
+			ID:           stringPtr(s.newID()),
+			Name:         stringPtr("DATABASE_URL"),
+			CreatedAt:    timestamp(),
+			RetainWeeks:  intPtr(5),
+			RetainMonths: intPtr(0),
+		}
+	}
+
+	db.schedule.Hour = &body.Hour
+	db.schedule.Days = body.Days
+	db.schedule.Timezone = stringPtr(body.Timezone)
+	db.schedule.UpdatedAt = timestamp()
+
+	writeJSON(w, statusCode, db.schedule)
+}
+
+func (s *Server) deleteBackupSchedule(w http.ResponseWriter, r *http.Request, db *database) {
+	if db.schedule == nil || db.schedule.GetID() != r.PathValue("id") {
+		writeNotFound(w, "Couldn't find that backup schedule.")
+		return
+	}
+
+	db.schedule = nil
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listDataLinks(w http.ResponseWriter, r *http.Request, db *database) {
+	result := make([]*postgres.DataLink, 0, len(db.links))
+	result = append(result, db.links...)
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) createDataLink(w http.ResponseWriter, r *http.Request, db *database) {
+	var body postgres.DataLinkCreateOpts
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.Remote == "" {
+		writeInvalidParams(w, "Target is required.")
+		return
+	}
+
+	name := body.Name
+	if name == "" {
+		name = body.Remote
+	}
+
+	for _, l := range db.links {
+		if l.GetName() == name {
+			writeInvalidParams(w, "A data link named %s already exists.", name)
+			return
+		}
+	}
+
+	link := &postgres.DataLink{
+		ID:         stringPtr(s.newID()),
+		CreatedAt:  now(),
+		RemoteName: stringPtr(body.Remote),
+		Remote: &postgres.DataLinkRemote{
+			Name:           stringPtr(body.Remote),
+			AttachmentName: stringPtr(body.Remote),
+		},
+		Name: stringPtr(name),
+	}
+	db.links = append(db.links, link)
+
+	writeJSON(w, http.StatusCreated, link)
+}
+
+func (s *Server) deleteDataLink(w http.ResponseWriter, r *http.Request, db *database) {
+	name := r.PathValue("name")
+
+	var links []*postgres.DataLink
+	for _, l := range db.links {
+		if l.GetName() != name {
+			links = append(links, l)
+		}
+	}
+
+	if len(links) == len(db.links) {
+		writeNotFound(w, "Couldn't find a data link named %s.", name)
+		return
+	}
+
+	db.links = links
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,107 @@
+package fake
+
+import (
+	"github.com/davidji99/terraform-provider-herokux/api/redis"
+	"net/http"
+)
+
+// maxmemoryPolicies are the eviction policies accepted by the Redis API.
+var maxmemoryPolicies = []string{"noeviction", "allkeys-lru", "volatile-lru", "allkeys-random", "volatile-random",
+	"volatile-ttl", "allkeys-lfu", "volatile-lfu"}
+
+// redisDatabase represents the state of a Heroku Redis database.
+type redisDatabase struct {
+	config *redis.Config
+}
+
+// AddRedisDatabase adds a Heroku Redis database with the default configuration to the Server.
+func (s *Server) AddRedisDatabase(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.redisDatabases[id] = &redisDatabase{config: &redis.Config{
+		MaxmemoryPolicy: &redis.ConfigMaxmemory{
+			Value:       stringPtr("noeviction"),
+			Description: stringPtr("Return errors when memory limit is reached."),
+			Default:     stringPtr("noeviction"),
+		},
+		NotifyKeyspaceEvents: &redis.ConfigNotifyKeyspaceEvents{
+			Value:       stringPtr(""),
+			Description: stringPtr("Keyspace notifications are disabled."),
+			Default:     stringPtr(""),
+		},
+		Timeout: &redis.ConfigTimeout{
+			Value:       intPtr(300),
+			Description: stringPtr("Connections that idle for 300 seconds are closed."),
+			Default:     intPtr(300),
+		},
+		StandbySegvWorkaround: &redis.ConfigStandbySegvWorkaround{
+			Value:       boolPtr(false),
+			Description: stringPtr("Standby segfault workaround is disabled."),
+			Default:     boolPtr(false),
+		},
+	}}
+}
+
+func (s *Server) registerRedis(mux *http.ServeMux) {
+	mux.HandleFunc("GET /redis/v0/databases/{db}/config", s.getRedisConfig)
+	mux.HandleFunc("PATCH /redis/v0/databases/{db}/config", s.updateRedisConfig)
+}
+
+func (s *Server) getRedisConfig(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.redisDatabases[r.PathValue("db")]
+	if !ok {
+		writeNotFound(w, "Couldn't find that Redis database.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, db.config)
+}
+
+func (s *Server) updateRedisConfig(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var req redis.ConfigUpdateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	db, ok := s.redisDatabases[r.PathValue("db")]
+	if !ok {
+		writeNotFound(w, "Couldn't find that Redis database.")
+		return
+	}
+
+	if req.MaxmemoryPolicy != "" {
+		valid := false
+		for _, p := range maxmemoryPolicies {
+			valid = valid || p == req.MaxmemoryPolicy
+		}
+
+		if !valid {
+			writeInvalidParams(w, "%s is not a valid maxmemory_policy.", req.MaxmemoryPolicy)
+			return
+		}
+
+		db.config.MaxmemoryPolicy.Value = stringPtr(req.MaxmemoryPolicy)
+	}
+
+	if req.NotifyKeyspaceEvents != nil {
+		db.config.NotifyKeyspaceEvents.Value = stringPtr(*req.NotifyKeyspaceEvents)
+	}
+
+	if req.Timeout != nil {
+		if *req.Timeout < 0 {
+			writeInvalidParams(w, "timeout must be greater than or equal to 0.")
+			return
+		}
+
+		db.config.Timeout.Value = intPtr(*req.Timeout)
+	}
+
+	writeJSON(w, http.StatusOK, db.config)
+}
@@ -0,0 +1,171 @@
+package fake
+
+import (
+	"github.com/davidji99/terraform-provider-herokux/api/scheduler"
+	"net/http"
+)
+
+// jsonAPIContentType is the content type of the Scheduler API, which follows the JSON:API specification.
+const jsonAPIContentType = "application/vnd.api+json"
+
+// jobRequest represents the JSON:API request body to create or update a job.
+type jobRequest struct {
+	Data struct {
+		Type       string                         `json:"type"`
+		Attributes *scheduler.JobAttributeRequest `json:"attributes"`
+	} `json:"data"`
+}
+
+func (s *Server) registerScheduler(mux *http.ServeMux) {
+	mux.HandleFunc("GET /apps/{app}/jobs", s.listJobs)
+	mux.HandleFunc("POST /apps/{app}/jobs", s.createJob)
+	mux.HandleFunc("PATCH /apps/{app}/jobs/{id}", s.updateJob)
+	mux.HandleFunc("DELETE /apps/{app}/jobs/{id}", s.deleteJob)
+}
+
+func (s *Server) findJob(r *http.Request) *scheduler.Job {
+	for _, j := range s.jobs[r.PathValue("app")] {
+		if j.GetID() == r.PathValue("id") {
+			return j
+		}
+	}
+	return nil
+}
+
+// validateJob returns an error message if the `every` and `at` attributes are not a valid schedule.
+func validateJob(attrs *scheduler.JobAttributes) string {
+	switch attrs.GetEvery() {
+	case 10:
+		if attrs.GetAt() != 0 {
+			return "at must be 0 for jobs that run every 10 minutes"
+		}
+	case 60:
+		if attrs.GetAt()%10 != 0 || attrs.GetAt() > 50 {
+			return "at must be one of 0, 10, 20, 30, 40 or 50 for hourly jobs"
+		}
+	case 1440:
+		if attrs.GetAt()%30 != 0 || attrs.GetAt() > 1410 {
+			return "at must be a multiple of 30 between 0 and 1410 for daily jobs"
+		}
+	default:
+		return "every must be one of 10, 60 or 1440"
+	}
+
+	if attrs.GetCommand() == "" {
+		return "command can't be blank"
+	}
+
+	return ""
+}
+
+func (s *Server) listJobs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := &scheduler.Jobs{Data: []*scheduler.Job{}}
+	result.Data = append(result.Data, s.jobs[r.PathValue("app")]...)
+
+	writeContent(w, jsonAPIContentType, http.StatusOK, result)
+}
+
+func (s *Server) createJob(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var body jobRequest
+	if !decode(w, r, &body) {
+		return
+	}
+
+	req := body.Data.Attributes
+	if req == nil {
+		writeInvalidParams(w, "attributes are required")
+		return
+	}
+
+	dynoSize := req.DynoSize
+	if dynoSize == "" {
+		dynoSize = "Standard-1X"
+	}
+
+	attrs := &scheduler.JobAttributes{
+		Every:     intPtr(req.Every),
+		At:        intPtr(req.At),
+		DynoSize:  stringPtr(dynoSize),
+		Command:   stringPtr(req.Command),
+		CreatedAt: now(),
+	}
+	if msg := validateJob(attrs); msg != "" {
+		writeInvalidParams(w, "%s", msg)
+		return
+	}
+
+	app := r.PathValue("app")
+	j := &scheduler.Job{Type: stringPtr("jobs"), ID: stringPtr(s.newID()), Attributes: attrs}
+	s.jobs[app] = append(s.jobs[app], j)
+
+	writeContent(w, jsonAPIContentType, http.StatusCreated, &scheduler.JobModifyResponse{Data: j})
+}
+
+func (s *Server) updateJob(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var body jobRequest
+	if !decode(w, r, &body) {
+		return
+	}
+
+	j := s.findJob(r)
+	if j == nil {
+		writeNotFound(w, "Couldn't find that job.")
+		return
+	}
+
+	req := body.Data.Attributes
+	if req == nil {
+		writeInvalidParams(w, "attributes are required")
+		return
+	}
+
+	attrs := *j.Attributes
+	attrs.Every = intPtr(req.Every)
+	attrs.At = intPtr(req.At)
+	if req.Command != "" {
+		attrs.Command = stringPtr(req.Command)
+	}
+	if req.DynoSize != "" {
+		attrs.DynoSize = stringPtr(req.DynoSize)
+	}
+
+	if msg := validateJob(&attrs); msg != "" {
+		writeInvalidParams(w, "%s", msg)
+		return
+	}
+
+	j.Attributes = &attrs
+
+	writeContent(w, jsonAPIContentType, http.StatusOK, &scheduler.JobModifyResponse{Data: j})
+}
+
+func (s *Server) deleteJob(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.findJob(r) == nil {
+		writeNotFound(w, "Couldn't find that job.")
+		return
+	}
+
+	app := r.PathValue("app")
+
+	var jobs []*scheduler.Job
+	for _, j := range s.jobs[app] {
+		if j.GetID() != r.PathValue("id") {
+			jobs = append(jobs, j)
+		}
+	}
+	s.jobs[app] = jobs
+
+	w.WriteHeader(http.StatusNoContent)
+}
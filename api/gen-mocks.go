@@ -0,0 +1,268 @@
+//go:build ignore
+// +build ignore
+
+// gen-mocks generates a mock for every service interface in services.go.
+//
+// It is meant to be used from the api directory:
+//
+//	go run gen-mocks.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	sourceFile = "services.go"
+	outputDir  = "mock"
+	outputFile = "mock.go"
+	apiImport  = "github.com/davidji99/terraform-provider-herokux/api"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Funcs(template.FuncMap{"base": filepath.Base}).Parse(source))
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, sourceFile, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	t := &templateData{Imports: map[string]string{"api": apiImport}}
+	if err := t.processAST(fset, f); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := t.dump(); err != nil {
+		log.Fatal(err)
+	}
+	logf("Done.")
+}
+
+func (t *templateData) processAST(fset *token.FileSet, f *ast.File) error {
+	imports := map[string]string{}
+	for _, spec := range f.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return err
+		}
+
+		// Imports of major versions, such as heroku-go/v5, are expected to be named.
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+
+			logf("Processing %v...", ts.Name)
+			m := &mock{Name: ts.Name.Name}
+			for _, field := range it.Methods.List {
+				ft, ok := field.Type.(*ast.FuncType)
+				if !ok || len(field.Names) == 0 {
+					return fmt.Errorf("%v: embedded interfaces are not supported", ts.Name)
+				}
+
+				method, err := newMethod(fset, field.Names[0].Name, ft)
+				if err != nil {
+					return err
+				}
+				m.Methods = append(m.Methods, method)
+
+				ast.Inspect(ft, func(n ast.Node) bool {
+					if se, ok := n.(*ast.SelectorExpr); ok {
+						if x, ok := se.X.(*ast.Ident); ok {
+							if path, ok := imports[x.Name]; ok {
+								t.Imports[x.Name] = path
+							}
+						}
+					}
+					return true
+				})
+			}
+
+			t.Mocks = append(t.Mocks, m)
+		}
+	}
+
+	return nil
+}
+
+func newMethod(fset *token.FileSet, name string, ft *ast.FuncType) (*method, error) {
+	m := &method{Name: name}
+
+	var params, args []string
+	for i, field := range ft.Params.List {
+		typ, err := exprString(fset, field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, n := range names {
+			params = append(params, n.Name+" "+typ)
+			args = append(args, n.Name)
+		}
+	}
+
+	var results []string
+	if ft.Results != nil {
+		for _, field := range ft.Results.List {
+			typ, err := exprString(fset, field.Type)
+			if err != nil {
+				return nil, err
+			}
+			for range max(len(field.Names), 1) {
+				results = append(results, typ)
+			}
+		}
+	}
+
+	m.Params = strings.Join(params, ", ")
+	m.Args = strings.Join(args, ", ")
+	switch len(results) {
+	case 0:
+	case 1:
+		m.Results = results[0]
+	default:
+		m.Results = "(" + strings.Join(results, ", ") + ")"
+	}
+	m.Return = len(results) > 0
+
+	return m, nil
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) (string, error) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (t *templateData) dump() error {
+	if len(t.Mocks) == 0 {
+		logf("No service interfaces in %v; skipping.", sourceFile)
+		return nil
+	}
+
+	sort.Slice(t.Mocks, func(i, j int) bool { return t.Mocks[i].Name < t.Mocks[j].Name })
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+
+	logf("Writing %v...", outputFile)
+	return ioutil.WriteFile(filepath.Join(outputDir, outputFile), clean, 0644)
+}
+
+type templateData struct {
+	Imports map[string]string
+	Mocks   []*mock
+}
+
+type mock struct {
+	Name    string
+	Methods []*method
+}
+
+type method struct {
+	Name    string
+	Params  string // The parameters with their names and types.
+	Args    string // The parameter names.
+	Results string
+	Return  bool
+}
+
+const source = `// Code generated by gen-mocks; DO NOT EDIT.
+
+// Package mock provides mocks of the api service interfaces for unit tests.
+//
+// Each mock method calls the function field named after the method with a Func suffix,
+// and panics if that field is not set.
+package mock
+
+import (
+  {{- range $name, $path := .Imports}}
+  {{if ne $name (base $path)}}{{$name}} {{end}}"{{$path}}"
+  {{- end}}
+)
+
+// Make sure the mocks implement their service interface.
+var (
+{{- range .Mocks}}
+  _ api.{{.Name}} = (*{{.Name}})(nil)
+{{- end}}
+)
+{{range $mock := .Mocks}}
+// {{.Name}} is a mock of api.{{.Name}}.
+type {{.Name}} struct {
+{{- range .Methods}}
+  {{.Name}}Func func({{.Params}}) {{.Results}}
+{{- end}}
+}
+{{range .Methods}}
+// {{.Name}} calls {{.Name}}Func.
+func (m *{{$mock.Name}}) {{.Name}}({{.Params}}) {{.Results}} {
+  if m.{{.Name}}Func == nil {
+    panic("mock: {{$mock.Name}}.{{.Name}} is not implemented")
+  }
+  {{if .Return}}return {{end}}m.{{.Name}}Func({{.Args}})
+}
+{{end}}
+{{end}}
+`
@@ -1,6 +1,7 @@
 package kafka
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
 	"time"
 )
@@ -89,12 +90,12 @@ type ClusterLimitsDataSize struct {
 }
 
 // Get retrieves information about a Kafka cluster.
-func (k *Kafka) Get(clusterID string) (*Cluster, *simpleresty.Response, error) {
+func (k *Kafka) Get(ctx context.Context, clusterID string) (*Cluster, *simpleresty.Response, error) {
 	var result *Cluster
 	urlStr := k.http.RequestURL("/data/kafka/v0/clusters/%s", clusterID)
 
 	// Execute the request
-	response, getErr := k.http.Get(urlStr, &result, nil)
+	response, getErr := k.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
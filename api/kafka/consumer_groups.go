@@ -1,8 +1,9 @@
 package kafka
 
 import (
-	"fmt"
+	"context"
 	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
 )
 
 // ConsumerGroups represents a list of consumer groups.
@@ -17,31 +18,35 @@ type ConsumerGroup struct {
 }
 
 // NewConsumerGroupRequest defines a constructor to create or destroy a consumer group.
-func NewConsumerGroupRequest() *consumerGroupRequest {
-	return &consumerGroupRequest{}
+func NewConsumerGroupRequest() *ConsumerGroupRequest {
+	return &ConsumerGroupRequest{}
 }
 
-type consumerGroupRequest struct {
+// ConsumerGroupRequest represents a request to create or delete a consumer group.
+type ConsumerGroupRequest struct {
 	Name string `json:"name"`
 }
 
 type consumerGroupBody struct {
-	ConsumerGroup *consumerGroupRequest `json:"consumer_group,omitempty"`
+	ConsumerGroup *ConsumerGroupRequest `json:"consumer_group,omitempty"`
 }
 
 // ListConsumerGroups returns a list of all consumer groups.
-func (k *Kafka) ListConsumerGroups(clusterID string) (*ConsumerGroups, *simpleresty.Response, error) {
+func (k *Kafka) ListConsumerGroups(ctx context.Context, clusterID string) (*ConsumerGroups, *simpleresty.Response, error) {
 	var result *ConsumerGroups
 	urlStr := k.http.RequestURL("/data/kafka/v0/clusters/%s/consumer_groups", clusterID)
 
 	// Execute the request
-	response, getErr := k.http.Get(urlStr, &result, nil)
+	response, getErr := k.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
-func (k *Kafka) GetConsumerGroupByName(clusterID, groupName string) (*ConsumerGroup, *simpleresty.Response, error) {
-	groups, _, listErr := k.ListConsumerGroups(clusterID)
+// GetConsumerGroupByName finds a consumer group by its name.
+//
+// Returns an error matching apierror.ErrNotFound if the consumer group does not exist.
+func (k *Kafka) GetConsumerGroupByName(ctx context.Context, clusterID, groupName string) (*ConsumerGroup, *simpleresty.Response, error) {
+	groups, _, listErr := k.ListConsumerGroups(ctx, clusterID)
 	if listErr != nil {
 		return nil, nil, listErr
 	}
@@ -52,14 +57,14 @@ func (k *Kafka) GetConsumerGroupByName(clusterID, groupName string) (*ConsumerGr
 		}
 	}
 
-	return nil, nil, fmt.Errorf("%s not found", groupName)
+	return nil, nil, apierror.NewNotFound("%s not found", groupName)
 }
 
 // CreateConsumerGroup creates a single consumer group.
 //
 // Requests to create duplicate groups result in a no-operation.
 // The group is not ready for use until it appears in the LIST response.
-func (k *Kafka) CreateConsumerGroup(clusterID string, opts *consumerGroupRequest) (*Response, *simpleresty.Response, error) {
+func (k *Kafka) CreateConsumerGroup(ctx context.Context, clusterID string, opts *ConsumerGroupRequest) (*Response, *simpleresty.Response, error) {
 	var result *Response
 	urlStr := k.http.RequestURL("/data/kafka/v0/clusters/%s/consumer_groups", clusterID)
 
@@ -68,13 +73,13 @@ func (k *Kafka) CreateConsumerGroup(clusterID string, opts *consumerGroupRequest
 	}
 
 	// Execute the request
-	response, createErr := k.http.Post(urlStr, &result, reqBody)
+	response, createErr := k.http.Post(ctx, urlStr, &result, reqBody)
 
 	return result, response, createErr
 }
 
 // DeleteConsumerGroup deletes an existing consumer group.
-func (k *Kafka) DeleteConsumerGroup(clusterID string, opts *consumerGroupRequest) (*Response, *simpleresty.Response, error) {
+func (k *Kafka) DeleteConsumerGroup(ctx context.Context, clusterID string, opts *ConsumerGroupRequest) (*Response, *simpleresty.Response, error) {
 	var result *Response
 	urlStr := k.http.RequestURL("/data/kafka/v0/clusters/%s/consumer_groups", clusterID)
 
@@ -83,7 +88,7 @@ func (k *Kafka) DeleteConsumerGroup(clusterID string, opts *consumerGroupRequest
 	}
 
 	// Execute the request
-	response, deleteErr := k.http.Delete(urlStr, &result, reqBody)
+	response, deleteErr := k.http.Delete(ctx, urlStr, &result, reqBody)
 
 	return result, response, deleteErr
 }
@@ -91,8 +96,8 @@ func (k *Kafka) DeleteConsumerGroup(clusterID string, opts *consumerGroupRequest
 // WasConsumerGroupCreated provides a simple method to determine if a consumer group was created successfully.
 //
 // This check is done by determining whether the consumer group is present when listing all consumer groups.
-func (k *Kafka) WasConsumerGroupCreated(clusterID, consumerGroupName string) (bool, *simpleresty.Response, error) {
-	listResp, response, listErr := k.ListConsumerGroups(clusterID)
+func (k *Kafka) WasConsumerGroupCreated(ctx context.Context, clusterID, consumerGroupName string) (bool, *simpleresty.Response, error) {
+	listResp, response, listErr := k.ListConsumerGroups(ctx, clusterID)
 	if listErr != nil {
 		return false, response, listErr
 	}
@@ -109,8 +114,8 @@ func (k *Kafka) WasConsumerGroupCreated(clusterID, consumerGroupName string) (bo
 // WasConsumerGroupDeleted provides a simple method to determine if a consumer group was deleted successfully.
 //
 // This check is done by determining whether the consumer group is not present when listing all consumer groups.
-func (k *Kafka) WasConsumerGroupDeleted(clusterID, consumerGroupName string) (bool, *simpleresty.Response, error) {
-	isCreated, response, err := k.WasConsumerGroupCreated(clusterID, consumerGroupName)
+func (k *Kafka) WasConsumerGroupDeleted(ctx context.Context, clusterID, consumerGroupName string) (bool, *simpleresty.Response, error) {
+	isCreated, response, err := k.WasConsumerGroupCreated(ctx, clusterID, consumerGroupName)
 	if err != nil {
 		return false, response, err
 	}
@@ -1,17 +1,14 @@
 package kafka
 
 import (
-	"fmt"
-	"github.com/davidji99/simpleresty"
 	config2 "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
-	"github.com/go-resty/resty/v2"
-	"net/http"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"time"
 )
 
 // Kafka represents Heroku's kafka APIs.
 type Kafka struct {
-	http   *simpleresty.Client
+	http   *rest.Client
 	config *config2.Config
 }
 
@@ -23,7 +20,7 @@ type Response struct {
 
 // New constructs a client to interface with the Heroku Kafka APIs.
 func New(config *config2.Config) *Kafka {
-	p := &Kafka{http: simpleresty.NewWithBaseURL(config.KafkaBaseURL), config: config}
+	p := &Kafka{http: rest.NewWithConfig(config.KafkaBaseURL, config), config: config}
 	p.setHeaders()
 
 	return p
@@ -33,17 +30,9 @@ func (k *Kafka) setHeaders() {
 	k.http.SetHeader("Content-type", k.config.ContentTypeHeader).
 		SetHeader("Accept", k.config.AcceptHeader).
 		SetHeader("User-Agent", k.config.UserAgent).
-		SetHeader("Authorization", fmt.Sprintf("Basic %s", k.config.BasicAuth)).
-		SetTimeout(5 * time.Minute).
-		SetAllowGetMethodPayload(true).
-		SetRetryCount(10).
-		SetRetryWaitTime(2 * time.Second).
-		SetRetryMaxWaitTime(60 * time.Second).
-		AddRetryCondition(
-			func(r *resty.Response, err error) bool {
-				return r.StatusCode() == http.StatusTooManyRequests
-			},
-		)
+		SetTimeout(k.config.Timeout(config2.ServiceKafka, 5*time.Minute)).
+		SetAllowGetMethodPayload(true)
+	k.http.SetAuthorizer(rest.BasicAuthorizer(k.config)).SetService(config2.ServiceKafka)
 
 	// Set additional headers
 	if k.config.CustomHTTPHeaders != nil {
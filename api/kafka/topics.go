@@ -1,10 +1,11 @@
 package kafka
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 
 	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
 )
 
 // Topics represents a list of topics.
@@ -58,24 +59,61 @@ type TopicRequest struct {
 	Compaction bool `json:"compaction"`
 }
 
+// SetCleanupPolicy sets the compaction and retention time of the request for the cleanup policy.
+// The API has no cleanup policy parameter: compaction enables compact, and a retention time enables delete.
+// The retention time is therefore only sent for the delete and compact,delete policies.
+func (r *TopicRequest) SetCleanupPolicy(policy TopicCleanupPolicy, retentionTimeMS *int) {
+	switch policy {
+	case TopicCleanupPolicies.COMPACT:
+		r.Compaction = true
+		r.RetentionTimeMS = nil
+	case TopicCleanupPolicies.COMPACT_DELETE:
+		r.Compaction = true
+		r.RetentionTimeMS = retentionTimeMS
+	default:
+		r.Compaction = false
+		r.RetentionTimeMS = retentionTimeMS
+	}
+}
+
+// EffectiveCleanupPolicy returns the cleanup policy of the topic. If the API does not report it,
+// it is derived from whether compaction and retention are enabled.
+func (t *Topic) EffectiveCleanupPolicy() TopicCleanupPolicy {
+	if policy := t.GetCleanupPolicy(); policy != "" {
+		return TopicCleanupPolicy(policy)
+	}
+
+	compaction := t.GetCompactionEnabled() || t.GetCompaction()
+	switch {
+	case compaction && t.GetRetentionEnabled():
+		return TopicCleanupPolicies.COMPACT_DELETE
+	case compaction:
+		return TopicCleanupPolicies.COMPACT
+	default:
+		return TopicCleanupPolicies.DELETE
+	}
+}
+
 type topicRequestBody struct {
 	Topic *TopicRequest `json:"topic,omitempty"`
 }
 
 // ListTopics returns a list of cluster topics.
-func (k *Kafka) ListTopics(clusterID string) (*Topics, *simpleresty.Response, error) {
+func (k *Kafka) ListTopics(ctx context.Context, clusterID string) (*Topics, *simpleresty.Response, error) {
 	var result *Topics
 	urlStr := k.http.RequestURL("/data/kafka/v0/clusters/%s/topics", clusterID)
 
 	// Execute the request
-	response, getErr := k.http.Get(urlStr, &result, nil)
+	response, getErr := k.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // GetTopicByName finds a cluster topic by its name.
-func (k *Kafka) GetTopicByName(clusterID, topicName string) (*Topic, *simpleresty.Response, error) {
-	topics, response, getErr := k.ListTopics(clusterID)
+//
+// Returns an error matching apierror.ErrNotFound if the topic does not exist.
+func (k *Kafka) GetTopicByName(ctx context.Context, clusterID, topicName string) (*Topic, *simpleresty.Response, error) {
+	topics, response, getErr := k.ListTopics(ctx, clusterID)
 	if getErr != nil {
 		return nil, response, getErr
 	}
@@ -87,47 +125,45 @@ func (k *Kafka) GetTopicByName(clusterID, topicName string) (*Topic, *simplerest
 		}
 	}
 
-	r := &simpleresty.Response{}
 	if topic == nil {
-		r.StatusCode = 404
-		return nil, r, fmt.Errorf("no cluster topic named %s found on cluster %s", topicName, clusterID)
+		return nil, response, apierror.NewNotFound("no cluster topic named %s found on cluster %s", topicName, clusterID)
 	}
 
-	return topic, r, nil
+	return topic, response, nil
 }
 
 // CreateTopic creates a cluster topic.
-func (k *Kafka) CreateTopic(clusterID string, opts *TopicRequest) (*Response, *simpleresty.Response, error) {
+func (k *Kafka) CreateTopic(ctx context.Context, clusterID string, opts *TopicRequest) (*Response, *simpleresty.Response, error) {
 	var result *Response
 	urlStr := k.http.RequestURL("/data/kafka/v0/clusters/%s/topics", clusterID)
 	reqBody := &topicRequestBody{Topic: opts}
 
 	// Execute the request
-	response, createErr := k.http.Post(urlStr, &result, reqBody)
+	response, createErr := k.http.Post(ctx, urlStr, &result, reqBody)
 
 	return result, response, createErr
 }
 
 // UpdateTopic updates an existing Kafka topic.
-func (k *Kafka) UpdateTopic(clusterID string, opts *TopicRequest) (*Response, *simpleresty.Response, error) {
+func (k *Kafka) UpdateTopic(ctx context.Context, clusterID string, opts *TopicRequest) (*Response, *simpleresty.Response, error) {
 	var result *Response
 	urlStr := k.http.RequestURL("/data/kafka/v0/clusters/%s/topics/%s", clusterID, opts.Name)
 	reqBody := &topicRequestBody{Topic: opts}
 
 	// Execute the request
-	response, updateErr := k.http.Put(urlStr, &result, reqBody)
+	response, updateErr := k.http.Put(ctx, urlStr, &result, reqBody)
 
 	return result, response, updateErr
 }
 
 // DeleteTopic deletes an existing topic.
-func (k *Kafka) DeleteTopic(clusterID, topicName string) (*Response, *simpleresty.Response, error) {
+func (k *Kafka) DeleteTopic(ctx context.Context, clusterID, topicName string) (*Response, *simpleresty.Response, error) {
 	var result *Response
 
 	urlStr := k.http.RequestURL("/data/kafka/v0/clusters/%s/topics/%s", clusterID, topicName)
 
 	// Execute the request
-	response, createErr := k.http.Delete(urlStr, &result, nil)
+	response, createErr := k.http.Delete(ctx, urlStr, &result, nil)
 
 	return result, response, createErr
 }
@@ -0,0 +1,41 @@
+package kafka
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTopicRequest_SetCleanupPolicy(t *testing.T) {
+	retention := 86400000
+
+	opts := &TopicRequest{}
+	opts.SetCleanupPolicy(TopicCleanupPolicies.COMPACT, &retention)
+	assert.True(t, opts.Compaction)
+	assert.Nil(t, opts.RetentionTimeMS)
+
+	opts.SetCleanupPolicy(TopicCleanupPolicies.COMPACT_DELETE, &retention)
+	assert.True(t, opts.Compaction)
+	assert.Equal(t, &retention, opts.RetentionTimeMS)
+
+	opts.SetCleanupPolicy(TopicCleanupPolicies.DELETE, &retention)
+	assert.False(t, opts.Compaction)
+	assert.Equal(t, &retention, opts.RetentionTimeMS)
+}
+
+func TestTopic_EffectiveCleanupPolicy(t *testing.T) {
+	testCases := map[string]TopicCleanupPolicy{
+		`{"cleanup_policy": "compact,delete"}`:                     TopicCleanupPolicies.COMPACT_DELETE,
+		`{"compaction_enabled": true, "retention_enabled": true}`:  TopicCleanupPolicies.COMPACT_DELETE,
+		`{"compaction_enabled": true, "retention_enabled": false}`: TopicCleanupPolicies.COMPACT,
+		`{"compaction": true}`:                                     TopicCleanupPolicies.COMPACT,
+		`{"compaction_enabled": false, "retention_enabled": true}`: TopicCleanupPolicies.DELETE,
+		`{}`: TopicCleanupPolicies.DELETE,
+	}
+
+	for body, expected := range testCases {
+		var topic *Topic
+		assert.Nil(t, json.Unmarshal([]byte(body), &topic))
+		assert.Equal(t, expected, topic.EffectiveCleanupPolicy(), body)
+	}
+}
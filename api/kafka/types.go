@@ -47,3 +47,22 @@ var TopicStatuses = struct {
 func (s TopicStatus) ToString() string {
 	return string(s)
 }
+
+// TopicCleanupPolicy represents how a topic cleans up old messages.
+type TopicCleanupPolicy string
+
+// TopicCleanupPolicies represent all cleanup policies of a topic.
+var TopicCleanupPolicies = struct {
+	DELETE         TopicCleanupPolicy
+	COMPACT        TopicCleanupPolicy
+	COMPACT_DELETE TopicCleanupPolicy
+}{
+	DELETE:         "delete",
+	COMPACT:        "compact",
+	COMPACT_DELETE: "compact,delete",
+}
+
+// ToString is a helper method to return the string of a TopicCleanupPolicy.
+func (p TopicCleanupPolicy) ToString() string {
+	return string(p)
+}
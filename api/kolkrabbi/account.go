@@ -1,6 +1,7 @@
 package kolkrabbi
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
 	"time"
 )
@@ -22,12 +23,12 @@ type AccountInfoHeroku struct {
 	UserID *string `json:"user_id"`
 }
 
-func (k *Kolkrabbi) GetAccountInfo() (*AccountInfo, *simpleresty.Response, error) {
+func (k *Kolkrabbi) GetAccountInfo(ctx context.Context) (*AccountInfo, *simpleresty.Response, error) {
 	var result AccountInfo
 	urlStr := k.http.RequestURL("/account/github/token")
 
 	// Execute the request
-	response, getErr := k.http.Get(urlStr, &result, nil)
+	response, getErr := k.http.Get(ctx, urlStr, &result, nil)
 
 	return &result, response, getErr
 }
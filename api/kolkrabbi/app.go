@@ -1,6 +1,7 @@
 package kolkrabbi
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
 	"github.com/davidji99/terraform-provider-herokux/api/platform"
 	"time"
@@ -30,23 +31,23 @@ type AppGhIntegrationRequest struct {
 }
 
 // GetAppGithubIntegration returns information regarding the integration between a Heroku app and Github repository.
-func (k *Kolkrabbi) GetAppGithubIntegration(appID string) (*AppGHIntegration, *simpleresty.Response, error) {
+func (k *Kolkrabbi) GetAppGithubIntegration(ctx context.Context, appID string) (*AppGHIntegration, *simpleresty.Response, error) {
 	var result AppGHIntegration
 	urlStr := k.http.RequestURL("/apps/%s/github", appID)
 
 	// Execute the request
-	response, getErr := k.http.Get(urlStr, &result, nil)
+	response, getErr := k.http.Get(ctx, urlStr, &result, nil)
 
 	return &result, response, getErr
 }
 
 // UpdateAppGithubIntegration updates the integration between a Heroku app and Github repository.
-func (k *Kolkrabbi) UpdateAppGithubIntegration(appID string, opts *AppGhIntegrationRequest) (*AppGHIntegration, *simpleresty.Response, error) {
+func (k *Kolkrabbi) UpdateAppGithubIntegration(ctx context.Context, appID string, opts *AppGhIntegrationRequest) (*AppGHIntegration, *simpleresty.Response, error) {
 	var result AppGHIntegration
 	urlStr := k.http.RequestURL("/apps/%s/github", appID)
 
 	// Execute the request
-	response, getErr := k.http.Patch(urlStr, &result, opts)
+	response, getErr := k.http.Patch(ctx, urlStr, &result, opts)
 
 	return &result, response, getErr
 }
@@ -1,21 +1,20 @@
 package kolkrabbi
 
 import (
-	"fmt"
-	"github.com/davidji99/simpleresty"
 	config2 "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"time"
 )
 
 // Kolkrabbi represents Heroku's kolkrabbi APIs.
 type Kolkrabbi struct {
-	http   *simpleresty.Client
+	http   *rest.Client
 	config *config2.Config
 }
 
 // New constructs a client to interface with the Heroku Postgres APIs.
 func New(config *config2.Config) *Kolkrabbi {
-	k := &Kolkrabbi{http: simpleresty.NewWithBaseURL(config.KolkrabbiURL), config: config}
+	k := &Kolkrabbi{http: rest.NewWithConfig(config.KolkrabbiURL, config), config: config}
 	k.setHeaders()
 
 	return k
@@ -25,9 +24,9 @@ func (k *Kolkrabbi) setHeaders() {
 	k.http.SetHeader("Content-type", k.config.ContentTypeHeader).
 		SetHeader("Accept", k.config.AcceptHeader).
 		SetHeader("User-Agent", k.config.UserAgent).
-		SetHeader("Authorization", fmt.Sprintf("Bearer %s", k.config.APIToken)).
-		SetTimeout(2 * time.Minute).
+		SetTimeout(k.config.Timeout(config2.ServiceKolkrabbi, 2*time.Minute)).
 		SetAllowGetMethodPayload(true)
+	k.http.SetAuthorizer(rest.BearerAuthorizer(k.config)).SetService(config2.ServiceKolkrabbi)
 
 	// Set additional headers
 	if k.config.CustomHTTPHeaders != nil {
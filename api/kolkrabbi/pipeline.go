@@ -1,6 +1,7 @@
 package kolkrabbi
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
 	"time"
 )
@@ -52,33 +53,33 @@ type PipelineGHIntegrationRequest struct {
 }
 
 // GetPipelineGithubIntegration retrieves information about a pipeline's integration with a Github repository.
-func (k *Kolkrabbi) GetPipelineGithubIntegration(pipelineID string) (*PipelineGHIntegration, *simpleresty.Response, error) {
+func (k *Kolkrabbi) GetPipelineGithubIntegration(ctx context.Context, pipelineID string) (*PipelineGHIntegration, *simpleresty.Response, error) {
 	var result PipelineGHIntegration
 	urlStr := k.http.RequestURL("/pipelines/%s/repository", pipelineID)
 
 	// Execute the request
-	response, getErr := k.http.Get(urlStr, &result, nil)
+	response, getErr := k.http.Get(ctx, urlStr, &result, nil)
 
 	return &result, response, getErr
 }
 
 // CreatePipelineGithubIntegration creates the integration between a pipeline and Github repository.
-func (k *Kolkrabbi) CreatePipelineGithubIntegration(pipelineID string, opts *PipelineGHIntegrationRequest) (*PipelineGHIntegration, *simpleresty.Response, error) {
+func (k *Kolkrabbi) CreatePipelineGithubIntegration(ctx context.Context, pipelineID string, opts *PipelineGHIntegrationRequest) (*PipelineGHIntegration, *simpleresty.Response, error) {
 	var result PipelineGHIntegration
 	urlStr := k.http.RequestURL("/pipelines/%s/repository", pipelineID)
 
 	// Execute the request
-	response, createErr := k.http.Post(urlStr, &result, opts)
+	response, createErr := k.http.Post(ctx, urlStr, &result, opts)
 
 	return &result, response, createErr
 }
 
 // DeletePipelineGithubIntegration destroys the integration between a pipeline and Github repository.
-func (k *Kolkrabbi) DeletePipelineGithubIntegration(pipelineID string) (*simpleresty.Response, error) {
+func (k *Kolkrabbi) DeletePipelineGithubIntegration(ctx context.Context, pipelineID string) (*simpleresty.Response, error) {
 	urlStr := k.http.RequestURL("/pipelines/%s/repository", pipelineID)
 
 	// Execute the request
-	response, deleteErr := k.http.Delete(urlStr, nil, nil)
+	response, deleteErr := k.http.Delete(ctx, urlStr, nil, nil)
 
 	return response, deleteErr
 }
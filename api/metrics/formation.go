@@ -1,9 +1,11 @@
 package metrics
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
 )
 
 const (
@@ -115,12 +117,12 @@ type FormationAlertRequest struct {
 // ListMonitors lists all monitors for a formation.
 //
 // This endpoint returns 200 and an empty array even if the app has no dyno and/or process type ('web')  associated to it.
-func (m *Metrics) ListMonitors(appID, formationName string) ([]*FormationMonitor, *simpleresty.Response, error) {
+func (m *Metrics) ListMonitors(ctx context.Context, appID, formationName string) ([]*FormationMonitor, *simpleresty.Response, error) {
 	var result []*FormationMonitor
 	urlStr := m.http.RequestURL("/apps/%s/formation/%s/monitors", appID, formationName)
 
 	// Execute the request
-	response, getErr := m.http.Get(urlStr, &result, nil)
+	response, getErr := m.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
@@ -131,12 +133,12 @@ func (m *Metrics) ListMonitors(appID, formationName string) ([]*FormationMonitor
 // This we need to manually unmarshall the response into the appropriate struct.
 //
 // This method also is used to return app threshold alerts.
-func (m *Metrics) GetMonitor(appID, formationName, monitorID string) (*FormationMonitor, *simpleresty.Response, error) {
+func (m *Metrics) GetMonitor(ctx context.Context, appID, formationName, monitorID string) (*FormationMonitor, *simpleresty.Response, error) {
 	var result FormationMonitor
 	urlStr := m.http.RequestURL("/apps/%s/formation/%s/monitors/%s", appID, formationName, monitorID)
 
 	// Execute the request
-	response, getErr := m.http.Get(urlStr, nil, nil)
+	response, getErr := m.http.Get(ctx, urlStr, nil, nil)
 	if getErr != nil {
 		return nil, response, getErr
 	}
@@ -152,18 +154,18 @@ func (m *Metrics) GetMonitor(appID, formationName, monitorID string) (*Formation
 // DeleteMonitor destroys an existing formation monitor.
 //
 // Returns '202 Accepted' if successful. WARNING! This method may cause unknown issues if used.
-func (m *Metrics) DeleteMonitor(appID, formationName, monitorID string) (*simpleresty.Response, error) {
+func (m *Metrics) DeleteMonitor(ctx context.Context, appID, formationName, monitorID string) (*simpleresty.Response, error) {
 	urlStr := m.http.RequestURL("/apps/%s/formation/%s/monitors/%s", appID, formationName, monitorID)
 
 	// Execute the request
-	response, deleteErr := m.http.Delete(urlStr, nil, nil)
+	response, deleteErr := m.http.Delete(ctx, urlStr, nil, nil)
 
 	return response, deleteErr
 }
 
 // FindMonitorByName gets a single monitor for a formation by its name.
-func (m *Metrics) FindMonitorByName(appID, formationName string, name FormationMonitorName) (*FormationMonitor, *simpleresty.Response, error) {
-	monitors, response, listErr := m.ListMonitors(appID, formationName)
+func (m *Metrics) FindMonitorByName(ctx context.Context, appID, formationName string, name FormationMonitorName) (*FormationMonitor, *simpleresty.Response, error) {
+	monitors, response, listErr := m.ListMonitors(ctx, appID, formationName)
 	if listErr != nil {
 		return nil, response, listErr
 	}
@@ -174,20 +176,20 @@ func (m *Metrics) FindMonitorByName(appID, formationName string, name FormationM
 		}
 	}
 
-	return nil, nil, fmt.Errorf("did not find a %s monitor for app %s's formation %s",
+	return nil, nil, apierror.NewNotFound("did not find a %s monitor for app %s's formation %s",
 		name.ToString(), appID, formationName)
 }
 
 // CreateFormationAutoscaling sets up autoscaling for an app formation.
 //
 // The API response body only has the formation autoscaling UUID.
-func (m *Metrics) CreateFormationAutoscaling(appID, formationName string, opts *FormationAutoscalingRequest) (*FormationMonitor, *simpleresty.Response, error) {
+func (m *Metrics) CreateFormationAutoscaling(ctx context.Context, appID, formationName string, opts *FormationAutoscalingRequest) (*FormationMonitor, *simpleresty.Response, error) {
 	var result *FormationMonitor
 
 	urlStr := m.http.RequestURL("/apps/%s/formation/%s/monitors", appID, formationName)
 
 	// Execute the request
-	response, createErr := m.http.Post(urlStr, &result, opts)
+	response, createErr := m.http.Post(ctx, urlStr, &result, opts)
 
 	return result, response, createErr
 }
@@ -195,11 +197,11 @@ func (m *Metrics) CreateFormationAutoscaling(appID, formationName string, opts *
 // UpdateFormationAutoscaling modifies autoscaling for an app formation.
 //
 // The endpoint does not return any response. Instead, the method returns true if request is successful; false otherwise,
-func (m *Metrics) UpdateFormationAutoscaling(appID, formationName, monitorID string, opts *FormationAutoscalingRequest) (bool, *simpleresty.Response, error) {
+func (m *Metrics) UpdateFormationAutoscaling(ctx context.Context, appID, formationName, monitorID string, opts *FormationAutoscalingRequest) (bool, *simpleresty.Response, error) {
 	urlStr := m.http.RequestURL("/apps/%s/formation/%s/monitors/%s", appID, formationName, monitorID)
 
 	// Execute the request
-	response, updateErr := m.http.Patch(urlStr, nil, opts)
+	response, updateErr := m.http.Patch(ctx, urlStr, nil, opts)
 	if updateErr != nil {
 		return false, response, updateErr
 	}
@@ -214,25 +216,25 @@ func (m *Metrics) UpdateFormationAutoscaling(appID, formationName, monitorID str
 // CreateFormationAlert creates an alert for an app formation.
 //
 // The API response body only has the formation alert UUID.
-func (m *Metrics) CreateFormationAlert(appID, formationName string, opts *FormationAlertRequest) (*FormationMonitor, *simpleresty.Response, error) {
+func (m *Metrics) CreateFormationAlert(ctx context.Context, appID, formationName string, opts *FormationAlertRequest) (*FormationMonitor, *simpleresty.Response, error) {
 	var result *FormationMonitor
 
 	urlStr := m.http.RequestURL("/apps/%s/formation/%s/monitors", appID, formationName)
 
 	// Execute the request
-	response, createErr := m.http.Post(urlStr, &result, opts)
+	response, createErr := m.http.Post(ctx, urlStr, &result, opts)
 
 	return result, response, createErr
 }
 
 // UpdateFormationAlert updates an existing alert for an app formation.
-func (m *Metrics) UpdateFormationAlert(appID, formationName, alertID string, opts *FormationAlertRequest) (bool, *simpleresty.Response, error) {
+func (m *Metrics) UpdateFormationAlert(ctx context.Context, appID, formationName, alertID string, opts *FormationAlertRequest) (bool, *simpleresty.Response, error) {
 	var result *FormationMonitor
 
 	urlStr := m.http.RequestURL("/apps/%s/formation/%s/monitors/%s", appID, formationName, alertID)
 
 	// Execute the request
-	response, updateErr := m.http.Patch(urlStr, &result, opts)
+	response, updateErr := m.http.Patch(ctx, urlStr, &result, opts)
 	if updateErr != nil {
 		return false, response, updateErr
 	}
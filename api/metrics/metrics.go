@@ -1,21 +1,20 @@
 package metrics
 
 import (
-	"fmt"
-	"github.com/davidji99/simpleresty"
 	config2 "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"time"
 )
 
 // Metrics represents Heroku's Metrics APIs.
 type Metrics struct {
-	http   *simpleresty.Client
+	http   *rest.Client
 	config *config2.Config
 }
 
 // New constructs a client to interface with the Heroku Metrics APIs.
 func New(config *config2.Config) *Metrics {
-	m := &Metrics{http: simpleresty.NewWithBaseURL(config.MetricsBaseURL), config: config}
+	m := &Metrics{http: rest.NewWithConfig(config.MetricsBaseURL, config), config: config}
 	m.setHeaders()
 
 	return m
@@ -25,9 +24,9 @@ func (m *Metrics) setHeaders() {
 	m.http.SetHeader("Content-type", m.config.ContentTypeHeader).
 		SetHeader("Accept", m.config.AcceptHeader).
 		SetHeader("User-Agent", m.config.UserAgent).
-		SetHeader("Authorization", fmt.Sprintf("Bearer %s", m.config.APIToken)).
-		SetTimeout(2 * time.Minute).
+		SetTimeout(m.config.Timeout(config2.ServiceMetrics, 2*time.Minute)).
 		SetAllowGetMethodPayload(true)
+	m.http.SetAuthorizer(rest.BearerAuthorizer(m.config)).SetService(config2.ServiceMetrics)
 
 	// Set additional headers
 	if m.config.CustomHTTPHeaders != nil {
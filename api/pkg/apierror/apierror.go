@@ -0,0 +1,198 @@
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/davidji99/simpleresty"
+)
+
+var (
+	// ErrNotFound is matched by errors.Is when the requested resource does not exist remotely.
+	ErrNotFound = errors.New("not found")
+
+	// ErrRateLimited is matched by errors.Is when the API rejected the request due to rate limiting.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrConflict is matched by errors.Is when the request conflicts with the current state of the resource.
+	ErrConflict = errors.New("conflict")
+
+	// ErrUnauthorized is matched by errors.Is when the request was not authenticated or authorized.
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// requestIDHeaders are the response headers Heroku APIs use to return the request ID.
+var requestIDHeaders = []string{"Request-Id", "X-Request-Id"}
+
+// Error represents an error returned by a Heroku API.
+type Error struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// ID is the Heroku error identifier such as `not_found` or `rate_limit`.
+	ID string
+
+	// Message is the human readable error message.
+	Message string
+
+	// RequestID is the request ID returned by the API, useful when contacting Heroku support.
+	RequestID string
+
+	// Method is the HTTP method of the failed request.
+	Method string
+
+	// URL is the URL of the failed request.
+	URL string
+
+	// Body is the raw response body.
+	Body string
+
+	// ValidationErrors are any GraphQL `extensions.validationErrors` returned in the response.
+	ValidationErrors []ValidationError
+}
+
+// ValidationError represents a single GraphQL validation error.
+type ValidationError struct {
+	Name   string `json:"name,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// responseBody represents the union of the Heroku and GraphQL error response bodies.
+type responseBody struct {
+	ID      string `json:"id,omitempty"`
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Errors  []struct {
+		Message string `json:"message,omitempty"`
+	} `json:"errors,omitempty"`
+	Extensions struct {
+		Code             string            `json:"code,omitempty"`
+		ValidationErrors []ValidationError `json:"validationErrors,omitempty"`
+	} `json:"extensions,omitempty"`
+}
+
+// New constructs an Error that was not returned by the API, such as when a finder method
+// does not locate a resource in a list response.
+func New(statusCode int, format string, args ...interface{}) *Error {
+	return &Error{StatusCode: statusCode, Message: fmt.Sprintf(format, args...)}
+}
+
+// NewNotFound constructs an Error that matches ErrNotFound.
+func NewNotFound(format string, args ...interface{}) *Error {
+	e := New(http.StatusNotFound, format, args...)
+	e.ID = "not_found"
+	return e
+}
+
+// FromResponse constructs an Error from an API response.
+func FromResponse(r *simpleresty.Response) *Error {
+	e := &Error{
+		StatusCode: r.StatusCode,
+		Method:     r.RequestMethod,
+		URL:        r.RequestURL,
+		Body:       r.Body,
+	}
+
+	if r.Resp != nil {
+		for _, h := range requestIDHeaders {
+			if v := r.Resp.Header().Get(h); v != "" {
+				e.RequestID = v
+				break
+			}
+		}
+	}
+
+	var body responseBody
+	if err := json.Unmarshal([]byte(r.Body), &body); err == nil {
+		e.ID = body.ID
+		if e.ID == "" {
+			e.ID = body.Extensions.Code
+		}
+
+		e.ValidationErrors = body.Extensions.ValidationErrors
+
+		var messages []string
+		for _, m := range []string{body.Message, body.Error} {
+			if m != "" {
+				messages = append(messages, m)
+			}
+		}
+		for _, err := range body.Errors {
+			if err.Message != "" {
+				messages = append(messages, err.Message)
+			}
+		}
+		e.Message = strings.Join(messages, ", ")
+	}
+
+	if e.Message == "" {
+		e.Message = r.Body
+	}
+
+	return e
+}
+
+// Error returns the string representation of the error.
+func (e *Error) Error() string {
+	var b strings.Builder
+
+	if e.Method != "" {
+		fmt.Fprintf(&b, "%s %s: ", e.Method, e.URL)
+	}
+
+	if e.StatusCode != 0 {
+		fmt.Fprintf(&b, "%d ", e.StatusCode)
+	}
+
+	if e.ID != "" {
+		fmt.Fprintf(&b, "[%s] ", e.ID)
+	}
+
+	b.WriteString(e.Message)
+
+	for _, v := range e.ValidationErrors {
+		fmt.Fprintf(&b, "; %s: %s", v.Name, v.Reason)
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request ID %s)", e.RequestID)
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+// Is reports whether the error matches one of the package sentinel errors.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests || e.ID == "rate_limit"
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	}
+
+	return false
+}
+
+// As returns the Error in err's chain, if any.
+func As(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+// StatusCode returns the HTTP status code of the Error in err's chain or 0 if there is none.
+func StatusCode(err error) int {
+	if e, ok := As(err); ok {
+		return e.StatusCode
+	}
+	return 0
+}
@@ -0,0 +1,85 @@
+package apierror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func responseFor(t *testing.T, statusCode int, header http.Header, body string) *simpleresty.Response {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(statusCode)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	c := simpleresty.NewWithBaseURL(server.URL)
+	req := c.ConstructRequest(nil, nil).SetContext(context.Background())
+	req.Method = simpleresty.GetMethod
+	req.URL = c.RequestURL("/resource")
+
+	response, err := c.Dispatch(req)
+	assert.NotNil(t, err)
+
+	return response
+}
+
+func TestFromResponse_Heroku(t *testing.T) {
+	header := http.Header{"Request-Id": []string{"abc-123"}}
+	r := responseFor(t, 404, header, `{"id":"not_found","message":"Couldn't find that app."}`)
+
+	e := FromResponse(r)
+	assert.Equal(t, 404, e.StatusCode)
+	assert.Equal(t, "not_found", e.ID)
+	assert.Equal(t, "Couldn't find that app.", e.Message)
+	assert.Equal(t, "abc-123", e.RequestID)
+	assert.Equal(t, "GET", e.Method)
+	assert.Contains(t, e.Error(), "404 [not_found] Couldn't find that app. (request ID abc-123)")
+}
+
+func TestFromResponse_GraphQLValidationErrors(t *testing.T) {
+	body := `{"errors":[{"message":"Invalid input"}],"extensions":{"code":"BAD_USER_INPUT",` +
+		`"validationErrors":[{"name":"retention_time_ms","reason":"must be at least 86400000"}]}}`
+	r := responseFor(t, 422, http.Header{"X-Request-Id": []string{"xyz"}}, body)
+
+	e := FromResponse(r)
+	assert.Equal(t, "BAD_USER_INPUT", e.ID)
+	assert.Equal(t, "Invalid input", e.Message)
+	assert.Equal(t, "xyz", e.RequestID)
+	assert.Equal(t, []ValidationError{{Name: "retention_time_ms", Reason: "must be at least 86400000"}}, e.ValidationErrors)
+	assert.Contains(t, e.Error(), "retention_time_ms: must be at least 86400000")
+}
+
+func TestFromResponse_PlainBody(t *testing.T) {
+	r := responseFor(t, 500, nil, "Internal Server Error")
+
+	e := FromResponse(r)
+	assert.Equal(t, "Internal Server Error", e.Message)
+	assert.Equal(t, 500, StatusCode(e))
+}
+
+func TestError_Is(t *testing.T) {
+	var err error = fmt.Errorf("wrapped: %w", &Error{StatusCode: 404})
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrConflict))
+
+	assert.True(t, errors.Is(&Error{StatusCode: 429}, ErrRateLimited))
+	assert.True(t, errors.Is(&Error{StatusCode: 403, ID: "rate_limit"}, ErrRateLimited))
+	assert.True(t, errors.Is(&Error{StatusCode: 409}, ErrConflict))
+	assert.True(t, errors.Is(&Error{StatusCode: 401}, ErrUnauthorized))
+
+	assert.True(t, errors.Is(NewNotFound("topic %s not found", "foo"), ErrNotFound))
+	assert.Equal(t, "404 [not_found] topic foo not found", NewNotFound("topic %s not found", "foo").Error())
+}
+
+func TestStatusCode_NoAPIError(t *testing.T) {
+	assert.Equal(t, 0, StatusCode(errors.New("boom")))
+}
@@ -1,5 +1,44 @@
 package config
 
+import (
+	"context"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/retry"
+	"net/http"
+	"time"
+)
+
+// Service identifies one of the Heroku APIs the Client communicates with.
+type Service string
+
+// Services supported by the Client.
+const (
+	ServiceConnect   Service = "connect"
+	ServiceData      Service = "data"
+	ServiceKafka     Service = "kafka"
+	ServiceKolkrabbi Service = "kolkrabbi"
+	ServiceMetrics   Service = "metrics"
+	ServicePlatform  Service = "platform"
+	ServicePostgres  Service = "postgres"
+	ServiceRedis     Service = "redis"
+	ServiceRegistry  Service = "registry"
+	ServiceScheduler Service = "scheduler"
+)
+
+// Services is a list of all Services.
+var Services = []Service{
+	ServiceConnect, ServiceData, ServiceKafka, ServiceKolkrabbi, ServiceMetrics,
+	ServicePlatform, ServicePostgres, ServiceRedis, ServiceRegistry, ServiceScheduler,
+}
+
+// Logger receives structured log entries describing each request made by the Client.
+//
+// Debug entries contain the method, URL, status code, latency and request ID of every request. Trace entries
+// additionally contain the request and response headers and bodies. Secrets are redacted before either is called.
+type Logger interface {
+	Debug(ctx context.Context, msg string, fields map[string]interface{})
+	Trace(ctx context.Context, msg string, fields map[string]interface{})
+}
+
 // Config represents all configuration options available to user to customize the Client.
 type Config struct {
 	// MetricsBaseURL is the base URL for Heroku's metrics API.
@@ -23,7 +62,8 @@ type Config struct {
 	// ConnectCentralBaseURL is the base URL for Heroku's Connect Central API.
 	ConnectCentralBaseURL string
 
-	// ConnectBaseURL is the base URL for Heroku's Connect APIs.
+	// ConnectBaseURL, if set, is the base URL for Heroku's Connect APIs. It is used instead of the
+	// regional URL returned by Connect Central for a connection.
 	ConnectBaseURL string
 
 	// RegistryBaseURL is the base URL for the Heroku Registry.
@@ -47,11 +87,53 @@ type Config struct {
 	// BasicAuth represents a base64 encoded string
 	BasicAuth string
 
+	// BasicAuthUsername is the username used with APITokenSource for the APIs that use HTTP Basic authentication.
+	BasicAuthUsername string
+
+	// APITokenSource, if set, supplies the API token for every request instead of APIToken and BasicAuth.
+	APITokenSource TokenSource
+
 	// ContentTypeHeader
 	ContentTypeHeader string
 
 	// AcceptHeader
 	AcceptHeader string
+
+	// RetryPolicy determines how rate limited and failed requests are retried.
+	RetryPolicy retry.Policy
+
+	// HTTPClient is used as the base for each service's HTTP client. Its transport, cookie jar,
+	// redirect policy and timeout are copied so the supplied client is never modified.
+	HTTPClient *http.Client
+
+	// Transport is the http.RoundTripper used for all requests. It takes precedence over HTTPClient's transport.
+	Transport http.RoundTripper
+
+	// Timeouts are the per service request timeouts.
+	Timeouts map[Service]time.Duration
+
+	// Logger, if set, logs every request.
+	Logger Logger
+
+	// Tracer starts a span for every API call.
+	Tracer Tracer
+
+	// Meter records the duration of every API call.
+	Meter Meter
+}
+
+// Timeout returns the request timeout for a service. A timeout set for the service takes precedence,
+// followed by the HTTPClient's timeout and finally the supplied default.
+func (c *Config) Timeout(s Service, def time.Duration) time.Duration {
+	if d, ok := c.Timeouts[s]; ok {
+		return d
+	}
+
+	if c.HTTPClient != nil && c.HTTPClient.Timeout > 0 {
+		return c.HTTPClient.Timeout
+	}
+
+	return def
 }
 
 // ParseOptions parses the supplied options functions.
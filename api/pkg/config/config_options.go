@@ -3,6 +3,8 @@ package config
 import (
 	"encoding/base64"
 	"fmt"
+	"net/http"
+	"time"
 )
 
 // Option is a functional option for configuring the API client.
@@ -169,11 +171,25 @@ func BasicAuth(username, password string) Option {
 	return func(c *Config) error {
 		userPass := fmt.Sprintf("%s:%s", username, password)
 		c.BasicAuth = base64.StdEncoding.EncodeToString([]byte(userPass))
+		c.BasicAuthUsername = username
 
 		return nil
 	}
 }
 
+// APITokenSource sets the TokenSource consulted for the API token before every request.
+// It takes precedence over APIToken and the password set by BasicAuth.
+func APITokenSource(ts TokenSource) Option {
+	return func(c *Config) error {
+		if ts == nil {
+			return fmt.Errorf("token source cannot be nil")
+		}
+
+		c.APITokenSource = ts
+		return nil
+	}
+}
+
 // ContentTypeHeader allows for a custom Content-Type header.
 func ContentTypeHeader(s string) Option {
 	return func(c *Config) error {
@@ -190,6 +206,131 @@ func AcceptHeader(s string) Option {
 	}
 }
 
+// MaxRetries sets the maximum number of times a rate limited or failed request is retried.
+// Setting this to zero disables retries.
+func MaxRetries(n int) Option {
+	return func(c *Config) error {
+		if n < 0 {
+			return fmt.Errorf("max retries cannot be negative")
+		}
+
+		c.RetryPolicy.MaxRetries = n
+		return nil
+	}
+}
+
+// RetryWaitTime sets the minimum and maximum time to wait between retries.
+func RetryWaitTime(min, max time.Duration) Option {
+	return func(c *Config) error {
+		if min <= 0 || max < min {
+			return fmt.Errorf("retry wait time minimum must be positive and not greater than the maximum")
+		}
+
+		c.RetryPolicy.WaitMin = min
+		c.RetryPolicy.WaitMax = max
+		return nil
+	}
+}
+
+// RetryIdempotentMethods sets the HTTP methods that are retried after a server error or a network failure.
+func RetryIdempotentMethods(methods ...string) Option {
+	return func(c *Config) error {
+		c.RetryPolicy.IdempotentMethods = methods
+		return nil
+	}
+}
+
+// HTTPClient sets the HTTP client used as the base for every service's HTTP client.
+//
+// Use this to supply proxies, custom CA bundles or client certificates. The client's transport, cookie jar,
+// redirect policy and timeout are copied; the client itself is never modified.
+func HTTPClient(client *http.Client) Option {
+	return func(c *Config) error {
+		if client == nil {
+			return fmt.Errorf("HTTP client cannot be nil")
+		}
+
+		c.HTTPClient = client
+		return nil
+	}
+}
+
+// Transport sets the http.RoundTripper used for all requests.
+func Transport(rt http.RoundTripper) Option {
+	return func(c *Config) error {
+		if rt == nil {
+			return fmt.Errorf("transport cannot be nil")
+		}
+
+		c.Transport = rt
+		return nil
+	}
+}
+
+// Timeout sets the request timeout for every service.
+func Timeout(d time.Duration) Option {
+	return func(c *Config) error {
+		for _, s := range Services {
+			if err := ServiceTimeout(s, d)(c); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// ServiceTimeout sets the request timeout for a single service.
+func ServiceTimeout(s Service, d time.Duration) Option {
+	return func(c *Config) error {
+		if d <= 0 {
+			return fmt.Errorf("timeout for %s must be positive", s)
+		}
+
+		if c.Timeouts == nil {
+			c.Timeouts = make(map[Service]time.Duration)
+		}
+
+		c.Timeouts[s] = d
+		return nil
+	}
+}
+
+// RequestLogger sets the Logger used to log every request.
+func RequestLogger(l Logger) Option {
+	return func(c *Config) error {
+		if l == nil {
+			return fmt.Errorf("logger cannot be nil")
+		}
+
+		c.Logger = l
+		return nil
+	}
+}
+
+// RequestTracer sets the Tracer used to trace every API call.
+func RequestTracer(t Tracer) Option {
+	return func(c *Config) error {
+		if t == nil {
+			return fmt.Errorf("tracer cannot be nil")
+		}
+
+		c.Tracer = t
+		return nil
+	}
+}
+
+// RequestMeter sets the Meter used to measure every API call.
+func RequestMeter(m Meter) Option {
+	return func(c *Config) error {
+		if m == nil {
+			return fmt.Errorf("meter cannot be nil")
+		}
+
+		c.Meter = m
+		return nil
+	}
+}
+
 // validateBaseURLOption ensures that any custom base URLs do not end with a trailing slash.
 func validateBaseURLOption(url string) error {
 	// Validate that there is no trailing slashes before setting the custom baseURL
@@ -0,0 +1,277 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bgentry/go-netrc/netrc"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/oauth2"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultOAuthTokenURL is the Heroku OAuth endpoint used to exchange refresh tokens for access tokens.
+	DefaultOAuthTokenURL = "https://id.heroku.com/oauth/token"
+
+	// DefaultExecTokenTTL is how long a token returned by an exec credential helper is reused
+	// when the helper does not return an expiry.
+	DefaultExecTokenTTL = 5 * time.Minute
+
+	// APIKeyEnvVar is the environment variable holding the Heroku API key.
+	APIKeyEnvVar = "HEROKU_API_KEY"
+
+	// NetrcPathEnvVar is the environment variable that overrides the location of the netrc file.
+	NetrcPathEnvVar = "NETRC_PATH"
+
+	// NetrcMachine is the netrc machine whose password is the API token. It is the Heroku Platform API host,
+	// which is where `heroku login` stores the token, and the token authenticates every Heroku API.
+	NetrcMachine = "api.heroku.com"
+
+	// tokenExpiryDelta is how long before its expiry a token is considered expired,
+	// so it isn't used for a request that reaches the API after it expires.
+	tokenExpiryDelta = 30 * time.Second
+)
+
+// TokenSource supplies the API token used to authenticate requests. It is consulted before every request,
+// so implementations that fetch tokens remotely should cache them until they expire.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of an ordinary function as a TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f(ctx).
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticTokenSource returns a TokenSource that always returns token.
+func StaticTokenSource(token string) TokenSource {
+	return TokenSourceFunc(func(_ context.Context) (string, error) {
+		return token, nil
+	})
+}
+
+// EnvTokenSource returns a TokenSource that reads the token from the name environment variable on every request.
+func EnvTokenSource(name string) TokenSource {
+	return TokenSourceFunc(func(_ context.Context) (string, error) {
+		token := os.Getenv(name)
+		if token == "" {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return token, nil
+	})
+}
+
+// NetrcTokenSource returns a TokenSource that reads the password of the host machine in the netrc file at path.
+// The file is parsed again whenever it is modified, so a rotated token is used by the next request.
+func NetrcTokenSource(path, host string) TokenSource {
+	return &netrcTokenSource{path: path, host: host}
+}
+
+type netrcTokenSource struct {
+	path string
+	host string
+
+	mu      sync.Mutex
+	modTime time.Time
+	token   string
+}
+
+func (s *netrcTokenSource) Token(_ context.Context) (string, error) {
+	fi, err := os.Stat(s.path)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && fi.ModTime().Equal(s.modTime) {
+		return s.token, nil
+	}
+
+	n, err := netrc.ParseFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("error parsing netrc file at %q: %s", s.path, err)
+	}
+
+	machine := n.FindMachine(s.host)
+	if machine == nil || machine.Password == "" {
+		return "", fmt.Errorf("netrc file at %q has no password for %s", s.path, s.host)
+	}
+
+	s.modTime = fi.ModTime()
+	s.token = machine.Password
+
+	return s.token, nil
+}
+
+// NetrcPath returns the path of the netrc file, which is NETRC_PATH if set or ~/.netrc otherwise (~/_netrc on Windows).
+func NetrcPath() (string, error) {
+	if path := os.Getenv(NetrcPathEnvVar); path != "" {
+		return path, nil
+	}
+
+	filename := ".netrc"
+	if runtime.GOOS == "windows" {
+		filename = "_netrc"
+	}
+
+	return homedir.Expand("~/" + filename)
+}
+
+// DefaultNetrcTokenSource returns a NetrcTokenSource for the NetrcMachine in the file at NetrcPath.
+// It returns nil if the file or the machine does not exist.
+func DefaultNetrcTokenSource() (TokenSource, error) {
+	path, err := NetrcPath()
+	if err != nil {
+		return nil, err
+	}
+
+	if fi, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	} else if fi.IsDir() {
+		return nil, nil
+	}
+
+	n, err := netrc.ParseFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing netrc file at %q: %s", path, err)
+	}
+
+	if n.FindMachine(NetrcMachine) == nil {
+		return nil, nil
+	}
+
+	return NetrcTokenSource(path, NetrcMachine), nil
+}
+
+// DefaultTokenSource returns the token source used when no credentials are configured explicitly:
+// the HEROKU_API_KEY environment variable if set, or else the netrc file.
+func DefaultTokenSource() (TokenSource, error) {
+	if token := os.Getenv(APIKeyEnvVar); token != "" {
+		return StaticTokenSource(token), nil
+	}
+
+	ts, err := DefaultNetrcTokenSource()
+	if err != nil {
+		return nil, err
+	}
+	if ts == nil {
+		return nil, fmt.Errorf("no API token found: set %s or log in with `heroku login`", APIKeyEnvVar)
+	}
+
+	return ts, nil
+}
+
+// ExecTokenSource returns a TokenSource that runs a credential helper command and reads the token from its output.
+//
+// The command may print the token on its own or a JSON object with a "token" and an optional RFC 3339 "expires_at".
+// The token is reused until it expires or, if the helper does not return an expiry, for DefaultExecTokenTTL.
+func ExecTokenSource(command string, args ...string) TokenSource {
+	return &cachedTokenSource{fetch: func(ctx context.Context) (string, time.Time, error) {
+		return execToken(ctx, command, args)
+	}}
+}
+
+// execTokenOutput is the JSON output of an exec credential helper.
+type execTokenOutput struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func execToken(ctx context.Context, command string, args []string) (string, time.Time, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", time.Time{}, fmt.Errorf("credential helper %s failed: %s: %s", command, err,
+			strings.TrimSpace(stderr.String()))
+	}
+
+	output := strings.TrimSpace(stdout.String())
+
+	result := execTokenOutput{Token: output}
+	if strings.HasPrefix(output, "{") {
+		result = execTokenOutput{}
+		if err := json.Unmarshal([]byte(output), &result); err != nil {
+			return "", time.Time{}, fmt.Errorf("unable to parse credential helper %s output: %s", command, err)
+		}
+	}
+
+	if result.Token == "" {
+		return "", time.Time{}, fmt.Errorf("credential helper %s did not return a token", command)
+	}
+
+	if result.ExpiresAt.IsZero() {
+		result.ExpiresAt = time.Now().Add(DefaultExecTokenTTL)
+	}
+
+	return result.Token, result.ExpiresAt, nil
+}
+
+// OAuthTokenSource returns a TokenSource that exchanges an OAuth refresh token for short-lived access tokens
+// using the Heroku OAuth client secret. Access tokens are reused until shortly before they expire.
+func OAuthTokenSource(clientSecret, refreshToken string) TokenSource {
+	return OAuthTokenSourceWithURL(DefaultOAuthTokenURL, clientSecret, refreshToken)
+}
+
+// OAuthTokenSourceWithURL is like OAuthTokenSource but exchanges refresh tokens at tokenURL.
+func OAuthTokenSourceWithURL(tokenURL, clientSecret, refreshToken string) TokenSource {
+	cfg := &oauth2.Config{
+		ClientSecret: clientSecret,
+		Endpoint:     oauth2.Endpoint{TokenURL: tokenURL, AuthStyle: oauth2.AuthStyleInParams},
+	}
+
+	return &cachedTokenSource{fetch: func(ctx context.Context) (string, time.Time, error) {
+		t, err := cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("unable to refresh OAuth token: %s", err)
+		}
+
+		return t.AccessToken, t.Expiry, nil
+	}}
+}
+
+// cachedTokenSource reuses the token returned by fetch until it expires.
+// A zero expiry means the token never expires.
+type cachedTokenSource struct {
+	fetch func(ctx context.Context) (string, time.Time, error)
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func (s *cachedTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(s.expiry)) {
+		return s.token, nil
+	}
+
+	token, expiry, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	s.token, s.expiry = token, expiry
+
+	return token, nil
+}
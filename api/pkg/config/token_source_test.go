@@ -0,0 +1,126 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEnvTokenSource(t *testing.T) {
+	t.Setenv("HEROKUX_TEST_TOKEN", "first")
+	ts := EnvTokenSource("HEROKUX_TEST_TOKEN")
+
+	token, err := ts.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "first", token)
+
+	t.Setenv("HEROKUX_TEST_TOKEN", "second")
+
+	token, err = ts.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "second", token)
+}
+
+func TestNetrcTokenSource_ReadsRotatedToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".netrc")
+	writeNetrc := func(password string, modTime time.Time) {
+		contents := fmt.Sprintf("machine api.heroku.com\n  login user@example.com\n  password %s\n", password)
+		assert.Nil(t, os.WriteFile(path, []byte(contents), 0600))
+		assert.Nil(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	writeNetrc("first", time.Now().Add(-time.Hour))
+	ts := NetrcTokenSource(path, "api.heroku.com")
+
+	token, err := ts.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "first", token)
+
+	writeNetrc("second", time.Now())
+
+	token, err = ts.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "second", token)
+
+	_, err = NetrcTokenSource(path, "example.com").Token(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestDefaultTokenSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".netrc")
+	t.Setenv(NetrcPathEnvVar, path)
+	t.Setenv(APIKeyEnvVar, "")
+
+	_, err := DefaultTokenSource()
+	assert.NotNil(t, err)
+
+	contents := "machine api.heroku.com\n  login user@example.com\n  password netrc-token\n"
+	assert.Nil(t, os.WriteFile(path, []byte(contents), 0600))
+
+	ts, err := DefaultTokenSource()
+	assert.Nil(t, err)
+	token, err := ts.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "netrc-token", token)
+
+	t.Setenv(APIKeyEnvVar, "env-token")
+
+	ts, err = DefaultTokenSource()
+	assert.Nil(t, err)
+	token, err = ts.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "env-token", token)
+}
+
+func TestExecTokenSource(t *testing.T) {
+	token, err := ExecTokenSource("echo", "plain-token").Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "plain-token", token)
+
+	token, err = ExecTokenSource("echo", `{"token":"json-token","expires_at":"2099-01-01T00:00:00Z"}`).
+		Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "json-token", token)
+
+	_, err = ExecTokenSource("false").Token(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestOAuthTokenSource_CachesUntilExpiry(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "refresh", r.PostForm.Get("refresh_token"))
+		assert.Equal(t, "secret", r.PostForm.Get("client_secret"))
+
+		// The first token expires within the expiry delta so it is refreshed on the next call.
+		expiresIn := 10
+		if n > 1 {
+			expiresIn = 3600
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"access-%d","token_type":"Bearer","expires_in":%d}`, n, expiresIn)
+	}))
+	defer server.Close()
+
+	ts := OAuthTokenSourceWithURL(server.URL, "secret", "refresh")
+
+	for _, expected := range []string{"access-1", "access-2", "access-2"} {
+		token, err := ts.Token(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, expected, token)
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
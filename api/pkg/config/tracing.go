@@ -0,0 +1,96 @@
+package config
+
+import "context"
+
+// Span and metric names emitted by the Client.
+const (
+	// WaitSpanName is the name of the span covering a wait loop. API call spans are named after the
+	// operation, such as "Kafka.GetTopicByName".
+	WaitSpanName = "herokux.wait"
+
+	// MetricAPICallDuration is the duration of an API call in seconds, including any retries.
+	MetricAPICallDuration = "herokux.api.call.duration"
+
+	// MetricWaitDuration is the duration of a wait loop in seconds.
+	MetricWaitDuration = "herokux.wait.duration"
+)
+
+// Attributes set on spans and measurements.
+const (
+	AttributeService         = "herokux.service"
+	AttributeOperation       = "herokux.operation"
+	AttributeHTTPMethod      = "http.request.method"
+	AttributeStatusCode      = "http.response.status_code"
+	AttributeRetryCount      = "herokux.retry_count"
+	AttributeWaitDescription = "herokux.wait.description"
+	AttributeWaitState       = "herokux.wait.state"
+	AttributeWaitPolls       = "herokux.wait.polls"
+)
+
+// Tracer starts spans for API calls and wait loops. Its shape mirrors the OpenTelemetry tracing API,
+// so adapting an OpenTelemetry Tracer only requires converting the attributes.
+type Tracer interface {
+	// Start starts a span and returns a context carrying it, which is used for any requests made during the span.
+	Start(ctx context.Context, name string, attrs map[string]interface{}) (context.Context, Span)
+}
+
+// Span is a single traced operation started by a Tracer.
+type Span interface {
+	SetAttributes(attrs map[string]interface{})
+	RecordError(err error)
+	End()
+}
+
+// Meter records measurements of API calls and wait loops.
+type Meter interface {
+	Record(ctx context.Context, name string, value float64, attrs map[string]interface{})
+}
+
+// NoopTracer is a Tracer that does nothing. It is the default Tracer.
+type NoopTracer struct{}
+
+// Start returns ctx unchanged and a Span that does nothing.
+func (NoopTracer) Start(ctx context.Context, _ string, _ map[string]interface{}) (context.Context, Span) {
+	return ctx, NoopSpan{}
+}
+
+// NoopSpan is a Span that does nothing.
+type NoopSpan struct{}
+
+// SetAttributes does nothing.
+func (NoopSpan) SetAttributes(map[string]interface{}) {}
+
+// RecordError does nothing.
+func (NoopSpan) RecordError(error) {}
+
+// End does nothing.
+func (NoopSpan) End() {}
+
+// NoopMeter is a Meter that does nothing. It is the default Meter.
+type NoopMeter struct{}
+
+// Record does nothing.
+func (NoopMeter) Record(context.Context, string, float64, map[string]interface{}) {}
+
+// TracerOrNoop returns t, or a NoopTracer if t is nil.
+func TracerOrNoop(t Tracer) Tracer {
+	if t == nil {
+		return NoopTracer{}
+	}
+	return t
+}
+
+// MeterOrNoop returns m, or a NoopMeter if m is nil.
+func MeterOrNoop(m Meter) Meter {
+	if m == nil {
+		return NoopMeter{}
+	}
+	return m
+}
+
+// IsNoop reports whether both t and m do nothing, in which case computing span attributes can be skipped.
+func IsNoop(t Tracer, m Meter) bool {
+	_, noopTracer := TracerOrNoop(t).(NoopTracer)
+	_, noopMeter := MeterOrNoop(m).(NoopMeter)
+	return noopTracer && noopMeter
+}
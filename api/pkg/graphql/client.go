@@ -0,0 +1,90 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
+)
+
+// Client executes GraphQL operations against a single endpoint.
+type Client struct {
+	http *rest.Client
+	path string
+}
+
+// body represents a GraphQL response body before its data is decoded.
+type body struct {
+	Data json.RawMessage `json:"data"`
+	ErrorResponse
+}
+
+// NewClient constructs a Client that sends operations to path, such as "/graphql", relative to the base URL of http.
+func NewClient(http *rest.Client, path string) *Client {
+	return &Client{http: http, path: path}
+}
+
+// Query executes a query and decodes its data into v. Queries are sent as GET requests, so they are
+// retried like any other idempotent request.
+func (c *Client) Query(ctx context.Context, query string, vars map[string]interface{}, v interface{}) (*simpleresty.Response, error) {
+	params := GetQueryParam{Query: query}
+	if len(vars) > 0 {
+		encoded, err := json.Marshal(vars)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode GraphQL variables: %w", err)
+		}
+		params.Variables = string(encoded)
+	}
+
+	urlStr, queryErr := c.http.RequestURLWithQueryParams(c.path, params)
+	if queryErr != nil {
+		return nil, queryErr
+	}
+
+	response, err := c.http.Get(ctx, urlStr, nil, nil)
+
+	return response, decode(response, err, v)
+}
+
+// Mutate executes a mutation and decodes its data into v. Mutations are sent as POST requests.
+func (c *Client) Mutate(ctx context.Context, mutation string, vars map[string]interface{}, v interface{}) (*simpleresty.Response, error) {
+	response, err := c.http.Post(ctx, c.http.RequestURL(c.path), nil, &Request{Query: mutation, Variables: vars})
+
+	return response, decode(response, err, v)
+}
+
+// decode decodes the data of a GraphQL response into v. Any errors in the response body are returned
+// as an *ErrorResponse wrapping the error of an unsuccessful HTTP response, if any.
+func decode(response *simpleresty.Response, err error, v interface{}) error {
+	if response == nil {
+		return err
+	}
+
+	var b body
+	if decodeErr := json.Unmarshal([]byte(response.Body), &b); decodeErr != nil {
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("unable to decode GraphQL response: %w", decodeErr)
+	}
+
+	if b.HasErrors() {
+		b.ErrorResponse.Err = err
+		return &b.ErrorResponse
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(b.Data) == 0 || string(b.Data) == "null" {
+		return fmt.Errorf("GraphQL response contains no data")
+	}
+
+	if decodeErr := json.Unmarshal(b.Data, v); decodeErr != nil {
+		return fmt.Errorf("unable to decode GraphQL response data: %w", decodeErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_Query_EncodesVariables(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/graphql", r.URL.Path)
+
+		var vars map[string]interface{}
+		assert.Nil(t, json.Unmarshal([]byte(r.URL.Query().Get("variables")), &vars))
+		assert.Equal(t, `my "quoted" slug`, vars["slug"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"clip":{"slug":"abc"}}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(rest.New(server.URL), "/graphql")
+
+	var result struct {
+		Clip struct {
+			Slug string `json:"slug"`
+		} `json:"clip"`
+	}
+
+	_, err := c.Query(context.Background(), "query FetchClip($slug: ID!) { clip(slug: $slug) { slug } }",
+		map[string]interface{}{"slug": `my "quoted" slug`}, &result)
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", result.Clip.Slug)
+}
+
+func TestClient_Mutate_ErrorsInSuccessfulResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var req Request
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "abc", req.Variables["clipId"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"deleteClip":null},"errors":[{"message":"clip is locked",` +
+			`"path":["deleteClip"],"locations":[{"line":2,"column":5}]}]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(rest.New(server.URL), "/graphql")
+
+	var result struct {
+		DeleteClip *string `json:"deleteClip"`
+	}
+
+	_, err := c.Mutate(context.Background(), "mutation DeleteClip($clipId: ID!) { deleteClip(clipId: $clipId) }",
+		map[string]interface{}{"clipId": "abc"}, &result)
+
+	var errResp *ErrorResponse
+	assert.True(t, errors.As(err, &errResp))
+	assert.Nil(t, errResp.Err)
+	assert.Equal(t, []interface{}{"deleteClip"}, errResp.Errors[0].Path)
+	assert.Equal(t, []Location{{Line: 2, Column: 5}}, errResp.Errors[0].Locations)
+	assert.Equal(t, "clip is locked (path: deleteClip) (line 2, column 5)", err.Error())
+}
+
+func TestClient_Query_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"message":"clip not found","extensions":{"code":"NOT_FOUND"}}]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(rest.New(server.URL), "/graphql")
+
+	var result struct{}
+	_, err := c.Query(context.Background(), "query FetchClip { clip { slug } }", nil, &result)
+
+	var errResp *ErrorResponse
+	assert.True(t, errors.As(err, &errResp))
+	assert.Equal(t, "NOT_FOUND", errResp.Errors[0].Extensions["code"])
+	assert.True(t, errors.Is(err, apierror.ErrNotFound))
+	assert.Equal(t, http.StatusNotFound, apierror.StatusCode(err))
+}
+
+func TestError_Error_DownstreamResponse(t *testing.T) {
+	e := &Error{
+		Message: "upstream failure",
+		DownstreamResponse: []DownstreamResponse{
+			{Path: []string{"privatelink", "connections"}, Locations: []Location{{Line: 3, Column: 7}}},
+		},
+	}
+
+	assert.Equal(t, "upstream failure (path: privatelink.connections) (line 3, column 7)", e.Error())
+}
+
+func TestClient_Query_NotFoundInSuccessfulResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"clip":null},"errors":[{"message":"clip not found","path":["clip"],` +
+			`"extensions":{"code":"NOT_FOUND"}}]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(rest.New(server.URL), "/graphql")
+
+	var result struct{}
+	_, err := c.Query(context.Background(), "query FetchClip { clip { slug } }", nil, &result)
+
+	var errResp *ErrorResponse
+	assert.True(t, errors.As(err, &errResp))
+	assert.Nil(t, errResp.Err)
+	assert.True(t, errors.Is(err, apierror.ErrNotFound))
+	assert.False(t, errors.Is(err, apierror.ErrConflict))
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	notFound := &ErrorResponse{}
+	notFound.Extensions.Code = "NOT_FOUND"
+	assert.True(t, errors.Is(notFound, apierror.ErrNotFound))
+
+	assert.False(t, errors.Is(&ErrorResponse{Errors: []Error{{Message: "clip is locked"}}}, apierror.ErrNotFound))
+	assert.False(t, errors.Is(&ErrorResponse{Errors: []Error{{Message: "boom", Extensions: map[string]interface{}{
+		"code": "INTERNAL_SERVER_ERROR",
+	}}}}, apierror.ErrNotFound))
+}
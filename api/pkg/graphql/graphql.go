@@ -1,10 +1,14 @@
 package graphql
 
 import (
-	"encoding/json"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
 	"strings"
 )
 
+// notFoundCode is the extensions code of errors about resources that do not exist.
+const notFoundCode = "NOT_FOUND"
+
 // Request represents a Graphql request.
 type Request struct {
 	Query     string                 `json:"query"`
@@ -18,17 +22,23 @@ type Response struct {
 
 // Error represents a GraphQL error.
 type Error struct {
-	Message            string               `json:"message"`
-	DownstreamResponse []DownstreamResponse `json:"downstreamResponse,omitempty"`
+	Message            string                 `json:"message"`
+	Path               []interface{}          `json:"path,omitempty"`
+	Locations          []Location             `json:"locations,omitempty"`
+	Extensions         map[string]interface{} `json:"extensions,omitempty"`
+	DownstreamResponse []DownstreamResponse   `json:"downstreamResponse,omitempty"`
+}
+
+// Location represents the line and column in the query that an error relates to.
+type Location struct {
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
 }
 
 // DownstreamResponse represents an error's downstream response.
 type DownstreamResponse struct {
-	Locations []struct {
-		Line   int `json:"line,omitempty"`
-		Column int `json:"column,omitempty"`
-	} `json:"locations,omitempty"`
-	Path []string `json:"path,omitempty"`
+	Locations []Location `json:"locations,omitempty"`
+	Path      []string   `json:"path,omitempty"`
 }
 
 // ErrorResponse represents the default GraphQL error response body.
@@ -41,6 +51,10 @@ type ErrorResponse struct {
 			Reason string `json:"reason,omitempty"`
 		} `json:"validationErrors,omitempty"`
 	} `json:"extensions,omitempty"`
+
+	// Err is the error of an unsuccessful HTTP response, if any. Use errors.Is with the api package's
+	// sentinel errors, such as api.ErrNotFound, to inspect it.
+	Err error `json:"-"`
 }
 
 // GetQueryParam represents a query parameter to used with GraphQL GET requests.
@@ -49,17 +63,88 @@ type GetQueryParam struct {
 	Variables string `url:"variables,omitempty"`
 }
 
+// Error returns the message followed by the path and locations in the query the error relates to, if known.
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Message)
+
+	path, locations := e.position()
+
+	if len(path) > 0 {
+		segments := make([]string, 0, len(path))
+		for _, p := range path {
+			segments = append(segments, fmt.Sprint(p))
+		}
+		fmt.Fprintf(&b, " (path: %s)", strings.Join(segments, "."))
+	}
+
+	for _, l := range locations {
+		fmt.Fprintf(&b, " (line %d, column %d)", l.Line, l.Column)
+	}
+
+	return b.String()
+}
+
+// position returns the path and locations of the error, falling back to those of its downstream responses.
+func (e *Error) position() ([]interface{}, []Location) {
+	path, locations := e.Path, e.Locations
+
+	for _, d := range e.DownstreamResponse {
+		if len(path) == 0 {
+			for _, p := range d.Path {
+				path = append(path, p)
+			}
+		}
+		if len(locations) == 0 {
+			locations = d.Locations
+		}
+	}
+
+	return path, locations
+}
+
 func (r *ErrorResponse) Error() string {
-	if len(r.Errors) > 0 {
-		var messages []string
-		for _, e := range r.Errors {
-			f, _ := json.Marshal(e.DownstreamResponse)
+	messages := make([]string, 0, len(r.Errors)+len(r.Extensions.ValidationErrors))
+	for _, e := range r.Errors {
+		messages = append(messages, e.Error())
+	}
+	for _, v := range r.Extensions.ValidationErrors {
+		messages = append(messages, fmt.Sprintf("%s: %s", v.Name, v.Reason))
+	}
+
+	msg := strings.Join(messages, "; ")
+	if r.Err != nil {
+		return fmt.Sprintf("%s: %s", r.Err, msg)
+	}
+
+	return msg
+}
 
-			messages = append(messages, e.Message)
-			messages = append(messages, string(f))
+func (r *ErrorResponse) Unwrap() error {
+	return r.Err
+}
+
+// Is reports whether the response matches target. A response with a NOT_FOUND error code matches
+// apierror.ErrNotFound, as GraphQL APIs may return it in a successful HTTP response.
+func (r *ErrorResponse) Is(target error) bool {
+	if target != apierror.ErrNotFound {
+		return false
+	}
+
+	if r.Extensions.Code == notFoundCode {
+		return true
+	}
+
+	for _, e := range r.Errors {
+		if e.Extensions["code"] == notFoundCode {
+			return true
 		}
-		return strings.Join(messages, ", ")
 	}
 
-	return ""
+	return false
+}
+
+// HasErrors reports whether the response contains any errors.
+func (r *ErrorResponse) HasErrors() bool {
+	return len(r.Errors) > 0 || len(r.Extensions.ValidationErrors) > 0
 }
@@ -0,0 +1,46 @@
+package rest
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+)
+
+// Authorizer returns the value of the Authorization header for a request.
+type Authorizer func(ctx context.Context) (string, error)
+
+// BearerAuthorizer returns an Authorizer for the APIs that use Bearer authentication.
+// The token is retrieved from the config's APITokenSource if set, otherwise its APIToken is used.
+func BearerAuthorizer(cfg *config.Config) Authorizer {
+	return func(ctx context.Context) (string, error) {
+		if cfg.APITokenSource == nil {
+			return fmt.Sprintf("Bearer %s", cfg.APIToken), nil
+		}
+
+		token, err := cfg.APITokenSource.Token(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("Bearer %s", token), nil
+	}
+}
+
+// BasicAuthorizer returns an Authorizer for the APIs that use HTTP Basic authentication.
+// The token is retrieved from the config's APITokenSource if set, otherwise its BasicAuth is used.
+func BasicAuthorizer(cfg *config.Config) Authorizer {
+	return func(ctx context.Context) (string, error) {
+		if cfg.APITokenSource == nil {
+			return fmt.Sprintf("Basic %s", cfg.BasicAuth), nil
+		}
+
+		token, err := cfg.APITokenSource.Token(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		userPass := fmt.Sprintf("%s:%s", cfg.BasicAuthUsername, token)
+		return fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(userPass))), nil
+	}
+}
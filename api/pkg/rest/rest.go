@@ -0,0 +1,336 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/retry"
+	"github.com/go-resty/resty/v2"
+)
+
+// Client wraps a simpleresty.Client so every HTTP request is bound to a context.Context.
+//
+// The embedded simpleresty.Client is still used to configure client wide headers and timeouts, which must
+// only be done when the Client is constructed. Once constructed, a Client is safe for concurrent use:
+// the request execution and URL methods are shadowed so that per-request headers are set on the request
+// rather than the shared client and the base URL can be changed safely.
+type Client struct {
+	*simpleresty.Client
+
+	retry      retry.Policy
+	logger     config.Logger
+	authorizer Authorizer
+	service    config.Service
+	tracer     config.Tracer
+	meter      config.Meter
+
+	// baseURLMu guards baseURL.
+	baseURLMu sync.RWMutex
+	baseURL   string
+
+	// throttleMu guards throttleUntil, which is shared by every request made with this Client.
+	throttleMu    sync.Mutex
+	throttleUntil time.Time
+}
+
+// RequestOption customizes a single request without modifying the Client shared by other requests.
+type RequestOption func(*resty.Request)
+
+// WithHeader sets a header on a single request, overriding any client wide header of the same name.
+func WithHeader(key, value string) RequestOption {
+	return func(r *resty.Request) {
+		r.SetHeader(key, value)
+	}
+}
+
+// headersContextKey is the context key for request headers.
+type headersContextKey struct{}
+
+// ContextWithHeaders returns a copy of ctx carrying headers that are set on every request made with it.
+// Headers are merged with any headers already carried by ctx.
+func ContextWithHeaders(ctx context.Context, headers map[string]string) context.Context {
+	merged := make(map[string]string)
+	for k, v := range HeadersFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range headers {
+		merged[k] = v
+	}
+
+	return context.WithValue(ctx, headersContextKey{}, merged)
+}
+
+// HeadersFromContext returns the headers carried by ctx, if any.
+func HeadersFromContext(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+
+	h, _ := ctx.Value(headersContextKey{}).(map[string]string)
+	return h
+}
+
+// New constructs a new Client with the base url set and the default retry policy.
+func New(baseURL string) *Client {
+	c := &Client{Client: simpleresty.NewWithBaseURL(baseURL), retry: retry.DefaultPolicy(), baseURL: baseURL}
+
+	// simpleresty lazily configures any proxy on the first request it constructs,
+	// so do it now rather than racing on it later.
+	c.Client.ConstructRequest(nil, nil)
+
+	return c
+}
+
+// NewWithConfig constructs a new Client with the base url set and the retry policy,
+// HTTP client and transport from the config applied.
+func NewWithConfig(baseURL string, cfg *config.Config) *Client {
+	return New(baseURL).SetRetryPolicy(cfg.RetryPolicy).SetHTTPClient(cfg.HTTPClient).SetTransport(cfg.Transport).
+		SetLogger(cfg.Logger).SetTracer(cfg.Tracer).SetMeter(cfg.Meter)
+}
+
+// SetHTTPClient copies the transport, cookie jar, redirect policy and timeout of hc into the Client's HTTP client.
+// A nil hc is ignored.
+func (c *Client) SetHTTPClient(hc *http.Client) *Client {
+	if hc == nil {
+		return c
+	}
+
+	own := c.GetClient()
+	if hc.Transport != nil {
+		own.Transport = hc.Transport
+	}
+	if hc.Jar != nil {
+		own.Jar = hc.Jar
+	}
+	if hc.CheckRedirect != nil {
+		own.CheckRedirect = hc.CheckRedirect
+	}
+	if hc.Timeout > 0 {
+		own.Timeout = hc.Timeout
+	}
+
+	return c
+}
+
+// SetTransport sets the http.RoundTripper used for all requests. A nil rt is ignored.
+func (c *Client) SetTransport(rt http.RoundTripper) *Client {
+	if rt != nil {
+		c.Client.SetTransport(rt)
+	}
+	return c
+}
+
+// SetBaseURL sets the base URL used by RequestURL and RequestURLWithQueryParams.
+func (c *Client) SetBaseURL(url string) {
+	c.baseURLMu.Lock()
+	defer c.baseURLMu.Unlock()
+
+	c.baseURL = url
+}
+
+// BaseURL returns the base URL.
+func (c *Client) BaseURL() string {
+	c.baseURLMu.RLock()
+	defer c.baseURLMu.RUnlock()
+
+	return c.baseURL
+}
+
+// RequestURL appends the template argument to the base URL and returns the full request URL.
+func (c *Client) RequestURL(template string, args ...interface{}) string {
+	if len(args) == 1 && args[0] == "" {
+		return c.BaseURL() + template
+	}
+	return c.BaseURL() + fmt.Sprintf(template, args...)
+}
+
+// RequestURLWithQueryParams first constructs the request URL and then appends any URL encoded query parameters.
+func (c *Client) RequestURLWithQueryParams(url string, opts ...interface{}) (string, error) {
+	return simpleresty.AddQueryParams(c.RequestURL(url), opts...)
+}
+
+// SetRetryPolicy sets the policy used to retry failed requests.
+func (c *Client) SetRetryPolicy(p retry.Policy) *Client {
+	c.retry = p
+	return c
+}
+
+// SetLogger sets the Logger used to log every request. A nil l disables logging.
+func (c *Client) SetLogger(l config.Logger) *Client {
+	c.logger = l
+	return c
+}
+
+// SetAuthorizer sets the Authorizer consulted for the Authorization header before every request.
+func (c *Client) SetAuthorizer(a Authorizer) *Client {
+	c.authorizer = a
+	return c
+}
+
+// SetService sets the service that spans and measurements of every request are attributed to.
+func (c *Client) SetService(s config.Service) *Client {
+	c.service = s
+	return c
+}
+
+// SetTracer sets the Tracer used to trace every request. A nil t disables tracing.
+func (c *Client) SetTracer(t config.Tracer) *Client {
+	c.tracer = t
+	return c
+}
+
+// SetMeter sets the Meter used to measure every request. A nil m disables measurements.
+func (c *Client) SetMeter(m config.Meter) *Client {
+	c.meter = m
+	return c
+}
+
+// Get executes a HTTP GET request.
+func (c *Client) Get(ctx context.Context, url string, r, body interface{}, opts ...RequestOption) (*simpleresty.Response, error) {
+	return c.Do(ctx, simpleresty.GetMethod, url, r, body, opts...)
+}
+
+// Post executes a HTTP POST request.
+func (c *Client) Post(ctx context.Context, url string, r, body interface{}, opts ...RequestOption) (*simpleresty.Response, error) {
+	return c.Do(ctx, simpleresty.PostMethod, url, r, body, opts...)
+}
+
+// Put executes a HTTP PUT request.
+func (c *Client) Put(ctx context.Context, url string, r, body interface{}, opts ...RequestOption) (*simpleresty.Response, error) {
+	return c.Do(ctx, simpleresty.PutMethod, url, r, body, opts...)
+}
+
+// Patch executes a HTTP PATCH request.
+func (c *Client) Patch(ctx context.Context, url string, r, body interface{}, opts ...RequestOption) (*simpleresty.Response, error) {
+	return c.Do(ctx, simpleresty.PatchMethod, url, r, body, opts...)
+}
+
+// Delete executes a HTTP DELETE request.
+func (c *Client) Delete(ctx context.Context, url string, r, body interface{}, opts ...RequestOption) (*simpleresty.Response, error) {
+	return c.Do(ctx, simpleresty.DeleteMethod, url, r, body, opts...)
+}
+
+// Do executes a HTTP request with the given method. The request is cancelled
+// when ctx is cancelled or its deadline is exceeded.
+//
+// The Authorization header from the Client's Authorizer is applied first, followed by headers carried by ctx
+// and finally opts.
+//
+// Rate limited requests, and idempotent requests that fail with a server error, are retried
+// according to the Client's retry policy.
+//
+// If the Client has a Logger, every attempt is logged with any secrets redacted. If the Client has a Tracer or
+// a Meter, a single span and measurement covers all attempts.
+//
+// If the API responds with an unsuccessful status code, the returned error is an *apierror.Error.
+func (c *Client) Do(ctx context.Context, method, url string, r, body interface{}, opts ...RequestOption) (*simpleresty.Response, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, end := c.traceRequest(ctx, method)
+	response, retries, err := c.do(ctx, method, url, r, body, opts...)
+	end(response, retries, err)
+
+	return response, err
+}
+
+// do executes a HTTP request and any retries, returning the response of the last attempt and the number of retries.
+func (c *Client) do(ctx context.Context, method, url string, r, body interface{}, opts ...RequestOption) (*simpleresty.Response, int, error) {
+	for attempt := 0; ; attempt++ {
+		if err := sleep(ctx, c.throttleDelay()); err != nil {
+			return nil, attempt, err
+		}
+
+		req := c.ConstructRequest(r, body).SetContext(ctx)
+		req.Method = method
+		req.URL = url
+		if c.authorizer != nil {
+			authorization, authErr := c.authorizer(ctx)
+			if authErr != nil {
+				return nil, attempt, fmt.Errorf("unable to retrieve API token: %w", authErr)
+			}
+			req.SetHeader("Authorization", authorization)
+		}
+		req.SetHeaders(HeadersFromContext(ctx))
+		for _, opt := range opts {
+			opt(req)
+		}
+
+		start := time.Now()
+		response, err := c.Dispatch(req)
+		c.logRequest(ctx, req, response, attempt, time.Since(start), err)
+
+		var header http.Header
+		statusCode := 0
+		if response != nil {
+			statusCode = response.StatusCode
+			if response.Resp != nil {
+				header = response.Resp.Header()
+			}
+		}
+		c.observe(header)
+
+		// simpleresty reports some successful responses, such as 206 Partial Content, as errors.
+		if err != nil && statusCode >= 200 && statusCode < 300 {
+			err = nil
+		}
+
+		if ctx.Err() != nil || !c.retry.ShouldRetry(attempt, method, statusCode, err) {
+			if err != nil && response != nil {
+				return response, attempt, apierror.FromResponse(response)
+			}
+			return response, attempt, err
+		}
+
+		if err := sleep(ctx, c.retry.Backoff(attempt, header)); err != nil {
+			return response, attempt, err
+		}
+	}
+}
+
+// observe records when the next request may be sent based on the rate limit headers of a response.
+func (c *Client) observe(header http.Header) {
+	d := c.retry.Throttle(header)
+	if d <= 0 {
+		return
+	}
+
+	c.throttleMu.Lock()
+	defer c.throttleMu.Unlock()
+
+	if until := time.Now().Add(d); until.After(c.throttleUntil) {
+		c.throttleUntil = until
+	}
+}
+
+// throttleDelay returns how long to wait before the next request may be sent.
+func (c *Client) throttleDelay() time.Duration {
+	c.throttleMu.Lock()
+	defer c.throttleMu.Unlock()
+
+	return time.Until(c.throttleUntil)
+}
+
+// sleep waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
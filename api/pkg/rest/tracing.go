@@ -0,0 +1,78 @@
+package rest
+
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// restPackage is the import path of this package, whose frames are skipped when naming an operation.
+var restPackage = reflect.TypeOf(Client{}).PkgPath() + "."
+
+// apiPackage is the import path prefix of the sub-client packages, such as ".../api/kafka".
+var apiPackage = strings.TrimSuffix(restPackage, "pkg/rest.")
+
+// operationRegex matches the receiver type and method name of a function name,
+// such as "Kafka" and "GetTopicByName" in "github.com/.../api/kafka.(*Kafka).GetTopicByName".
+var operationRegex = regexp.MustCompile(`\.\(\*?(\w+)\)\.(\w+)`)
+
+// traceRequest starts a span for an API call and returns a function that ends it and records its duration.
+// Nothing is traced if the Client has neither a Tracer nor a Meter.
+func (c *Client) traceRequest(ctx context.Context, method string) (context.Context, func(*simpleresty.Response, int, error)) {
+	if config.IsNoop(c.tracer, c.meter) {
+		return ctx, func(*simpleresty.Response, int, error) {}
+	}
+
+	operation := operationName(method)
+	attrs := map[string]interface{}{
+		config.AttributeService:    string(c.service),
+		config.AttributeOperation:  operation,
+		config.AttributeHTTPMethod: method,
+	}
+
+	start := time.Now()
+	ctx, span := config.TracerOrNoop(c.tracer).Start(ctx, operation, attrs)
+
+	return ctx, func(response *simpleresty.Response, retries int, err error) {
+		endAttrs := map[string]interface{}{config.AttributeRetryCount: retries}
+		if response != nil {
+			endAttrs[config.AttributeStatusCode] = response.StatusCode
+		}
+
+		span.SetAttributes(endAttrs)
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+
+		for k, v := range endAttrs {
+			attrs[k] = v
+		}
+		config.MeterOrNoop(c.meter).Record(ctx, config.MetricAPICallDuration, time.Since(start).Seconds(), attrs)
+	}
+}
+
+// operationName returns the name of the sub-client method making a request, such as "Kafka.GetTopicByName".
+// If it can't be determined, the HTTP method is used instead.
+func operationName(method string) string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+		if strings.HasPrefix(frame.Function, apiPackage) && !strings.HasPrefix(frame.Function, restPackage) {
+			if m := operationRegex.FindStringSubmatch(frame.Function); m != nil {
+				return m[1] + "." + m[2]
+			}
+		}
+
+		if !more {
+			return "HTTP " + method
+		}
+	}
+}
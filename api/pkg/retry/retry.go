@@ -0,0 +1,156 @@
+package retry
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a request is retried.
+	DefaultMaxRetries = 8
+
+	// DefaultWaitMin is the default minimum time to wait before retrying a request.
+	DefaultWaitMin = 1 * time.Second
+
+	// DefaultWaitMax is the default maximum time to wait before retrying a request.
+	DefaultWaitMax = 60 * time.Second
+
+	// RetryAfterHeader is the response header a server uses to indicate how long to wait before retrying.
+	RetryAfterHeader = "Retry-After"
+
+	// RateLimitRemainingHeader is the response header Heroku uses to indicate the number of remaining requests.
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+)
+
+// DefaultIdempotentMethods are the HTTP methods that are safe to retry after a server error or a network failure.
+var DefaultIdempotentMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete,
+}
+
+// Policy determines whether and when a failed request is retried.
+//
+// Requests rejected with a 429 are always retried as the API did not process them. Requests that fail with a
+// 5xx status code or a network error are only retried if their HTTP method is idempotent.
+type Policy struct {
+	// MaxRetries is the maximum number of retries. Zero disables retries.
+	MaxRetries int
+
+	// WaitMin is the minimum time to wait between retries.
+	WaitMin time.Duration
+
+	// WaitMax is the maximum time to wait between retries.
+	WaitMax time.Duration
+
+	// IdempotentMethods are the HTTP methods retried after a server error or a network failure.
+	IdempotentMethods []string
+}
+
+// DefaultPolicy returns the default retry policy.
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxRetries:        DefaultMaxRetries,
+		WaitMin:           DefaultWaitMin,
+		WaitMax:           DefaultWaitMax,
+		IdempotentMethods: DefaultIdempotentMethods,
+	}
+}
+
+// IsIdempotent returns true if the HTTP method is safe to retry.
+func (p Policy) IsIdempotent(method string) bool {
+	for _, m := range p.IdempotentMethods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldRetry returns true if a request should be retried. A statusCode of zero means no response was received.
+func (p Policy) ShouldRetry(attempt int, method string, statusCode int, err error) bool {
+	if attempt >= p.MaxRetries {
+		return false
+	}
+
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		return true
+	case statusCode == 0 && err != nil:
+		return p.IsIdempotent(method)
+	case statusCode == http.StatusInternalServerError, statusCode == http.StatusBadGateway,
+		statusCode == http.StatusServiceUnavailable, statusCode == http.StatusGatewayTimeout:
+		return p.IsIdempotent(method)
+	}
+
+	return false
+}
+
+// Backoff returns how long to wait before the next attempt. The zero-based attempt is the number of retries
+// already made. A Retry-After response header takes precedence over the exponential backoff; otherwise the
+// wait doubles every attempt starting from WaitMin with up to 50% jitter and is capped at WaitMax.
+func (p Policy) Backoff(attempt int, header http.Header) time.Duration {
+	if d, ok := RetryAfter(header); ok {
+		if d > p.WaitMax {
+			return p.WaitMax
+		}
+		return d
+	}
+
+	wait := float64(p.WaitMin) * math.Pow(2, float64(attempt))
+	if wait > float64(p.WaitMax) || math.IsInf(wait, 0) {
+		wait = float64(p.WaitMax)
+	}
+
+	// Apply jitter so concurrent requests that were rate limited together do not retry together.
+	half := int64(wait / 2)
+	if half <= 0 {
+		return time.Duration(wait)
+	}
+
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// Throttle returns how long to wait before sending the next request given the headers of the previous response.
+// Heroku returns a RateLimit-Remaining header; once it reaches zero, further requests are rejected until tokens
+// are replenished, so the next request is delayed by WaitMin.
+func (p Policy) Throttle(header http.Header) time.Duration {
+	if header == nil {
+		return 0
+	}
+
+	remaining, err := strconv.Atoi(header.Get(RateLimitRemainingHeader))
+	if err != nil || remaining > 0 {
+		return 0
+	}
+
+	return p.WaitMin
+}
+
+// RetryAfter parses the Retry-After header, which is either a number of seconds or an HTTP date.
+func RetryAfter(header http.Header) (time.Duration, bool) {
+	if header == nil {
+		return 0, false
+	}
+
+	v := header.Get(RetryAfterHeader)
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,57 @@
+package retry
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPolicy_ShouldRetry(t *testing.T) {
+	p := DefaultPolicy()
+
+	assert.True(t, p.ShouldRetry(0, http.MethodPost, http.StatusTooManyRequests, nil))
+	assert.True(t, p.ShouldRetry(0, http.MethodGet, http.StatusServiceUnavailable, nil))
+	assert.True(t, p.ShouldRetry(0, http.MethodDelete, 0, errors.New("connection reset")))
+
+	assert.False(t, p.ShouldRetry(0, http.MethodPost, http.StatusServiceUnavailable, nil))
+	assert.False(t, p.ShouldRetry(0, http.MethodPatch, 0, errors.New("connection reset")))
+	assert.False(t, p.ShouldRetry(0, http.MethodGet, http.StatusNotFound, nil))
+	assert.False(t, p.ShouldRetry(p.MaxRetries, http.MethodGet, http.StatusTooManyRequests, nil))
+}
+
+func TestPolicy_Backoff_Exponential(t *testing.T) {
+	p := Policy{WaitMin: 2 * time.Second, WaitMax: 10 * time.Second}
+
+	for attempt, max := range []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		d := p.Backoff(attempt, nil)
+		assert.LessOrEqual(t, d, max)
+		assert.GreaterOrEqual(t, d, max/2)
+	}
+}
+
+func TestPolicy_Backoff_RetryAfter(t *testing.T) {
+	p := Policy{WaitMin: time.Second, WaitMax: 30 * time.Second}
+
+	assert.Equal(t, 5*time.Second, p.Backoff(0, http.Header{RetryAfterHeader: []string{"5"}}))
+	assert.Equal(t, 30*time.Second, p.Backoff(0, http.Header{RetryAfterHeader: []string{"120"}}))
+
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	d := p.Backoff(0, http.Header{RetryAfterHeader: []string{date}})
+	assert.LessOrEqual(t, d, 10*time.Second)
+	assert.Greater(t, d, 8*time.Second)
+}
+
+func TestPolicy_Throttle(t *testing.T) {
+	p := Policy{WaitMin: time.Second}
+
+	assert.Equal(t, time.Duration(0), p.Throttle(nil))
+
+	header := http.Header{}
+	header.Set(RateLimitRemainingHeader, "100")
+	assert.Equal(t, time.Duration(0), p.Throttle(header))
+
+	header.Set(RateLimitRemainingHeader, "0")
+	assert.Equal(t, time.Second, p.Throttle(header))
+}
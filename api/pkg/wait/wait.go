@@ -0,0 +1,312 @@
+// Package wait polls asynchronous Heroku operations, such as provisioning a database add-on or a Kafka topic,
+// until they reach a target state.
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"log"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPollInterval is the default time to wait between refreshes.
+	DefaultPollInterval = 20 * time.Second
+
+	// DefaultMaxTransientErrors is the default number of consecutive transient errors tolerated before waiting fails.
+	DefaultMaxTransientErrors = 10
+
+	// UnlimitedTransientErrors tolerates transient errors until the Timeout elapses.
+	UnlimitedTransientErrors = -1
+
+	// pollIntervalMultiplier is how much the poll interval grows after every refresh that doesn't reach a target
+	// state until it reaches the maximum poll interval.
+	pollIntervalMultiplier = 1.5
+
+	// maxPollIntervalMultiplier determines the default maximum poll interval relative to the poll interval.
+	maxPollIntervalMultiplier = 3
+)
+
+// RefreshFunc returns the current value of the resource being waited on and its state.
+type RefreshFunc[T any, S comparable] func(ctx context.Context) (T, S, error)
+
+// Waiter waits for a resource to reach one of the Target states.
+//
+// Refresh is called until it reports a Target state, a state that is neither Pending nor Target, or a
+// non-transient error. The time between refreshes starts at PollInterval and grows with jitter up to
+// MaxPollInterval. Waiting stops when Timeout elapses or ctx is done, whichever happens first.
+type Waiter[T any, S comparable] struct {
+	// Description describes what is being waited on in progress logs and errors,
+	// such as "MTLS on my-database to be operational".
+	Description string
+
+	// Pending are the states that are expected while waiting.
+	Pending []S
+
+	// Target are the states that end waiting successfully.
+	Target []S
+
+	// Refresh retrieves the current value and state.
+	Refresh RefreshFunc[T, S]
+
+	// Timeout is the maximum time to wait. Zero waits until ctx is done.
+	Timeout time.Duration
+
+	// Delay is the time to wait before the first refresh.
+	Delay time.Duration
+
+	// PollInterval is the initial time to wait between refreshes. Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+
+	// MaxPollInterval is the maximum time to wait between refreshes. Defaults to three times the PollInterval.
+	MaxPollInterval time.Duration
+
+	// IsTransient reports whether a Refresh error is transient and the refresh should be tried again.
+	// Defaults to IsTransientError.
+	IsTransient func(err error) bool
+
+	// MaxTransientErrors is the number of consecutive transient errors tolerated. Defaults to DefaultMaxTransientErrors.
+	// Set it to UnlimitedTransientErrors to tolerate transient errors until the Timeout elapses.
+	MaxTransientErrors int
+
+	// Logf logs the progress of waiting. Defaults to log.Printf.
+	Logf func(format string, v ...interface{})
+
+	// Tracer, if set, starts a span covering the whole wait loop.
+	Tracer config.Tracer
+
+	// Meter, if set, records the duration of the wait loop.
+	Meter config.Meter
+}
+
+// progress is the state of a wait loop reported to the Tracer and Meter once waiting ends.
+type progress struct {
+	state string
+	polls int
+}
+
+// TimeoutError is returned when the target state is not reached in time.
+type TimeoutError struct {
+	Description string
+	LastState   string
+	LastError   error
+	Timeout     time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	msg := fmt.Sprintf("timeout while waiting for %s", e.Description)
+	if e.Timeout > 0 {
+		msg = fmt.Sprintf("%s (timeout: %s)", msg, e.Timeout)
+	}
+	if e.LastState != "" {
+		msg = fmt.Sprintf("%s, last state: %q", msg, e.LastState)
+	}
+	if e.LastError != nil {
+		msg = fmt.Sprintf("%s, last error: %s", msg, e.LastError)
+	}
+	return msg
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.LastError
+}
+
+// UnexpectedStateError is returned when the resource reaches a state that is neither pending nor a target.
+type UnexpectedStateError struct {
+	Description string
+	State       string
+	Expected    []string
+}
+
+func (e *UnexpectedStateError) Error() string {
+	return fmt.Sprintf("unexpected state %q while waiting for %s, wanted one of: %s",
+		e.State, e.Description, strings.Join(e.Expected, ", "))
+}
+
+// IsTransientError reports whether err is a rate limit or a server error that is likely to succeed if tried again.
+func IsTransientError(err error) bool {
+	return errors.Is(err, apierror.ErrRateLimited) || apierror.StatusCode(err) >= http.StatusInternalServerError
+}
+
+// Wait waits for the resource to reach one of the Target states and returns its value in that state.
+func (w *Waiter[T, S]) Wait(ctx context.Context) (T, error) {
+	if config.IsNoop(w.Tracer, w.Meter) {
+		return w.wait(ctx, &progress{})
+	}
+
+	attrs := map[string]interface{}{config.AttributeWaitDescription: w.Description}
+
+	start := time.Now()
+	ctx, span := config.TracerOrNoop(w.Tracer).Start(ctx, config.WaitSpanName, attrs)
+
+	p := &progress{}
+	value, err := w.wait(ctx, p)
+
+	attrs[config.AttributeWaitState] = p.state
+	attrs[config.AttributeWaitPolls] = p.polls
+
+	span.SetAttributes(attrs)
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+
+	config.MeterOrNoop(w.Meter).Record(ctx, config.MetricWaitDuration, time.Since(start).Seconds(), attrs)
+
+	return value, err
+}
+
+func (w *Waiter[T, S]) wait(ctx context.Context, p *progress) (T, error) {
+	var zero T
+
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+
+	interval := w.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	maxInterval := w.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = interval * maxPollIntervalMultiplier
+	}
+
+	isTransient := w.IsTransient
+	if isTransient == nil {
+		isTransient = IsTransientError
+	}
+
+	maxTransientErrors := w.MaxTransientErrors
+	if maxTransientErrors == 0 {
+		maxTransientErrors = DefaultMaxTransientErrors
+	}
+
+	logf := w.Logf
+	if logf == nil {
+		logf = log.Printf
+	}
+
+	var lastState S
+	var lastErr error
+	refreshed := false
+	transientErrors := 0
+
+	next := w.Delay
+	for {
+		if err := sleep(ctx, next); err != nil {
+			return zero, w.doneError(err, refreshed, lastState, lastErr)
+		}
+
+		value, state, err := w.Refresh(ctx)
+		p.polls++
+		if err != nil {
+			if ctx.Err() != nil {
+				return zero, w.doneError(ctx.Err(), refreshed, lastState, err)
+			}
+
+			transientErrors++
+			if !isTransient(err) || (maxTransientErrors > 0 && transientErrors > maxTransientErrors) {
+				return zero, fmt.Errorf("error waiting for %s: %w", w.Description, err)
+			}
+
+			logf("[DEBUG] Transient error while waiting for %s, will try again: %s", w.Description, err)
+			lastErr = err
+		} else {
+			transientErrors = 0
+			lastErr = nil
+			refreshed = true
+			lastState = state
+			p.state = fmt.Sprint(state)
+
+			if contains(w.Target, state) {
+				logf("[DEBUG] Finished waiting for %s: %v", w.Description, state)
+				return value, nil
+			}
+
+			if !contains(w.Pending, state) {
+				return zero, &UnexpectedStateError{
+					Description: w.Description,
+					State:       fmt.Sprint(state),
+					Expected:    toStrings(append(append([]S{}, w.Pending...), w.Target...)),
+				}
+			}
+
+			logf("[DEBUG] Still waiting for %s: %v", w.Description, state)
+		}
+
+		next = jitter(interval)
+		interval = time.Duration(float64(interval) * pollIntervalMultiplier)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// doneError returns the error for when ctx is done. Cancellation is wrapped as is, whereas exceeding
+// the Timeout or the ctx deadline is reported as a TimeoutError.
+func (w *Waiter[T, S]) doneError(err error, refreshed bool, lastState S, lastErr error) error {
+	if errors.Is(err, context.Canceled) {
+		return fmt.Errorf("stopped waiting for %s: %w", w.Description, err)
+	}
+
+	timeoutErr := &TimeoutError{Description: w.Description, LastError: lastErr, Timeout: w.Timeout}
+	if refreshed {
+		timeoutErr.LastState = fmt.Sprint(lastState)
+	}
+
+	return timeoutErr
+}
+
+// jitter returns d adjusted by up to 10% in either direction so concurrent waiters don't poll together.
+func jitter(d time.Duration) time.Duration {
+	tenth := int64(d / 10)
+	if tenth <= 0 {
+		return d
+	}
+
+	return d - time.Duration(tenth) + time.Duration(rand.Int63n(2*tenth+1))
+}
+
+func contains[S comparable](states []S, state S) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+func toStrings[S comparable](states []S) []string {
+	result := make([]string, 0, len(states))
+	for _, s := range states {
+		result = append(result, fmt.Sprint(s))
+	}
+	return result
+}
+
+// sleep waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,191 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type testStatus string
+
+// sequence returns a RefreshFunc that reports each state in turn, repeating the last one.
+func sequence(states ...testStatus) (RefreshFunc[string, testStatus], *int) {
+	calls := 0
+	return func(_ context.Context) (string, testStatus, error) {
+		state := states[len(states)-1]
+		if calls < len(states) {
+			state = states[calls]
+		}
+		calls++
+		return "value-" + string(state), state, nil
+	}, &calls
+}
+
+func newTestWaiter(refresh RefreshFunc[string, testStatus]) *Waiter[string, testStatus] {
+	return &Waiter[string, testStatus]{
+		Description:  "test resource to be ready",
+		Pending:      []testStatus{"pending"},
+		Target:       []testStatus{"ready"},
+		Refresh:      refresh,
+		PollInterval: time.Millisecond,
+		Logf:         func(string, ...interface{}) {},
+	}
+}
+
+func TestWaiter_Wait_ReachesTarget(t *testing.T) {
+	refresh, calls := sequence("pending", "pending", "ready")
+
+	value, err := newTestWaiter(refresh).Wait(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "value-ready", value)
+	assert.Equal(t, 3, *calls)
+}
+
+func TestWaiter_Wait_UnexpectedState(t *testing.T) {
+	refresh, _ := sequence("pending", "failed")
+
+	_, err := newTestWaiter(refresh).Wait(context.Background())
+
+	var stateErr *UnexpectedStateError
+	assert.True(t, errors.As(err, &stateErr))
+	assert.Equal(t, "failed", stateErr.State)
+	assert.Equal(t, []string{"pending", "ready"}, stateErr.Expected)
+}
+
+func TestWaiter_Wait_Timeout(t *testing.T) {
+	refresh, _ := sequence("pending")
+
+	w := newTestWaiter(refresh)
+	w.Timeout = 20 * time.Millisecond
+
+	_, err := w.Wait(context.Background())
+
+	var timeoutErr *TimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "pending", timeoutErr.LastState)
+}
+
+func TestWaiter_Wait_Canceled(t *testing.T) {
+	refresh, _ := sequence("pending")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := newTestWaiter(refresh).Wait(ctx)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestWaiter_Wait_TransientErrors(t *testing.T) {
+	serverErr := &apierror.Error{StatusCode: http.StatusInternalServerError}
+
+	calls := 0
+	w := newTestWaiter(func(_ context.Context) (string, testStatus, error) {
+		calls++
+		if calls < 3 {
+			return "", "", serverErr
+		}
+		return "value", "ready", nil
+	})
+
+	_, err := w.Wait(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	w.MaxTransientErrors = 1
+
+	_, err = w.Wait(context.Background())
+	assert.True(t, errors.Is(err, serverErr))
+}
+
+func TestWaiter_Wait_UnlimitedTransientErrors(t *testing.T) {
+	serverErr := &apierror.Error{StatusCode: http.StatusInternalServerError}
+
+	calls := 0
+	w := newTestWaiter(func(_ context.Context) (string, testStatus, error) {
+		calls++
+		if calls <= 3*DefaultMaxTransientErrors {
+			return "", "", serverErr
+		}
+		return "value", "ready", nil
+	})
+	w.MaxTransientErrors = UnlimitedTransientErrors
+	w.MaxPollInterval = time.Millisecond
+
+	_, err := w.Wait(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 3*DefaultMaxTransientErrors+1, calls)
+
+	// Transient errors are still bounded by the timeout.
+	w.Refresh = func(_ context.Context) (string, testStatus, error) {
+		return "", "", serverErr
+	}
+	w.Timeout = 20 * time.Millisecond
+
+	_, err = w.Wait(context.Background())
+
+	var timeoutErr *TimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.True(t, errors.Is(err, serverErr))
+}
+
+func TestWaiter_Wait_NonTransientError(t *testing.T) {
+	notFound := &apierror.Error{StatusCode: http.StatusNotFound}
+
+	calls := 0
+	_, err := newTestWaiter(func(_ context.Context) (string, testStatus, error) {
+		calls++
+		return "", "", notFound
+	}).Wait(context.Background())
+
+	assert.True(t, errors.Is(err, notFound))
+	assert.Equal(t, 1, calls)
+}
+
+type testSpan struct {
+	config.NoopSpan
+	attrs map[string]interface{}
+}
+
+func (s *testSpan) SetAttributes(attrs map[string]interface{}) {
+	s.attrs = attrs
+}
+
+type testTracer struct {
+	names []string
+	span  *testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, _ map[string]interface{}) (context.Context, config.Span) {
+	t.names = append(t.names, name)
+	t.span = &testSpan{}
+	return ctx, t.span
+}
+
+func TestWaiter_Wait_Traced(t *testing.T) {
+	refresh, _ := sequence("pending", "ready")
+
+	tracer := &testTracer{}
+	w := newTestWaiter(refresh)
+	w.Tracer = tracer
+
+	_, err := w.Wait(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{config.WaitSpanName}, tracer.names)
+	assert.Equal(t, "test resource to be ready", tracer.span.attrs[config.AttributeWaitDescription])
+	assert.Equal(t, "ready", tracer.span.attrs[config.AttributeWaitState])
+	assert.Equal(t, 2, tracer.span.attrs[config.AttributeWaitPolls])
+}
+
+func TestJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		d := jitter(10 * time.Second)
+		assert.True(t, d >= 9*time.Second && d <= 11*time.Second)
+	}
+}
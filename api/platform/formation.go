@@ -1,7 +1,9 @@
 package platform
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"time"
 )
 
@@ -43,19 +45,15 @@ type FormationDockerUpdateOpts struct {
 // FormationContainerBatchUpdate updates all specified process types with their target container image.
 //
 // Reference: https://devcenter.heroku.com/articles/container-registry-and-runtime#api
-func (p *Platform) FormationContainerBatchUpdate(appIdOrName string, opts *FormationDockerBatchUpdateOpts) (
+func (p *Platform) FormationContainerBatchUpdate(ctx context.Context, appIdOrName string, opts *FormationDockerBatchUpdateOpts) (
 	[]*Formation, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
 	var result []*Formation
 
 	urlStr := p.http.RequestURL("/apps/%s/formation", appIdOrName)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", DockerReleasesAcceptHeader)
-
 	// Execute the request
-	response, updateErr := p.http.Patch(urlStr, &result, opts)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, updateErr := p.http.Patch(ctx, urlStr, &result, opts, rest.WithHeader("Accept", DockerReleasesAcceptHeader))
 
 	return result, response, updateErr
 }
@@ -63,17 +61,13 @@ func (p *Platform) FormationContainerBatchUpdate(appIdOrName string, opts *Forma
 // FormationContainerUpdate updates the specified process type with their target container image.
 //
 // To destroy an existing process type's container, pass in `nil` for the `docker_image` field in the request body.
-func (p *Platform) FormationContainerUpdate(appIdOrName string, processType string, opts *FormationDockerUpdateOpts) (
+func (p *Platform) FormationContainerUpdate(ctx context.Context, appIdOrName string, processType string, opts *FormationDockerUpdateOpts) (
 	*simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
 	urlStr := p.http.RequestURL("/apps/%s/formation/%s", appIdOrName, processType)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", DockerReleasesAcceptHeader)
-
 	// Execute the request
-	response, updateErr := p.http.Patch(urlStr, nil, opts)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, updateErr := p.http.Patch(ctx, urlStr, nil, opts, rest.WithHeader("Accept", DockerReleasesAcceptHeader))
 
 	return response, updateErr
 }
@@ -1,6 +1,8 @@
 package platform
 
-import "time"
+import (
+	"time"
+)
 
 // LogDrain represents a log drain in Heroku.
 type LogDrain struct {
@@ -0,0 +1,88 @@
+package platform
+
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
+	"iter"
+	"net/http"
+)
+
+const (
+	// RangeHeader is the request header used to request a page of a list endpoint.
+	RangeHeader = "Range"
+
+	// NextRangeHeader is the response header containing the Range of the next page of a partial list response.
+	NextRangeHeader = "Next-Range"
+)
+
+// NextRange returns the Range of the next page of a list response or an empty string if there are no more pages.
+//
+// The Platform API returns 206 Partial Content and sets the Next-Range header when a list has more pages.
+func NextRange(r *simpleresty.Response) string {
+	if r == nil || r.Resp == nil || r.StatusCode != http.StatusPartialContent {
+		return ""
+	}
+
+	return r.Resp.Header().Get(NextRangeHeader)
+}
+
+// getPage retrieves the page of a list endpoint starting at nextRange, or the first page if nextRange is empty.
+func getPage[T any](ctx context.Context, c *rest.Client, urlStr, nextRange string,
+	opts ...rest.RequestOption) ([]*T, *simpleresty.Response, error) {
+	var page []*T
+
+	if nextRange != "" {
+		opts = append(opts[:len(opts):len(opts)], rest.WithHeader(RangeHeader, nextRange))
+	}
+
+	response, getErr := c.Get(ctx, urlStr, &page, nil, opts...)
+
+	return page, response, getErr
+}
+
+// listAll retrieves every page of a list endpoint. The returned response is that of the last page.
+func listAll[T any](ctx context.Context, c *rest.Client, urlStr string,
+	opts ...rest.RequestOption) ([]*T, *simpleresty.Response, error) {
+	var result []*T
+	nextRange := ""
+
+	for {
+		page, response, getErr := getPage[T](ctx, c, urlStr, nextRange, opts...)
+		if getErr != nil {
+			return nil, response, getErr
+		}
+
+		result = append(result, page...)
+
+		if nextRange = NextRange(response); nextRange == "" {
+			return result, response, nil
+		}
+	}
+}
+
+// listIter returns an iterator over every element of a list endpoint. Pages are retrieved as the iteration
+// progresses, so breaking out of the loop early avoids requesting the remaining pages.
+func listIter[T any](ctx context.Context, c *rest.Client, urlStr string, opts ...rest.RequestOption) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		nextRange := ""
+
+		for {
+			page, response, getErr := getPage[T](ctx, c, urlStr, nextRange, opts...)
+			if getErr != nil {
+				yield(nil, getErr)
+				return
+			}
+
+			for _, v := range page {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			if nextRange = NextRange(response); nextRange == "" {
+				return
+			}
+		}
+	}
+}
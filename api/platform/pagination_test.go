@@ -0,0 +1,78 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// newPaginatedServer returns a server that lists total webhooks in pages of pageSize using Range headers.
+func newPaginatedServer(t *testing.T, total, pageSize int, requests *int32) *Platform {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+
+		start := 0
+		if v := r.Header.Get(RangeHeader); v != "" {
+			start, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(strings.Split(v, ";")[0], "id ]"), ".."))
+		}
+
+		end := start + pageSize
+		if end > total {
+			end = total
+		}
+
+		var page []*AppWebhook
+		for i := start; i < end; i++ {
+			id := fmt.Sprintf("%d", i)
+			page = append(page, &AppWebhook{ID: &id})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if end < total {
+			w.Header().Set(NextRangeHeader, fmt.Sprintf("id ]%d..; max=%d", end, pageSize))
+			w.WriteHeader(http.StatusPartialContent)
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+
+	return New(&config.Config{PlatformBaseURL: server.URL, APIToken: "token"})
+}
+
+func TestPlatform_ListAppWebhooks_Paginated(t *testing.T) {
+	var requests int32
+	p := newPaginatedServer(t, 450, 200, &requests)
+
+	webhooks, response, err := p.ListAppWebhooks(context.Background(), "app")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Len(t, webhooks, 450)
+	assert.Equal(t, "449", webhooks[449].GetID())
+	assert.Equal(t, int32(3), requests)
+}
+
+func TestPlatform_AppWebhooks_StopsEarly(t *testing.T) {
+	var requests int32
+	p := newPaginatedServer(t, 450, 200, &requests)
+
+	count := 0
+	for webhook, err := range p.AppWebhooks(context.Background(), "app") {
+		assert.Nil(t, err)
+
+		count++
+		if webhook.GetID() == "250" {
+			break
+		}
+	}
+
+	assert.Equal(t, 251, count)
+	assert.Equal(t, int32(2), requests)
+}
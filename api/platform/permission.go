@@ -9,3 +9,8 @@ type Permission struct {
 type PermissionNotFoundError struct {
 	error
 }
+
+// Unwrap returns the underlying error so PermissionNotFoundError matches apierror.ErrNotFound.
+func (e PermissionNotFoundError) Unwrap() error {
+	return e.error
+}
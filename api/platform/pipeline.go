@@ -1,8 +1,10 @@
 package platform
 
 import (
+	"context"
 	heroku "github.com/davidji99/heroku-go/v5"
 	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 )
 
 // Pipeline represents a Heroku pipeline.
@@ -38,33 +40,24 @@ type PipelineEphemeralAppsConfigUpdateOpts struct {
 // GetPipelineEphemeralAppsConfig returns information about a pipeline's ephemeral apps configuration.
 //
 // This method also returns basic information about the pipeline itself.
-func (p *Platform) GetPipelineEphemeralAppsConfig(pipelineID string) (*Pipeline, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) GetPipelineEphemeralAppsConfig(ctx context.Context, pipelineID string) (*Pipeline, *simpleresty.Response, error) {
 	var result Pipeline
 
 	urlStr := p.http.RequestURL("/pipelines/%s", pipelineID)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", PipelineCollaboratorsAcceptHeader)
-
 	// Execute the request
-	response, updateErr := p.http.Get(urlStr, &result, nil)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, updateErr := p.http.Get(ctx, urlStr, &result, nil, rest.WithHeader("Accept", PipelineCollaboratorsAcceptHeader))
 
 	return &result, response, updateErr
 }
 
 // UpdatePipelineEphemeralAppsConfig updates an existing pipeline permission configuration.
-func (p *Platform) UpdatePipelineEphemeralAppsConfig(pipelineID string, opts *PipelineEphemeralAppsConfigUpdateOpts) (*Pipeline, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) UpdatePipelineEphemeralAppsConfig(ctx context.Context, pipelineID string, opts *PipelineEphemeralAppsConfigUpdateOpts) (*Pipeline, *simpleresty.Response, error) {
 	var result Pipeline
 
 	urlStr := p.http.RequestURL("/pipelines/%s", pipelineID)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", PipelineCollaboratorsAcceptHeader)
-
 	// Construct request body
 	o := struct {
 		EphemeralApps *PipelineEphemeralAppsConfigUpdateOpts `json:"ephemeral_apps"`
@@ -73,7 +66,8 @@ func (p *Platform) UpdatePipelineEphemeralAppsConfig(pipelineID string, opts *Pi
 	}
 
 	// Execute the request
-	response, updateErr := p.http.Patch(urlStr, &result, o)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, updateErr := p.http.Patch(ctx, urlStr, &result, o, rest.WithHeader("Accept", PipelineCollaboratorsAcceptHeader))
 
 	return &result, response, updateErr
 }
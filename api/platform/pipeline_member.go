@@ -1,8 +1,11 @@
 package platform
 
 import (
-	"fmt"
+	"context"
 	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
+	"iter"
 	"time"
 )
 
@@ -24,68 +27,61 @@ type PipelineMembershipRequestOpts struct {
 }
 
 // ListPipelineMembers returns all members added to a pipeline.
-func (p *Platform) ListPipelineMembers(pipelineID string) ([]*PipelineMembership, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
-	var result []*PipelineMembership
-
+//
+// Every page of results is retrieved. The returned response is that of the last page.
+func (p *Platform) ListPipelineMembers(ctx context.Context, pipelineID string) ([]*PipelineMembership, *simpleresty.Response, error) {
 	urlStr := p.http.RequestURL("/pipelines/%s/ephemeral-app-collaborators", pipelineID)
 
+	// Execute the request
 	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", PipelineCollaboratorsAcceptHeader)
+	return listAll[PipelineMembership](ctx, p.http, urlStr, rest.WithHeader("Accept", PipelineCollaboratorsAcceptHeader))
+}
 
-	// Execute the request
-	response, addErr := p.http.Get(urlStr, &result, nil)
+// PipelineMembers returns an iterator over all members added to a pipeline.
+// Pages are retrieved as the iteration progresses.
+func (p *Platform) PipelineMembers(ctx context.Context, pipelineID string) iter.Seq2[*PipelineMembership, error] {
+	urlStr := p.http.RequestURL("/pipelines/%s/ephemeral-app-collaborators", pipelineID)
 
-	return result, response, addErr
+	// TODO: remove custom Accept headers when APIs fully launch.
+	return listIter[PipelineMembership](ctx, p.http, urlStr, rest.WithHeader("Accept", PipelineCollaboratorsAcceptHeader))
 }
 
 // FindPipelineMembersByEmail retrieves a membership to a pipeline by email.
 //
 // Returns a PermissionNotFoundError if specified user has not been added to the pipeline.
-func (p *Platform) FindPipelineMembersByEmail(pipelineID, email string) (*PipelineMembership, *simpleresty.Response, error) {
-	members, listResponse, listErr := p.ListPipelineMembers(pipelineID)
-	if listErr != nil {
-		return nil, listResponse, listErr
-	}
+func (p *Platform) FindPipelineMembersByEmail(ctx context.Context, pipelineID, email string) (*PipelineMembership, *simpleresty.Response, error) {
+	for m, listErr := range p.PipelineMembers(ctx, pipelineID) {
+		if listErr != nil {
+			return nil, nil, listErr
+		}
 
-	for _, m := range members {
 		if m.GetUser().GetEmail() == email {
 			return m, nil, nil
 		}
 	}
 
-	return nil, nil, PermissionNotFoundError{error: fmt.Errorf("did not find %s on pipeline %s", email, pipelineID)}
+	return nil, nil, PermissionNotFoundError{error: apierror.NewNotFound("did not find %s on pipeline %s", email, pipelineID)}
 }
 
 // AddPipelineMember adds a member to a pipeline.
-func (p *Platform) AddPipelineMember(opts *PipelineMembershipRequestOpts) (*PipelineMembership, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) AddPipelineMember(ctx context.Context, opts *PipelineMembershipRequestOpts) (*PipelineMembership, *simpleresty.Response, error) {
 	var result PipelineMembership
 
 	urlStr := p.http.RequestURL("/ephemeral-app-collaborators")
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", PipelineCollaboratorsAcceptHeader)
-
 	// Execute the request
-	response, addErr := p.http.Post(urlStr, &result, opts)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, addErr := p.http.Post(ctx, urlStr, &result, opts, rest.WithHeader("Accept", PipelineCollaboratorsAcceptHeader))
 
 	return &result, response, addErr
 }
 
 // UpdatePipelineMemberPermissions modifies a pipeline member's permissions.
-func (p *Platform) UpdatePipelineMemberPermissions(membershipID string, permissions []string) (*PipelineMembership, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) UpdatePipelineMemberPermissions(ctx context.Context, membershipID string, permissions []string) (*PipelineMembership, *simpleresty.Response, error) {
 	var result PipelineMembership
 
 	urlStr := p.http.RequestURL("/ephemeral-app-collaborators/%s", membershipID)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", PipelineCollaboratorsAcceptHeader)
-
 	opts := struct {
 		Permissions []string `json:"permissions"`
 	}{
@@ -93,22 +89,19 @@ func (p *Platform) UpdatePipelineMemberPermissions(membershipID string, permissi
 	}
 
 	// Execute the request
-	response, addErr := p.http.Patch(urlStr, &result, opts)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, addErr := p.http.Patch(ctx, urlStr, &result, opts, rest.WithHeader("Accept", PipelineCollaboratorsAcceptHeader))
 
 	return &result, response, addErr
 }
 
 // RemovePipelineMember remove a member to a pipeline.
-func (p *Platform) RemovePipelineMember(membershipID string) (*simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) RemovePipelineMember(ctx context.Context, membershipID string) (*simpleresty.Response, error) {
 	urlStr := p.http.RequestURL("/ephemeral-app-collaborators/%s", membershipID)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", PipelineCollaboratorsAcceptHeader)
-
 	// Execute the request
-	response, addErr := p.http.Delete(urlStr, nil, nil)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, addErr := p.http.Delete(ctx, urlStr, nil, nil, rest.WithHeader("Accept", PipelineCollaboratorsAcceptHeader))
 
 	return response, addErr
 }
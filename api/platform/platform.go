@@ -1,9 +1,8 @@
 package platform
 
 import (
-	"fmt"
-	"github.com/davidji99/simpleresty"
 	config2 "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"time"
 )
 
@@ -22,13 +21,13 @@ const (
 //
 // The APIs under Platform almost exclusively consist of variants to the official Platform API.
 type Platform struct {
-	http   *simpleresty.Client
+	http   *rest.Client
 	config *config2.Config
 }
 
 // New constructs a client to interface with the Heroku Platform APIs.
 func New(config *config2.Config) *Platform {
-	p := &Platform{http: simpleresty.NewWithBaseURL(config.PlatformBaseURL), config: config}
+	p := &Platform{http: rest.NewWithConfig(config.PlatformBaseURL, config), config: config}
 	p.setHeaders()
 
 	return p
@@ -38,17 +37,12 @@ func (p *Platform) setHeaders() {
 	p.http.SetHeader("Content-type", p.config.ContentTypeHeader).
 		SetHeader("Accept", DefaultAcceptHeader).
 		SetHeader("User-Agent", p.config.UserAgent).
-		SetHeader("Authorization", fmt.Sprintf("Bearer %s", p.config.APIToken)).
-		SetTimeout(2 * time.Minute).
+		SetTimeout(p.config.Timeout(config2.ServicePlatform, 2*time.Minute)).
 		SetAllowGetMethodPayload(true)
+	p.http.SetAuthorizer(rest.BearerAuthorizer(p.config)).SetService(config2.ServicePlatform)
 
 	// Set additional headers
 	if p.config.CustomHTTPHeaders != nil {
 		p.http.SetHeaders(p.config.CustomHTTPHeaders)
 	}
 }
-
-// resetAcceptHeader sets the `Accept` header back to the default value.
-func (p *Platform) resetAcceptHeader() {
-	p.http.SetHeader("Accept", DefaultAcceptHeader)
-}
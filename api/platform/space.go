@@ -1,19 +1,19 @@
 package platform
 
-import "github.com/davidji99/simpleresty"
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
+)
 
 // GetSpaceLogDrain returns a space's log drain if available.
-func (p *Platform) GetSpaceLogDrain(spaceID string) (*LogDrain, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) GetSpaceLogDrain(ctx context.Context, spaceID string) (*LogDrain, *simpleresty.Response, error) {
 	var result *LogDrain
 	urlStr := p.http.RequestURL("/spaces/%s/log-drain", spaceID)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", DogwoodAcceptHeader)
-
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil, rest.WithHeader("Accept", DogwoodAcceptHeader))
 
 	return result, response, getErr
 }
@@ -21,15 +21,10 @@ func (p *Platform) GetSpaceLogDrain(spaceID string) (*LogDrain, *simpleresty.Res
 // SetSpaceLogDrain sets a space's log drain.
 //
 // To remove a log drain, pass in an empty string.
-func (p *Platform) SetSpaceLogDrain(spaceID string, url string) (*LogDrain, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) SetSpaceLogDrain(ctx context.Context, spaceID string, url string) (*LogDrain, *simpleresty.Response, error) {
 	var result *LogDrain
 	urlStr := p.http.RequestURL("/spaces/%s/log-drain", spaceID)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", DogwoodAcceptHeader)
-
 	opts := struct {
 		Url string `json:"url"`
 	}{
@@ -37,7 +32,8 @@ func (p *Platform) SetSpaceLogDrain(spaceID string, url string) (*LogDrain, *sim
 	}
 
 	// Execute the request
-	response, getErr := p.http.Put(urlStr, &result, opts)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, getErr := p.http.Put(ctx, urlStr, &result, opts, rest.WithHeader("Accept", DogwoodAcceptHeader))
 
 	return result, response, getErr
 }
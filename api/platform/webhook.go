@@ -1,7 +1,10 @@
 package platform
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
+	"iter"
 	"time"
 )
 
@@ -44,80 +47,67 @@ type AppWebhookRequest struct {
 }
 
 // ListAppWebhooks lists all webhooks for an app.
-func (p *Platform) ListAppWebhooks(appID string) ([]*AppWebhook, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
-	var result []*AppWebhook
+//
+// Every page of results is retrieved. The returned response is that of the last page.
+func (p *Platform) ListAppWebhooks(ctx context.Context, appID string) ([]*AppWebhook, *simpleresty.Response, error) {
 	urlStr := p.http.RequestURL("/apps/%s/webhooks", appID)
 
+	// Execute the request
 	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", WebhooksAcceptHeader)
+	return listAll[AppWebhook](ctx, p.http, urlStr, rest.WithHeader("Accept", WebhooksAcceptHeader))
+}
 
-	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+// AppWebhooks returns an iterator over all webhooks for an app. Pages are retrieved as the iteration progresses.
+func (p *Platform) AppWebhooks(ctx context.Context, appID string) iter.Seq2[*AppWebhook, error] {
+	urlStr := p.http.RequestURL("/apps/%s/webhooks", appID)
 
-	return result, response, getErr
+	// TODO: remove custom Accept headers when APIs fully launch.
+	return listIter[AppWebhook](ctx, p.http, urlStr, rest.WithHeader("Accept", WebhooksAcceptHeader))
 }
 
 // GetAppWebhook retrieves a single webhook.
-func (p *Platform) GetAppWebhook(appID, webhookID string) (*AppWebhook, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) GetAppWebhook(ctx context.Context, appID, webhookID string) (*AppWebhook, *simpleresty.Response, error) {
 	var result *AppWebhook
 	urlStr := p.http.RequestURL("/apps/%s/webhooks/%s", appID, webhookID)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", WebhooksAcceptHeader)
-
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil, rest.WithHeader("Accept", WebhooksAcceptHeader))
 
 	return result, response, getErr
 }
 
 // CreateAppWebhook creates an app webhook.
-func (p *Platform) CreateAppWebhook(appID string, opts *AppWebhookRequest) (*AppWebhook, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) CreateAppWebhook(ctx context.Context, appID string, opts *AppWebhookRequest) (*AppWebhook, *simpleresty.Response, error) {
 	var result *AppWebhook
 	urlStr := p.http.RequestURL("/apps/%s/webhooks", appID)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", WebhooksAcceptHeader)
-
 	// Execute the request
-	response, getErr := p.http.Post(urlStr, &result, opts)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, getErr := p.http.Post(ctx, urlStr, &result, opts, rest.WithHeader("Accept", WebhooksAcceptHeader))
 
 	return result, response, getErr
 }
 
 // UpdateAppWebhook modifies an existing app webhook.
-func (p *Platform) UpdateAppWebhook(appID, webhookID string, opts *AppWebhookRequest) (*AppWebhook, *simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) UpdateAppWebhook(ctx context.Context, appID, webhookID string, opts *AppWebhookRequest) (*AppWebhook, *simpleresty.Response, error) {
 	var result *AppWebhook
 	urlStr := p.http.RequestURL("/apps/%s/webhooks/%s", appID, webhookID)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", WebhooksAcceptHeader)
-
 	// Execute the request
-	response, getErr := p.http.Patch(urlStr, &result, opts)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, getErr := p.http.Patch(ctx, urlStr, &result, opts, rest.WithHeader("Accept", WebhooksAcceptHeader))
 
 	return result, response, getErr
 }
 
 // DeleteAppWebhook deletes an app webhook.
-func (p *Platform) DeleteAppWebhook(appID, webhookID string) (*simpleresty.Response, error) {
-	defer p.resetAcceptHeader()
-
+func (p *Platform) DeleteAppWebhook(ctx context.Context, appID, webhookID string) (*simpleresty.Response, error) {
 	urlStr := p.http.RequestURL("/apps/%s/webhooks/%s", appID, webhookID)
 
-	// TODO: remove custom Accept headers when APIs fully launch.
-	p.http.SetHeader("Accept", WebhooksAcceptHeader)
-
 	// Execute the request
-	response, getErr := p.http.Delete(urlStr, nil, nil)
+	// TODO: remove custom Accept headers when APIs fully launch.
+	response, getErr := p.http.Delete(ctx, urlStr, nil, nil, rest.WithHeader("Accept", WebhooksAcceptHeader))
 
 	return response, getErr
 }
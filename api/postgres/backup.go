@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/davidji99/simpleresty"
 )
@@ -29,12 +30,12 @@ type BackupScheduleRequest struct {
 }
 
 // ListBackupSchedules returns all backup schedules for a database.
-func (p *Postgres) ListBackupSchedules(nameOrID string) ([]*BackupSchedule, *simpleresty.Response, error) {
+func (p *Postgres) ListBackupSchedules(ctx context.Context, nameOrID string) ([]*BackupSchedule, *simpleresty.Response, error) {
 	var result []*BackupSchedule
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/transfer-schedules", nameOrID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
@@ -42,22 +43,22 @@ func (p *Postgres) ListBackupSchedules(nameOrID string) ([]*BackupSchedule, *sim
 // CreateBackupSchedule creates a backup schedule.
 //
 // This method effectively acts as a PUT request as well.
-func (p *Postgres) CreateBackupSchedule(nameOrID string, opts *BackupScheduleRequest) (*BackupSchedule, *simpleresty.Response, error) {
+func (p *Postgres) CreateBackupSchedule(ctx context.Context, nameOrID string, opts *BackupScheduleRequest) (*BackupSchedule, *simpleresty.Response, error) {
 	var result *BackupSchedule
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/transfer-schedules", nameOrID)
 
 	// Execute the request
-	response, createErr := p.http.Post(urlStr, &result, opts)
+	response, createErr := p.http.Post(ctx, urlStr, &result, opts)
 
 	return result, response, createErr
 }
 
 // DeleteBackupSchedule deletes a backup schedule.
-func (p *Postgres) DeleteBackupSchedule(dbNameOrID, scheduleID string) (*simpleresty.Response, error) {
+func (p *Postgres) DeleteBackupSchedule(ctx context.Context, dbNameOrID, scheduleID string) (*simpleresty.Response, error) {
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/transfer-schedules/%s", dbNameOrID, scheduleID)
 
 	// Execute the request
-	response, getErr := p.http.Delete(urlStr, nil, nil)
+	response, getErr := p.http.Delete(ctx, urlStr, nil, nil)
 
 	return response, getErr
 }
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	heroku "github.com/davidji99/heroku-go/v5"
 	"github.com/davidji99/simpleresty"
 )
@@ -18,13 +19,13 @@ type ConnectionPoolingRequest struct {
 }
 
 // CreateConnectionPooling activates connection pooling for a database.
-func (p *Postgres) CreateConnectionPooling(nameOrID string, opts *ConnectionPoolingRequest) (*heroku.AddOnAttachment, *simpleresty.Response, error) {
+func (p *Postgres) CreateConnectionPooling(ctx context.Context, nameOrID string, opts *ConnectionPoolingRequest) (*heroku.AddOnAttachment, *simpleresty.Response, error) {
 	var result heroku.AddOnAttachment
 
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/connection-pooling", nameOrID)
 
 	// Execute the request
-	response, createErr := p.http.Post(urlStr, &result, opts)
+	response, createErr := p.http.Post(ctx, urlStr, &result, opts)
 
 	return &result, response, createErr
 }
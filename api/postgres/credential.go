@@ -1,6 +1,9 @@
 package postgres
 
-import "github.com/davidji99/simpleresty"
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+)
 
 // Credential represents a credential for a postgres database.
 //
@@ -23,23 +26,23 @@ type CredentialSecret struct {
 }
 
 // ListCredentials retrieves all credentials for a database.
-func (p *Postgres) ListCredentials(nameOrID string) ([]*Credential, *simpleresty.Response, error) {
+func (p *Postgres) ListCredentials(ctx context.Context, nameOrID string) ([]*Credential, *simpleresty.Response, error) {
 	var result []*Credential
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/credentials", nameOrID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // GetCredential retrieves a single credential for a database.
-func (p *Postgres) GetCredential(nameOrID, credentialName string) (*Credential, *simpleresty.Response, error) {
+func (p *Postgres) GetCredential(ctx context.Context, nameOrID, credentialName string) (*Credential, *simpleresty.Response, error) {
 	var result *Credential
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/credentials/%s", nameOrID, credentialName)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
@@ -47,7 +50,7 @@ func (p *Postgres) GetCredential(nameOrID, credentialName string) (*Credential,
 // CreateCredential creates a postgres database credential.
 //
 // Returns a GenericResponse.
-func (p *Postgres) CreateCredential(nameOrID, newCredName string) (*GenericResponse, *simpleresty.Response, error) {
+func (p *Postgres) CreateCredential(ctx context.Context, nameOrID, newCredName string) (*GenericResponse, *simpleresty.Response, error) {
 	var result *GenericResponse
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/credentials", nameOrID)
 
@@ -58,7 +61,7 @@ func (p *Postgres) CreateCredential(nameOrID, newCredName string) (*GenericRespo
 	}
 
 	// Execute the request
-	response, getErr := p.http.Post(urlStr, &result, &body)
+	response, getErr := p.http.Post(ctx, urlStr, &result, &body)
 
 	return result, response, getErr
 }
@@ -71,12 +74,12 @@ func (p *Postgres) CreateCredential(nameOrID, newCredName string) (*GenericRespo
 //
 // Note: it takes a bit of time before the credential is fully deleted. The username/password are first to be deleted
 // and then the credential itself is deleted.
-func (p *Postgres) DeleteCredential(nameOrID, credentialName string) (*GenericResponse, *simpleresty.Response, error) {
+func (p *Postgres) DeleteCredential(ctx context.Context, nameOrID, credentialName string) (*GenericResponse, *simpleresty.Response, error) {
 	var result *GenericResponse
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/credentials/%s", nameOrID, credentialName)
 
 	// Execute the request
-	response, getErr := p.http.Delete(urlStr, &result, nil)
+	response, getErr := p.http.Delete(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
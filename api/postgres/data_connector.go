@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
 	"time"
 )
@@ -53,8 +54,8 @@ type DataConnectorTopic struct {
 }
 
 // NewDataConnectorRequest represents a new request to create a data connector.
-func NewDataConnectorRequest(postgresAddonID string, tables []string) *dataConnectorRequest {
-	return &dataConnectorRequest{
+func NewDataConnectorRequest(postgresAddonID string, tables []string) *DataConnectorRequest {
+	return &DataConnectorRequest{
 		PostgresAddonID: postgresAddonID,
 		Tables:          tables,
 		ExcludedColumns: make([]string, 0),
@@ -63,8 +64,8 @@ func NewDataConnectorRequest(postgresAddonID string, tables []string) *dataConne
 	}
 }
 
-// dataConnectorRequest represents a request to create a data connector.
-type dataConnectorRequest struct {
+// DataConnectorRequest represents a request to create a data connector.
+type DataConnectorRequest struct {
 	// PostgresAddonID
 	PostgresAddonID string `json:"postgres_addon_uuid"`
 
@@ -89,34 +90,34 @@ type DataConnectSettings struct {
 // ListDataConnectors retrieves all data connectors for an app.
 //
 // Note: Only the Heroku app name is acceptable here, not its UUID.
-func (p *Postgres) ListDataConnectors(appName string) ([]*DataConnector, *simpleresty.Response, error) {
+func (p *Postgres) ListDataConnectors(ctx context.Context, appName string) ([]*DataConnector, *simpleresty.Response, error) {
 	var result []*DataConnector
 	urlStr := p.http.RequestURL("/data/cdc/v0/apps/%s", appName)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // GetDataConnector retrieves a single data connector.
-func (p *Postgres) GetDataConnector(id string) (*DataConnector, *simpleresty.Response, error) {
+func (p *Postgres) GetDataConnector(ctx context.Context, id string) (*DataConnector, *simpleresty.Response, error) {
 	var result *DataConnector
 	urlStr := p.http.RequestURL("/data/cdc/v0/connectors/%s", id)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // CreateDataConnector creates a data connector.
-func (p *Postgres) CreateDataConnector(kafkaID string, opts *dataConnectorRequest) (*DataConnector, *simpleresty.Response, error) {
+func (p *Postgres) CreateDataConnector(ctx context.Context, kafkaID string, opts *DataConnectorRequest) (*DataConnector, *simpleresty.Response, error) {
 	var result *DataConnector
 	urlStr := p.http.RequestURL("/data/cdc/v0/kafka_tenants/%s", kafkaID)
 
 	// Execute the request
-	response, createErr := p.http.Post(urlStr, &result, opts)
+	response, createErr := p.http.Post(ctx, urlStr, &result, opts)
 
 	return result, response, createErr
 }
@@ -128,12 +129,12 @@ func (p *Postgres) CreateDataConnector(kafkaID string, opts *dataConnectorReques
 //
 // Heroku does not delete your Kafka topics automatically, because they could still contain messages
 // which you haven't consumed. Please delete the topics manually.
-func (p *Postgres) DeleteDataConnector(id string) (*DataConnector, *simpleresty.Response, error) {
+func (p *Postgres) DeleteDataConnector(ctx context.Context, id string) (*DataConnector, *simpleresty.Response, error) {
 	var result *DataConnector
 	urlStr := p.http.RequestURL("/data/cdc/v0/connectors/%s", id)
 
 	// Execute the request
-	response, deleteErr := p.http.Delete(urlStr, &result, nil)
+	response, deleteErr := p.http.Delete(ctx, urlStr, &result, nil)
 
 	return result, response, deleteErr
 }
@@ -141,7 +142,7 @@ func (p *Postgres) DeleteDataConnector(id string) (*DataConnector, *simpleresty.
 // PauseDataConnector pauses change event creation on a Data Connector.
 //
 // Returns 202 with empty body if accepted and takes a bit of time before the pause is applied to the data connector.
-func (p *Postgres) PauseDataConnector(id string) (*simpleresty.Response, error) {
+func (p *Postgres) PauseDataConnector(ctx context.Context, id string) (*simpleresty.Response, error) {
 	urlStr := p.http.RequestURL("/data/cdc/v0/connectors/%s/pause", id)
 
 	opts := struct {
@@ -151,7 +152,7 @@ func (p *Postgres) PauseDataConnector(id string) (*simpleresty.Response, error)
 	}
 
 	// Execute the request
-	response, pauseErr := p.http.Put(urlStr, nil, &opts)
+	response, pauseErr := p.http.Put(ctx, urlStr, nil, &opts)
 
 	return response, pauseErr
 }
@@ -159,7 +160,7 @@ func (p *Postgres) PauseDataConnector(id string) (*simpleresty.Response, error)
 // ResumeDataConnector resumes change event creation on a Data Connector.
 //
 // Returns 202 with empty body if accepted and takes a bit of time before the resume is applied to the data connector.
-func (p *Postgres) ResumeDataConnector(id string) (*simpleresty.Response, error) {
+func (p *Postgres) ResumeDataConnector(ctx context.Context, id string) (*simpleresty.Response, error) {
 	urlStr := p.http.RequestURL("/data/cdc/v0/connectors/%s/resume", id)
 
 	opts := struct {
@@ -169,7 +170,7 @@ func (p *Postgres) ResumeDataConnector(id string) (*simpleresty.Response, error)
 	}
 
 	// Execute the request
-	response, resume := p.http.Put(urlStr, nil, &opts)
+	response, resume := p.http.Put(ctx, urlStr, nil, &opts)
 
 	return response, resume
 }
@@ -182,12 +183,12 @@ func (p *Postgres) ResumeDataConnector(id string) (*simpleresty.Response, error)
 // an existing settings value.
 //
 // Reference: https://devcenter.heroku.com/articles/heroku-data-connectors#update-configuration
-func (p *Postgres) UpdateDataConnectorSettings(id string, opts *DataConnectSettings) (*DataConnector, *simpleresty.Response, error) {
+func (p *Postgres) UpdateDataConnectorSettings(ctx context.Context, id string, opts *DataConnectSettings) (*DataConnector, *simpleresty.Response, error) {
 	var result DataConnector
 	urlStr := p.http.RequestURL("/data/cdc/v0/connectors/%s", id)
 
 	// Execute the request
-	response, resume := p.http.Patch(urlStr, &result, opts)
+	response, resume := p.http.Patch(ctx, urlStr, &result, opts)
 
 	return &result, response, resume
 }
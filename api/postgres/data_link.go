@@ -1,8 +1,9 @@
 package postgres
 
 import (
-	"fmt"
+	"context"
 	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
 	"time"
 )
 
@@ -33,19 +34,19 @@ type DataLinkCreateOpts struct {
 }
 
 // ListDataLink lists all data links for a postgres database.
-func (p *Postgres) ListDataLink(localDbID string) ([]*DataLink, *simpleresty.Response, error) {
+func (p *Postgres) ListDataLink(ctx context.Context, localDbID string) ([]*DataLink, *simpleresty.Response, error) {
 	var result []*DataLink
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/links", localDbID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // FindDataLinkByID finds a data link by its ID.
-func (p *Postgres) FindDataLinkByID(localDbID, dataLinkID string) (*DataLink, *simpleresty.Response, error) {
-	links, listResponse, listErr := p.ListDataLink(localDbID)
+func (p *Postgres) FindDataLinkByID(ctx context.Context, localDbID, dataLinkID string) (*DataLink, *simpleresty.Response, error) {
+	links, listResponse, listErr := p.ListDataLink(ctx, localDbID)
 	if listErr != nil {
 		return nil, listResponse, listErr
 	}
@@ -56,12 +57,12 @@ func (p *Postgres) FindDataLinkByID(localDbID, dataLinkID string) (*DataLink, *s
 		}
 	}
 
-	return nil, nil, fmt.Errorf("did not find data link by ID %s", dataLinkID)
+	return nil, nil, apierror.NewNotFound("did not find data link by ID %s", dataLinkID)
 }
 
 // FindDataLinkByName finds a data link by its name.
-func (p *Postgres) FindDataLinkByName(localDbID, dataLinkName string) (*DataLink, *simpleresty.Response, error) {
-	links, listResponse, listErr := p.ListDataLink(localDbID)
+func (p *Postgres) FindDataLinkByName(ctx context.Context, localDbID, dataLinkName string) (*DataLink, *simpleresty.Response, error) {
+	links, listResponse, listErr := p.ListDataLink(ctx, localDbID)
 	if listErr != nil {
 		return nil, listResponse, listErr
 	}
@@ -72,26 +73,26 @@ func (p *Postgres) FindDataLinkByName(localDbID, dataLinkName string) (*DataLink
 		}
 	}
 
-	return nil, nil, fmt.Errorf("did not find data link by name %s", dataLinkName)
+	return nil, nil, apierror.NewNotFound("did not find data link by name %s", dataLinkName)
 }
 
 // CreateDataLink creates a data link between two databases.
-func (p *Postgres) CreateDataLink(localDbID string, opts *DataLinkCreateOpts) (*DataLink, *simpleresty.Response, error) {
+func (p *Postgres) CreateDataLink(ctx context.Context, localDbID string, opts *DataLinkCreateOpts) (*DataLink, *simpleresty.Response, error) {
 	var result *DataLink
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/links", localDbID)
 
 	// Execute the request
-	response, getErr := p.http.Post(urlStr, &result, opts)
+	response, getErr := p.http.Post(ctx, urlStr, &result, opts)
 
 	return result, response, getErr
 }
 
 // DeleteDataLink deletes a data link between two databases.
-func (p *Postgres) DeleteDataLink(localDbID, linkName string) (*simpleresty.Response, error) {
+func (p *Postgres) DeleteDataLink(ctx context.Context, localDbID, linkName string) (*simpleresty.Response, error) {
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/links/%s", localDbID, linkName)
 
 	// Execute the request
-	response, getErr := p.http.Delete(urlStr, nil, nil)
+	response, getErr := p.http.Delete(ctx, urlStr, nil, nil)
 
 	return response, getErr
 }
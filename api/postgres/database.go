@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -52,29 +53,29 @@ type DatabaseWaitStatus struct {
 }
 
 // GetDB returns detailed information about a Heroku postgres database.
-func (p *Postgres) GetDB(dbID string) (*Database, *simpleresty.Response, error) {
+func (p *Postgres) GetDB(ctx context.Context, dbID string) (*Database, *simpleresty.Response, error) {
 	var result *Database
 	urlStr := p.http.RequestURL("/client/v11/databases/%s", dbID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // GetDBWaitStatus returns the database's overall status and whether or not it is waiting.
-func (p *Postgres) GetDBWaitStatus(dbID string) (*DatabaseWaitStatus, *simpleresty.Response, error) {
+func (p *Postgres) GetDBWaitStatus(ctx context.Context, dbID string) (*DatabaseWaitStatus, *simpleresty.Response, error) {
 	var result *DatabaseWaitStatus
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/wait_status", dbID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // UnfollowDB tells a follower DB to unfollow the leader DB.
-func (p *Postgres) UnfollowDB(dbID string) (*GenericResponse, *simpleresty.Response, error) {
+func (p *Postgres) UnfollowDB(ctx context.Context, dbID string) (*GenericResponse, *simpleresty.Response, error) {
 	var result *GenericResponse
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/unfollow", dbID)
 
@@ -84,7 +85,7 @@ func (p *Postgres) UnfollowDB(dbID string) (*GenericResponse, *simpleresty.Respo
 	}{Host: ""}
 
 	// Execute the request
-	response, err := p.http.Put(urlStr, &result, &body)
+	response, err := p.http.Put(ctx, urlStr, &result, &body)
 
 	return result, response, err
 }
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"regexp"
@@ -13,18 +14,18 @@ type MaintenanceWindowResponse struct {
 // GetMaintenanceWindow returns the maintenance window for a postgres database.
 //
 // All times are in UTC.
-func (p *Postgres) GetMaintenanceWindow(dbID string) (*GenericResponse, *simpleresty.Response, error) {
+func (p *Postgres) GetMaintenanceWindow(ctx context.Context, dbID string) (*GenericResponse, *simpleresty.Response, error) {
 	var result *GenericResponse
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/maintenance", dbID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // SetMaintenanceWindow sets the the weekly maintenance window for a postgres database.
-func (p *Postgres) SetMaintenanceWindow(dbID, window string) (*MaintenanceWindowResponse, *simpleresty.Response, error) {
+func (p *Postgres) SetMaintenanceWindow(ctx context.Context, dbID, window string) (*MaintenanceWindowResponse, *simpleresty.Response, error) {
 	var result *MaintenanceWindowResponse
 	urlStr := p.http.RequestURL("/client/v11/databases/%s/maintenance_window", dbID)
 
@@ -41,7 +42,7 @@ func (p *Postgres) SetMaintenanceWindow(dbID, window string) (*MaintenanceWindow
 	}
 
 	// Execute the request
-	response, getErr := p.http.Put(urlStr, &result, &body)
+	response, getErr := p.http.Put(ctx, urlStr, &result, &body)
 
 	return result, response, getErr
 }
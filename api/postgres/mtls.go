@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
 	"github.com/davidji99/terraform-provider-herokux/api/general"
 )
@@ -19,12 +20,12 @@ type MTLS struct {
 //
 // If the request is successful, the response status code is 201 and the "status" is set to "Provisioning".
 // Once the configuration is ready, "status" changes to "Operational".
-func (p *Postgres) ProvisionMTLS(nameOrID string) (*MTLS, *simpleresty.Response, error) {
+func (p *Postgres) ProvisionMTLS(ctx context.Context, nameOrID string) (*MTLS, *simpleresty.Response, error) {
 	var result *MTLS
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint", nameOrID)
 
 	// Execute the request
-	response, createErr := p.http.Post(urlStr, &result, nil)
+	response, createErr := p.http.Post(ctx, urlStr, &result, nil)
 
 	return result, response, createErr
 }
@@ -32,8 +33,8 @@ func (p *Postgres) ProvisionMTLS(nameOrID string) (*MTLS, *simpleresty.Response,
 // IsMTLSReady determines if the MTLS configuration is provisioned and operational.
 //
 // Return true if ready; false otherwise.
-func (p *Postgres) IsMTLSReady(nameOrID string) (bool, MTLSConfigStatus, error) {
-	mtlsConfig, _, getErr := p.GetMTLS(nameOrID)
+func (p *Postgres) IsMTLSReady(ctx context.Context, nameOrID string) (bool, MTLSConfigStatus, error) {
+	mtlsConfig, _, getErr := p.GetMTLS(ctx, nameOrID)
 	if getErr != nil {
 		return false, MTLSConfigStatuses.UNKNOWN, getErr
 	}
@@ -48,23 +49,23 @@ func (p *Postgres) IsMTLSReady(nameOrID string) (bool, MTLSConfigStatus, error)
 // DeprovisionMTLS destroys a MTLS configuration on your database.
 //
 // Returns 202 if request is successful with a 'status' of 'Deprovisioning'.
-func (p *Postgres) DeprovisionMTLS(nameOrID string) (*MTLS, *simpleresty.Response, error) {
+func (p *Postgres) DeprovisionMTLS(ctx context.Context, nameOrID string) (*MTLS, *simpleresty.Response, error) {
 	var result *MTLS
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint", nameOrID)
 
 	// Execute the request
-	response, deleteErr := p.http.Delete(urlStr, &result, nil)
+	response, deleteErr := p.http.Delete(ctx, urlStr, &result, nil)
 
 	return result, response, deleteErr
 }
 
 // GetMTLS retrieves the MTLS configuration for a database.
-func (p *Postgres) GetMTLS(nameOrID string) (*MTLS, *simpleresty.Response, error) {
+func (p *Postgres) GetMTLS(ctx context.Context, nameOrID string) (*MTLS, *simpleresty.Response, error) {
 	var result *MTLS
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint", nameOrID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
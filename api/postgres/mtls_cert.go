@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
 )
 
@@ -21,12 +22,12 @@ type MTLSCert struct {
 // The certificates returned by this endpoint do not have their private keys and certificate chains in the response.
 // To retrieve the key and chain, you must use the `GetMTLSCert` method.
 // Furthermore, this endpoint also returns certificates that were disabled.
-func (p *Postgres) ListMTLSCerts(dbNameOrID string) ([]*MTLSCert, *simpleresty.Response, error) {
+func (p *Postgres) ListMTLSCerts(ctx context.Context, dbNameOrID string) ([]*MTLSCert, *simpleresty.Response, error) {
 	var result []*MTLSCert
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint/certificates", dbNameOrID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
@@ -34,12 +35,12 @@ func (p *Postgres) ListMTLSCerts(dbNameOrID string) ([]*MTLSCert, *simpleresty.R
 // GetMTLSCert retrieves a single MTLS certificate.
 //
 // This endpoint returns a 404 if you retrieve a certificate that has been disabled/deleted.
-func (p *Postgres) GetMTLSCert(dbNameOrID, certID string) (*MTLSCert, *simpleresty.Response, error) {
+func (p *Postgres) GetMTLSCert(ctx context.Context, dbNameOrID, certID string) (*MTLSCert, *simpleresty.Response, error) {
 	var result *MTLSCert
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint/certificates/%s", dbNameOrID, certID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
@@ -48,12 +49,12 @@ func (p *Postgres) GetMTLSCert(dbNameOrID, certID string) (*MTLSCert, *simpleres
 //
 // Upon creation, the new certificate has a status of 'pending'. A status of 'ready' signifies
 // the certificate is ready for use.
-func (p *Postgres) CreateMTLSCert(dbNameOrID string) (*MTLSCert, *simpleresty.Response, error) {
+func (p *Postgres) CreateMTLSCert(ctx context.Context, dbNameOrID string) (*MTLSCert, *simpleresty.Response, error) {
 	var result *MTLSCert
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint/certificates", dbNameOrID)
 
 	// Execute the request
-	response, createErr := p.http.Post(urlStr, &result, nil)
+	response, createErr := p.http.Post(ctx, urlStr, &result, nil)
 
 	return result, response, createErr
 
@@ -62,12 +63,12 @@ func (p *Postgres) CreateMTLSCert(dbNameOrID string) (*MTLSCert, *simpleresty.Re
 // DeleteMTLSCert deletes a MTLS certificate.
 //
 // Upon deletion, the target certificate has a status of 'disabling'.
-func (p *Postgres) DeleteMTLSCert(dbNameOrID, certID string) (*MTLSCert, *simpleresty.Response, error) {
+func (p *Postgres) DeleteMTLSCert(ctx context.Context, dbNameOrID, certID string) (*MTLSCert, *simpleresty.Response, error) {
 	var result *MTLSCert
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint/certificates/%s", dbNameOrID, certID)
 
 	// Execute the request
-	response, deleteErr := p.http.Delete(urlStr, &result, nil)
+	response, deleteErr := p.http.Delete(ctx, urlStr, &result, nil)
 
 	return result, response, deleteErr
 }
@@ -1,49 +1,50 @@
 package postgres
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
 	"github.com/davidji99/terraform-provider-herokux/api/general"
 )
 
 // ListMTLSIPRules returns all IP rules.
-func (p *Postgres) ListMTLSIPRules(dbNameOrID string) ([]*general.MtlsIPRule, *simpleresty.Response, error) {
+func (p *Postgres) ListMTLSIPRules(ctx context.Context, dbNameOrID string) ([]*general.MtlsIPRule, *simpleresty.Response, error) {
 	var result []*general.MtlsIPRule
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint/ip-rules", dbNameOrID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // GetMTLSIPRule returns a single IP rule.
-func (p *Postgres) GetMTLSIPRule(dbNameOrID, ipRuleID string) (*general.MtlsIPRule, *simpleresty.Response, error) {
+func (p *Postgres) GetMTLSIPRule(ctx context.Context, dbNameOrID, ipRuleID string) (*general.MtlsIPRule, *simpleresty.Response, error) {
 	var result *general.MtlsIPRule
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint/ip-rules/%s", dbNameOrID, ipRuleID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // CreateMTLSIPRule creates an IP rule.
-func (p *Postgres) CreateMTLSIPRule(dbNameOrID string, opts *general.MTLSIPRuleRequest) (*general.MtlsIPRule, *simpleresty.Response, error) {
+func (p *Postgres) CreateMTLSIPRule(ctx context.Context, dbNameOrID string, opts *general.MTLSIPRuleRequest) (*general.MtlsIPRule, *simpleresty.Response, error) {
 	var result *general.MtlsIPRule
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint/ip-rules", dbNameOrID)
 
 	// Execute the request
-	response, createErr := p.http.Post(urlStr, &result, opts)
+	response, createErr := p.http.Post(ctx, urlStr, &result, opts)
 
 	return result, response, createErr
 }
 
 // DeleteMTLSIPRule deletes an IP rule.
-func (p *Postgres) DeleteMTLSIPRule(dbNameOrID, ipRuleID string) (*simpleresty.Response, error) {
+func (p *Postgres) DeleteMTLSIPRule(ctx context.Context, dbNameOrID, ipRuleID string) (*simpleresty.Response, error) {
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/tls-endpoint/ip-rules/%s", dbNameOrID, ipRuleID)
 
 	// Execute the request
-	response, deleteErr := p.http.Delete(urlStr, nil, nil)
+	response, deleteErr := p.http.Delete(ctx, urlStr, nil, nil)
 
 	return response, deleteErr
 }
@@ -463,6 +463,28 @@ func (d *DataConnectorPostgresApp) GetName() string {
 	return *d.Name
 }
 
+// HasExcludedColumns checks if DataConnectorRequest has any ExcludedColumns.
+func (d *DataConnectorRequest) HasExcludedColumns() bool {
+	if d == nil || d.ExcludedColumns == nil {
+		return false
+	}
+	if len(d.ExcludedColumns) == 0 {
+		return false
+	}
+	return true
+}
+
+// HasTables checks if DataConnectorRequest has any Tables.
+func (d *DataConnectorRequest) HasTables() bool {
+	if d == nil || d.Tables == nil {
+		return false
+	}
+	if len(d.Tables) == 0 {
+		return false
+	}
+	return true
+}
+
 // GetTableName returns the TableName field if it's non-nil, zero value otherwise.
 func (d *DataConnectorTopic) GetTableName() string {
 	if d == nil || d.TableName == nil {
@@ -1,16 +1,15 @@
 package postgres
 
 import (
-	"fmt"
-	"github.com/davidji99/simpleresty"
 	config2 "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"github.com/davidji99/terraform-provider-herokux/api/platform"
 	"time"
 )
 
 // Postgres represents Heroku's postgres APIs.
 type Postgres struct {
-	http   *simpleresty.Client
+	http   *rest.Client
 	config *config2.Config
 }
 
@@ -35,7 +34,7 @@ type GenericResponse struct {
 
 // New constructs a client to interface with the Heroku Postgres APIs.
 func New(config *config2.Config) *Postgres {
-	p := &Postgres{http: simpleresty.NewWithBaseURL(config.PostgresBaseURL), config: config}
+	p := &Postgres{http: rest.NewWithConfig(config.PostgresBaseURL, config), config: config}
 	p.setHeaders()
 
 	return p
@@ -45,9 +44,9 @@ func (p *Postgres) setHeaders() {
 	p.http.SetHeader("Content-type", p.config.ContentTypeHeader).
 		SetHeader("Accept", p.config.AcceptHeader).
 		SetHeader("User-Agent", p.config.UserAgent).
-		SetHeader("Authorization", fmt.Sprintf("Basic %s", p.config.BasicAuth)).
-		SetTimeout(2 * time.Minute).
+		SetTimeout(p.config.Timeout(config2.ServicePostgres, 2*time.Minute)).
 		SetAllowGetMethodPayload(true)
+	p.http.SetAuthorizer(rest.BasicAuthorizer(p.config)).SetService(config2.ServicePostgres)
 
 	// Set additional headers
 	if p.config.CustomHTTPHeaders != nil {
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
 	"github.com/davidji99/terraform-provider-herokux/api/data"
 )
@@ -50,23 +51,23 @@ type PrivatelinkRequest struct {
 }
 
 // CreatePrivatelink creates a privatelink for a Heroku postgres, redis, or kafka addon.
-func (p *Postgres) CreatePrivatelink(addonID string, opts *PrivatelinkRequest) (*Privatelink, *simpleresty.Response, error) {
+func (p *Postgres) CreatePrivatelink(ctx context.Context, addonID string, opts *PrivatelinkRequest) (*Privatelink, *simpleresty.Response, error) {
 	var result *Privatelink
 	urlStr := p.http.RequestURL("/private-link/v0/databases/%s", addonID)
 
 	// Execute the request
-	response, createErr := p.http.Post(urlStr, &result, opts)
+	response, createErr := p.http.Post(ctx, urlStr, &result, opts)
 
 	return result, response, createErr
 }
 
 // GetPrivatelink gets information about a privatelink for a Heroku postgres, redis, or kafka addon.
-func (p *Postgres) GetPrivatelink(addonID string) (*Privatelink, *simpleresty.Response, error) {
+func (p *Postgres) GetPrivatelink(ctx context.Context, addonID string) (*Privatelink, *simpleresty.Response, error) {
 	var result *Privatelink
 	urlStr := p.http.RequestURL("/private-link/v0/databases/%s", addonID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
@@ -76,23 +77,23 @@ func (p *Postgres) GetPrivatelink(addonID string) (*Privatelink, *simpleresty.Re
 // A successful DELETE requests results in the `status` set to Deprovisioned.
 // However, the UI will show "deprovisioning" so user needs to use `GetPrivatelink` method in a loop
 // until the GET request returns a `404`.
-func (p *Postgres) DeletePrivatelink(addonID string) (*Privatelink, *simpleresty.Response, error) {
+func (p *Postgres) DeletePrivatelink(ctx context.Context, addonID string) (*Privatelink, *simpleresty.Response, error) {
 	var result *Privatelink
 	urlStr := p.http.RequestURL("/private-link/v0/databases/%s", addonID)
 
 	// Execute the request
-	response, deleteErr := p.http.Delete(urlStr, &result, nil)
+	response, deleteErr := p.http.Delete(ctx, urlStr, &result, nil)
 
 	return result, response, deleteErr
 }
 
 // RemovePrivatelinkAllowedAccounts removes one or more allowed accounts from a private link.
-func (p *Postgres) RemovePrivatelinkAllowedAccounts(addonID string, opts *PrivatelinkRequest) (*Privatelink, *simpleresty.Response, error) {
+func (p *Postgres) RemovePrivatelinkAllowedAccounts(ctx context.Context, addonID string, opts *PrivatelinkRequest) (*Privatelink, *simpleresty.Response, error) {
 	var result *Privatelink
 	urlStr := p.http.RequestURL("/private-link/v0/databases/%s/allowed_accounts", addonID)
 
 	// Execute the request
-	response, patchErr := p.http.Patch(urlStr, &result, opts)
+	response, patchErr := p.http.Patch(ctx, urlStr, &result, opts)
 
 	return result, response, patchErr
 }
@@ -102,12 +103,12 @@ func (p *Postgres) RemovePrivatelinkAllowedAccounts(addonID string, opts *Privat
 // Adding the same allowed account ID while the ID already exists results in a 202 "no-operation".
 //
 // Warning: the UI may become a bit wonky until the allowed account becomes `Active`.
-func (p *Postgres) AddPrivatelinkAllowedAccounts(addonID string, opts *PrivatelinkRequest) (*Privatelink, *simpleresty.Response, error) {
+func (p *Postgres) AddPrivatelinkAllowedAccounts(ctx context.Context, addonID string, opts *PrivatelinkRequest) (*Privatelink, *simpleresty.Response, error) {
 	var result *Privatelink
 	urlStr := p.http.RequestURL("/private-link/v0/databases/%s/allowed_accounts", addonID)
 
 	// Execute the request
-	response, patchErr := p.http.Put(urlStr, &result, opts)
+	response, patchErr := p.http.Put(ctx, urlStr, &result, opts)
 
 	return result, response, patchErr
 }
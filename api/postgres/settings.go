@@ -1,6 +1,9 @@
 package postgres
 
-import "github.com/davidji99/simpleresty"
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+)
 
 // Settings represents the available settings for a Heroku postgres database.
 type Settings struct {
@@ -71,12 +74,12 @@ type SettingsRequest struct {
 }
 
 // GetSettings returns all settings for a postgres database.
-func (p *Postgres) GetSettings(nameOrID string) (*Settings, *simpleresty.Response, error) {
+func (p *Postgres) GetSettings(ctx context.Context, nameOrID string) (*Settings, *simpleresty.Response, error) {
 	var result *Settings
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/config", nameOrID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
@@ -86,13 +89,13 @@ func (p *Postgres) GetSettings(nameOrID string) (*Settings, *simpleresty.Respons
 // NOTE: A successful update request does not necessarily mean the update has been fully applied in Heroku.
 // Often times, subsequent requests may return with a 422 status code that indicates the following:
 // "Still applying previous configuration change to this database. Please try again later."
-func (p *Postgres) UpdateSettings(nameOrID string, opts *SettingsRequest) (*Settings, *simpleresty.Response, error) {
+func (p *Postgres) UpdateSettings(ctx context.Context, nameOrID string, opts *SettingsRequest) (*Settings, *simpleresty.Response, error) {
 	var result *Settings
 
 	urlStr := p.http.RequestURL("/postgres/v0/databases/%s/config", nameOrID)
 
 	// Execute the request
-	response, updateErr := p.http.Patch(urlStr, &result, opts)
+	response, updateErr := p.http.Patch(ctx, urlStr, &result, opts)
 
 	return result, response, updateErr
 }
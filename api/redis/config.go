@@ -1,6 +1,9 @@
 package redis
 
-import "github.com/davidji99/simpleresty"
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+)
 
 const (
 	DisableNotifyKeyspaceEvents = "disabled"
@@ -54,23 +57,23 @@ type ConfigUpdateRequest struct {
 }
 
 // GetConfig retrieves configurations for a Redis instance.
-func (r *Redis) GetConfig(id string) (*Config, *simpleresty.Response, error) {
+func (r *Redis) GetConfig(ctx context.Context, id string) (*Config, *simpleresty.Response, error) {
 	var result *Config
 	urlStr := r.http.RequestURL("/redis/v0/databases/%s/config", id)
 
 	// Execute the request
-	response, getErr := r.http.Get(urlStr, &result, nil)
+	response, getErr := r.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // UpdateConfig updates Redis configuration(s).
-func (r *Redis) UpdateConfig(id string, opts *ConfigUpdateRequest) (*Config, *simpleresty.Response, error) {
+func (r *Redis) UpdateConfig(ctx context.Context, id string, opts *ConfigUpdateRequest) (*Config, *simpleresty.Response, error) {
 	var result *Config
 	urlStr := r.http.RequestURL("/redis/v0/databases/%s/config", id)
 
 	// Execute the request
-	response, updateErr := r.http.Patch(urlStr, &result, opts)
+	response, updateErr := r.http.Patch(ctx, urlStr, &result, opts)
 
 	return result, response, updateErr
 }
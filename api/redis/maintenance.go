@@ -1,6 +1,7 @@
 package redis
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -15,18 +16,18 @@ type MaintenanceWindowResponse struct {
 // GetMaintenanceWindow returns the maintenance window for a redis database.
 //
 // All times are in UTC.
-func (p *Redis) GetMaintenanceWindow(dbID string) (*GenericResponse, *simpleresty.Response, error) {
+func (p *Redis) GetMaintenanceWindow(ctx context.Context, dbID string) (*GenericResponse, *simpleresty.Response, error) {
 	var result *GenericResponse
 	urlStr := p.http.RequestURL("/redis/v0/databases/%s/maintenance", dbID)
 
 	// Execute the request
-	response, getErr := p.http.Get(urlStr, &result, nil)
+	response, getErr := p.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
 
 // SetMaintenanceWindow sets the the weekly maintenance window for a redis database.
-func (p *Redis) SetMaintenanceWindow(dbID, window string) (*MaintenanceWindowResponse, *simpleresty.Response, error) {
+func (p *Redis) SetMaintenanceWindow(ctx context.Context, dbID, window string) (*MaintenanceWindowResponse, *simpleresty.Response, error) {
 	var result *MaintenanceWindowResponse
 	urlStr := p.http.RequestURL("/redis/v0/databases/%s/maintenance_window", dbID)
 
@@ -43,7 +44,7 @@ func (p *Redis) SetMaintenanceWindow(dbID, window string) (*MaintenanceWindowRes
 	}
 
 	// Execute the request
-	response, getErr := p.http.Put(urlStr, &result, &body)
+	response, getErr := p.http.Put(ctx, urlStr, &result, &body)
 
 	return result, response, getErr
 }
@@ -1,15 +1,14 @@
 package redis
 
 import (
-	"fmt"
-	"github.com/davidji99/simpleresty"
 	config2 "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"time"
 )
 
 // Redis represents Heroku's Redis APIs.
 type Redis struct {
-	http   *simpleresty.Client
+	http   *rest.Client
 	config *config2.Config
 }
 
@@ -21,7 +20,7 @@ type GenericResponse struct {
 
 // New constructs a client to interface with the Heroku Redis APIs.
 func New(config *config2.Config) *Redis {
-	r := &Redis{http: simpleresty.NewWithBaseURL(config.RedisBaseURL), config: config}
+	r := &Redis{http: rest.NewWithConfig(config.RedisBaseURL, config), config: config}
 	r.setHeaders()
 
 	return r
@@ -31,9 +30,9 @@ func (r *Redis) setHeaders() {
 	r.http.SetHeader("Content-type", r.config.ContentTypeHeader).
 		SetHeader("Accept", r.config.AcceptHeader).
 		SetHeader("User-Agent", r.config.UserAgent).
-		SetHeader("Authorization", fmt.Sprintf("Bearer %s", r.config.APIToken)).
-		SetTimeout(2 * time.Minute).
+		SetTimeout(r.config.Timeout(config2.ServiceRedis, 2*time.Minute)).
 		SetAllowGetMethodPayload(true)
+	r.http.SetAuthorizer(rest.BearerAuthorizer(r.config)).SetService(config2.ServiceRedis)
 
 	// Set additional headers
 	if r.config.CustomHTTPHeaders != nil {
@@ -1,6 +1,9 @@
 package registry
 
-import "github.com/davidji99/simpleresty"
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+)
 
 // Manifest represents a docker image data. This struct is only returned via a specific header, DistributionManifestAcceptHeader.
 type Manifest struct {
@@ -30,13 +33,13 @@ type ManifestLayer struct {
 // GetAppProcessManifests retrieves a pushed docker images by tag.
 //
 // Note: the only acceptable tag parameter is 'latest' as 01-11-2021.
-func (r *Registry) GetAppProcessManifests(appIDorName, processType, tag string) (*Manifest, *simpleresty.Response, error) {
+func (r *Registry) GetAppProcessManifests(ctx context.Context, appIDorName, processType, tag string) (*Manifest, *simpleresty.Response, error) {
 	var result *Manifest
 
 	urlStr := r.http.RequestURL("/v2/%s/%s/manifests/%s", appIDorName, processType, tag)
 
 	// Execute the request
-	response, getErr := r.http.Get(urlStr, &result, nil)
+	response, getErr := r.http.Get(ctx, urlStr, &result, nil)
 
 	return result, response, getErr
 }
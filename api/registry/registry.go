@@ -1,9 +1,8 @@
 package registry
 
 import (
-	"fmt"
-	"github.com/davidji99/simpleresty"
 	config "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"time"
 )
 
@@ -13,13 +12,13 @@ const (
 
 // Registry represents API functionality that's part of the Heroku Registry.
 type Registry struct {
-	http   *simpleresty.Client
+	http   *rest.Client
 	config *config.Config
 }
 
 // New constructs a client to interface with the Heroku Platform APIs.
 func New(config *config.Config) *Registry {
-	r := &Registry{http: simpleresty.NewWithBaseURL(config.RegistryBaseURL), config: config}
+	r := &Registry{http: rest.NewWithConfig(config.RegistryBaseURL, config), config: config}
 	r.setHeaders()
 
 	return r
@@ -29,9 +28,9 @@ func (r *Registry) setHeaders() {
 	r.http.SetHeader("Content-type", r.config.ContentTypeHeader).
 		SetHeader("Accept", DistributionManifestAcceptHeader).
 		SetHeader("User-Agent", r.config.UserAgent).
-		SetHeader("Authorization", fmt.Sprintf("Basic %s", r.config.BasicAuth)).
-		SetTimeout(2 * time.Minute).
+		SetTimeout(r.config.Timeout(config.ServiceRegistry, 2*time.Minute)).
 		SetAllowGetMethodPayload(true)
+	r.http.SetAuthorizer(rest.BasicAuthorizer(r.config)).SetService(config.ServiceRegistry)
 
 	// Set additional headers
 	if r.config.CustomHTTPHeaders != nil {
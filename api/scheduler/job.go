@@ -1,8 +1,9 @@
 package scheduler
 
 import (
-	"fmt"
+	"context"
 	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
 	"time"
 )
 
@@ -64,12 +65,12 @@ type JobRequestNew struct {
 }
 
 // List returns all jobs.
-func (s *Scheduler) List(appID string) (*Jobs, *simpleresty.Response, error) {
+func (s *Scheduler) List(ctx context.Context, appID string) (*Jobs, *simpleresty.Response, error) {
 	var result Jobs
 	urlStr := s.http.RequestURL("/apps/%s/jobs", appID)
 
 	// Execute the request
-	response, getErr := s.http.Get(urlStr, &result, nil)
+	response, getErr := s.http.Get(ctx, urlStr, &result, nil)
 
 	return &result, response, getErr
 }
@@ -77,14 +78,14 @@ func (s *Scheduler) List(appID string) (*Jobs, *simpleresty.Response, error) {
 // FindByID retrieves a job by its ID.
 //
 // Unfortunately, there is no `GET` endpoint to retrieve one job.
-func (s *Scheduler) FindByID(appID, jobID string) (*Job, *simpleresty.Response, error) {
-	jobs, listResponse, listErr := s.List(appID)
+func (s *Scheduler) FindByID(ctx context.Context, appID, jobID string) (*Job, *simpleresty.Response, error) {
+	jobs, listResponse, listErr := s.List(ctx, appID)
 	if listErr != nil {
 		return nil, listResponse, listErr
 	}
 
 	if !jobs.HasData() {
-		return nil, nil, fmt.Errorf("no jobs exist for app %s", appID)
+		return nil, nil, apierror.NewNotFound("no jobs exist for app %s", appID)
 	}
 
 	for _, j := range jobs.Data {
@@ -93,11 +94,11 @@ func (s *Scheduler) FindByID(appID, jobID string) (*Job, *simpleresty.Response,
 		}
 	}
 
-	return nil, nil, fmt.Errorf("job %s not found on app %s", appID, jobID)
+	return nil, nil, apierror.NewNotFound("job %s not found on app %s", jobID, appID)
 }
 
 // Create a job.
-func (s *Scheduler) Create(appID string, opts *JobRequest) (*JobModifyResponse, *simpleresty.Response, error) {
+func (s *Scheduler) Create(ctx context.Context, appID string, opts *JobRequest) (*JobModifyResponse, *simpleresty.Response, error) {
 	var result JobModifyResponse
 	urlStr := s.http.RequestURL("/apps/%s/jobs", appID)
 
@@ -117,13 +118,13 @@ func (s *Scheduler) Create(appID string, opts *JobRequest) (*JobModifyResponse,
 	}
 
 	// Execute the request
-	response, createErr := s.http.Post(urlStr, &result, &body)
+	response, createErr := s.http.Post(ctx, urlStr, &result, &body)
 
 	return &result, response, createErr
 }
 
 // Update a job.
-func (s *Scheduler) Update(appID, jobID string, opts *JobRequest) (*JobModifyResponse, *simpleresty.Response, error) {
+func (s *Scheduler) Update(ctx context.Context, appID, jobID string, opts *JobRequest) (*JobModifyResponse, *simpleresty.Response, error) {
 	var result JobModifyResponse
 	urlStr := s.http.RequestURL("/apps/%s/jobs/%s", appID, jobID)
 
@@ -146,17 +147,17 @@ func (s *Scheduler) Update(appID, jobID string, opts *JobRequest) (*JobModifyRes
 	}
 
 	// Execute the request
-	response, updateErr := s.http.Patch(urlStr, &result, &body)
+	response, updateErr := s.http.Patch(ctx, urlStr, &result, &body)
 
 	return &result, response, updateErr
 }
 
 // Delete a job.
-func (s *Scheduler) Delete(appID, jobID string) (*simpleresty.Response, error) {
+func (s *Scheduler) Delete(ctx context.Context, appID, jobID string) (*simpleresty.Response, error) {
 	urlStr := s.http.RequestURL("/apps/%s/jobs/%s", appID, jobID)
 
 	// Execute the request
-	response, deleteErr := s.http.Delete(urlStr, nil, nil)
+	response, deleteErr := s.http.Delete(ctx, urlStr, nil, nil)
 
 	return response, deleteErr
 }
@@ -1,21 +1,20 @@
 package scheduler
 
 import (
-	"fmt"
-	"github.com/davidji99/simpleresty"
 	config "github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/rest"
 	"time"
 )
 
 // Scheduler represents API functionality for the Heroku scheduler addon.
 type Scheduler struct {
-	http   *simpleresty.Client
+	http   *rest.Client
 	config *config.Config
 }
 
 // New constructs a client to interface with the Heroku scheduler APIs.
 func New(config *config.Config) *Scheduler {
-	s := &Scheduler{http: simpleresty.NewWithBaseURL(config.SchedulerURL), config: config}
+	s := &Scheduler{http: rest.NewWithConfig(config.SchedulerURL, config), config: config}
 	s.setHeaders()
 
 	return s
@@ -25,9 +24,9 @@ func (s *Scheduler) setHeaders() {
 	s.http.SetHeader("Content-type", "application/vnd.api+json").
 		SetHeader("Accept", "application/vnd.api+json").
 		SetHeader("User-Agent", s.config.UserAgent).
-		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.config.APIToken)).
-		SetTimeout(2 * time.Minute).
+		SetTimeout(s.config.Timeout(config.ServiceScheduler, 2*time.Minute)).
 		SetAllowGetMethodPayload(true)
+	s.http.SetAuthorizer(rest.BearerAuthorizer(s.config)).SetService(config.ServiceScheduler)
 
 	// Set additional headers
 	if s.config.CustomHTTPHeaders != nil {
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"context"
+	heroku "github.com/davidji99/heroku-go/v5"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api/connect"
+	"github.com/davidji99/terraform-provider-herokux/api/data"
+	"github.com/davidji99/terraform-provider-herokux/api/general"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/kolkrabbi"
+	"github.com/davidji99/terraform-provider-herokux/api/metrics"
+	"github.com/davidji99/terraform-provider-herokux/api/platform"
+	"github.com/davidji99/terraform-provider-herokux/api/postgres"
+	"github.com/davidji99/terraform-provider-herokux/api/redis"
+	"github.com/davidji99/terraform-provider-herokux/api/registry"
+	"github.com/davidji99/terraform-provider-herokux/api/scheduler"
+	"iter"
+)
+
+// The interfaces below are implemented by the api sub-clients and can be replaced with the mocks in the mock package
+// for unit tests. Run `go run gen-mocks.go` in this directory to regenerate the mocks after changing an interface.
+
+// ConnectService represents the Heroku Connect APIs.
+type ConnectService interface {
+	RegionalBaseURL(ctx context.Context, appID, connectID string) (string, error)
+	GetConnection(ctx context.Context, baseURL, connectionID string, params connect.ConnectionGetQueryParams) (*connect.Connection, *simpleresty.Response, error)
+	ConfigureSettings(ctx context.Context, baseURL, connectionID string, opts *connect.ConnectionUpdateRequest) (*connect.Connection, *simpleresty.Response, error)
+	GetMapping(ctx context.Context, baseURL, mappingID string) (*connect.Mapping, *simpleresty.Response, error)
+	ImportMappings(ctx context.Context, baseURL, connectID string, mappings []byte) (*simpleresty.Response, error)
+	ExportMappings(ctx context.Context, baseURL, connectID string) (*connect.MappingExportOutput, *simpleresty.Response, error)
+	DeleteMapping(ctx context.Context, baseURL, mappingID string) (*simpleresty.Response, error)
+	CreateCredential(ctx context.Context, baseURL, odataID string) (*connect.ConnectionCredential, *simpleresty.Response, error)
+	RevokeCredential(ctx context.Context, baseURL, odataID string) (*connect.ConnectionCredential, *simpleresty.Response, error)
+}
+
+// DataService represents the Heroku Data GraphQL APIs.
+type DataService interface {
+	ListPostgresDataclips(ctx context.Context) ([]*data.PostgresDataclip, *simpleresty.Response, error)
+	GetPostgresDataclip(ctx context.Context, slug string) (*data.PostgresDataclip, *simpleresty.Response, error)
+	CreatePostgresDataclip(ctx context.Context, opts *data.PostgresDataclipCreateRequest) (*data.PostgresDataclip, *simpleresty.Response, error)
+	UpdatePostgresDataclip(ctx context.Context, opts *data.PostgresDataclipUpdateRequest) (*data.PostgresDataclip, *simpleresty.Response, error)
+	DeletePostgresDataclip(ctx context.Context, id string) (*data.PostgresDataclipDeleteResponse, *simpleresty.Response, error)
+	TogglePostgresDataclipSharing(ctx context.Context, slug string, enabled bool) (*data.PostgresDataclip, *simpleresty.Response, error)
+	SharePostgresDataclipWithUser(ctx context.Context, dataclipID, userEmail string) (*data.PostgresDataclipUserShare, *simpleresty.Response, error)
+	UnsharePostgresDataclipWithUser(ctx context.Context, dataclipID, dataclipShareID string) (bool, *simpleresty.Response, error)
+	SharePostgresDataclipWithTeam(ctx context.Context, dataclipID, teamID string) (*data.PostgresDataclipTeamShare, *simpleresty.Response, error)
+	UnsharePostgresDataclipWithTeam(ctx context.Context, dataclipID, dataclipShareID string) (bool, *simpleresty.Response, error)
+	GetPrivatelink(ctx context.Context, addonID string) (*data.Privatelink, *simpleresty.Response, error)
+}
+
+// KafkaService represents the Heroku Kafka APIs.
+type KafkaService interface {
+	Get(ctx context.Context, clusterID string) (*kafka.Cluster, *simpleresty.Response, error)
+	ListConsumerGroups(ctx context.Context, clusterID string) (*kafka.ConsumerGroups, *simpleresty.Response, error)
+	GetConsumerGroupByName(ctx context.Context, clusterID, groupName string) (*kafka.ConsumerGroup, *simpleresty.Response, error)
+	CreateConsumerGroup(ctx context.Context, clusterID string, opts *kafka.ConsumerGroupRequest) (*kafka.Response, *simpleresty.Response, error)
+	DeleteConsumerGroup(ctx context.Context, clusterID string, opts *kafka.ConsumerGroupRequest) (*kafka.Response, *simpleresty.Response, error)
+	WasConsumerGroupCreated(ctx context.Context, clusterID, consumerGroupName string) (bool, *simpleresty.Response, error)
+	WasConsumerGroupDeleted(ctx context.Context, clusterID, consumerGroupName string) (bool, *simpleresty.Response, error)
+	ListMTLSIPRules(ctx context.Context, kafkaID string) ([]*general.MtlsIPRule, *simpleresty.Response, error)
+	GetMTLSIPRule(ctx context.Context, kafkaID, ruleID string) (*general.MtlsIPRule, *simpleresty.Response, error)
+	CreateMTLSIPRule(ctx context.Context, kafkaID string, opts *general.MTLSIPRuleRequest) (*general.MtlsIPRule, *simpleresty.Response, error)
+	DeleteMTLSIPRule(ctx context.Context, kafkaID, ruleID string) (*simpleresty.Response, error)
+	ListTopics(ctx context.Context, clusterID string) (*kafka.Topics, *simpleresty.Response, error)
+	GetTopicByName(ctx context.Context, clusterID, topicName string) (*kafka.Topic, *simpleresty.Response, error)
+	CreateTopic(ctx context.Context, clusterID string, opts *kafka.TopicRequest) (*kafka.Response, *simpleresty.Response, error)
+	UpdateTopic(ctx context.Context, clusterID string, opts *kafka.TopicRequest) (*kafka.Response, *simpleresty.Response, error)
+	DeleteTopic(ctx context.Context, clusterID, topicName string) (*kafka.Response, *simpleresty.Response, error)
+}
+
+// KolkrabbiService represents the Kolkrabbi APIs behind the Heroku GitHub integration.
+type KolkrabbiService interface {
+	GetAccountInfo(ctx context.Context) (*kolkrabbi.AccountInfo, *simpleresty.Response, error)
+	GetAppGithubIntegration(ctx context.Context, appID string) (*kolkrabbi.AppGHIntegration, *simpleresty.Response, error)
+	UpdateAppGithubIntegration(ctx context.Context, appID string, opts *kolkrabbi.AppGhIntegrationRequest) (*kolkrabbi.AppGHIntegration, *simpleresty.Response, error)
+	GetPipelineGithubIntegration(ctx context.Context, pipelineID string) (*kolkrabbi.PipelineGHIntegration, *simpleresty.Response, error)
+	CreatePipelineGithubIntegration(ctx context.Context, pipelineID string, opts *kolkrabbi.PipelineGHIntegrationRequest) (*kolkrabbi.PipelineGHIntegration, *simpleresty.Response, error)
+	DeletePipelineGithubIntegration(ctx context.Context, pipelineID string) (*simpleresty.Response, error)
+}
+
+// MetricsService represents the Heroku Metrics APIs.
+type MetricsService interface {
+	ListMonitors(ctx context.Context, appID, formationName string) ([]*metrics.FormationMonitor, *simpleresty.Response, error)
+	GetMonitor(ctx context.Context, appID, formationName, monitorID string) (*metrics.FormationMonitor, *simpleresty.Response, error)
+	DeleteMonitor(ctx context.Context, appID, formationName, monitorID string) (*simpleresty.Response, error)
+	FindMonitorByName(ctx context.Context, appID, formationName string, name metrics.FormationMonitorName) (*metrics.FormationMonitor, *simpleresty.Response, error)
+	CreateFormationAutoscaling(ctx context.Context, appID, formationName string, opts *metrics.FormationAutoscalingRequest) (*metrics.FormationMonitor, *simpleresty.Response, error)
+	UpdateFormationAutoscaling(ctx context.Context, appID, formationName, monitorID string, opts *metrics.FormationAutoscalingRequest) (bool, *simpleresty.Response, error)
+	CreateFormationAlert(ctx context.Context, appID, formationName string, opts *metrics.FormationAlertRequest) (*metrics.FormationMonitor, *simpleresty.Response, error)
+	UpdateFormationAlert(ctx context.Context, appID, formationName, alertID string, opts *metrics.FormationAlertRequest) (bool, *simpleresty.Response, error)
+}
+
+// PlatformService represents the Heroku Platform APIs not yet supported by heroku-go.
+type PlatformService interface {
+	FormationContainerBatchUpdate(ctx context.Context, appIdOrName string, opts *platform.FormationDockerBatchUpdateOpts) ([]*platform.Formation, *simpleresty.Response, error)
+	FormationContainerUpdate(ctx context.Context, appIdOrName string, processType string, opts *platform.FormationDockerUpdateOpts) (*simpleresty.Response, error)
+	GetPipelineEphemeralAppsConfig(ctx context.Context, pipelineID string) (*platform.Pipeline, *simpleresty.Response, error)
+	UpdatePipelineEphemeralAppsConfig(ctx context.Context, pipelineID string, opts *platform.PipelineEphemeralAppsConfigUpdateOpts) (*platform.Pipeline, *simpleresty.Response, error)
+	ListPipelineMembers(ctx context.Context, pipelineID string) ([]*platform.PipelineMembership, *simpleresty.Response, error)
+	PipelineMembers(ctx context.Context, pipelineID string) iter.Seq2[*platform.PipelineMembership, error]
+	FindPipelineMembersByEmail(ctx context.Context, pipelineID, email string) (*platform.PipelineMembership, *simpleresty.Response, error)
+	AddPipelineMember(ctx context.Context, opts *platform.PipelineMembershipRequestOpts) (*platform.PipelineMembership, *simpleresty.Response, error)
+	UpdatePipelineMemberPermissions(ctx context.Context, membershipID string, permissions []string) (*platform.PipelineMembership, *simpleresty.Response, error)
+	RemovePipelineMember(ctx context.Context, membershipID string) (*simpleresty.Response, error)
+	GetSpaceLogDrain(ctx context.Context, spaceID string) (*platform.LogDrain, *simpleresty.Response, error)
+	SetSpaceLogDrain(ctx context.Context, spaceID string, url string) (*platform.LogDrain, *simpleresty.Response, error)
+	ListAppWebhooks(ctx context.Context, appID string) ([]*platform.AppWebhook, *simpleresty.Response, error)
+	AppWebhooks(ctx context.Context, appID string) iter.Seq2[*platform.AppWebhook, error]
+	GetAppWebhook(ctx context.Context, appID, webhookID string) (*platform.AppWebhook, *simpleresty.Response, error)
+	CreateAppWebhook(ctx context.Context, appID string, opts *platform.AppWebhookRequest) (*platform.AppWebhook, *simpleresty.Response, error)
+	UpdateAppWebhook(ctx context.Context, appID, webhookID string, opts *platform.AppWebhookRequest) (*platform.AppWebhook, *simpleresty.Response, error)
+	DeleteAppWebhook(ctx context.Context, appID, webhookID string) (*simpleresty.Response, error)
+}
+
+// PostgresService represents the Heroku Postgres APIs.
+type PostgresService interface {
+	ListBackupSchedules(ctx context.Context, nameOrID string) ([]*postgres.BackupSchedule, *simpleresty.Response, error)
+	CreateBackupSchedule(ctx context.Context, nameOrID string, opts *postgres.BackupScheduleRequest) (*postgres.BackupSchedule, *simpleresty.Response, error)
+	DeleteBackupSchedule(ctx context.Context, dbNameOrID, scheduleID string) (*simpleresty.Response, error)
+	CreateConnectionPooling(ctx context.Context, nameOrID string, opts *postgres.ConnectionPoolingRequest) (*heroku.AddOnAttachment, *simpleresty.Response, error)
+	ListCredentials(ctx context.Context, nameOrID string) ([]*postgres.Credential, *simpleresty.Response, error)
+	GetCredential(ctx context.Context, nameOrID, credentialName string) (*postgres.Credential, *simpleresty.Response, error)
+	CreateCredential(ctx context.Context, nameOrID, newCredName string) (*postgres.GenericResponse, *simpleresty.Response, error)
+	DeleteCredential(ctx context.Context, nameOrID, credentialName string) (*postgres.GenericResponse, *simpleresty.Response, error)
+	ListDataConnectors(ctx context.Context, appName string) ([]*postgres.DataConnector, *simpleresty.Response, error)
+	GetDataConnector(ctx context.Context, id string) (*postgres.DataConnector, *simpleresty.Response, error)
+	CreateDataConnector(ctx context.Context, kafkaID string, opts *postgres.DataConnectorRequest) (*postgres.DataConnector, *simpleresty.Response, error)
+	DeleteDataConnector(ctx context.Context, id string) (*postgres.DataConnector, *simpleresty.Response, error)
+	PauseDataConnector(ctx context.Context, id string) (*simpleresty.Response, error)
+	ResumeDataConnector(ctx context.Context, id string) (*simpleresty.Response, error)
+	UpdateDataConnectorSettings(ctx context.Context, id string, opts *postgres.DataConnectSettings) (*postgres.DataConnector, *simpleresty.Response, error)
+	ListDataLink(ctx context.Context, localDbID string) ([]*postgres.DataLink, *simpleresty.Response, error)
+	FindDataLinkByID(ctx context.Context, localDbID, dataLinkID string) (*postgres.DataLink, *simpleresty.Response, error)
+	FindDataLinkByName(ctx context.Context, localDbID, dataLinkName string) (*postgres.DataLink, *simpleresty.Response, error)
+	CreateDataLink(ctx context.Context, localDbID string, opts *postgres.DataLinkCreateOpts) (*postgres.DataLink, *simpleresty.Response, error)
+	DeleteDataLink(ctx context.Context, localDbID, linkName string) (*simpleresty.Response, error)
+	GetDB(ctx context.Context, dbID string) (*postgres.Database, *simpleresty.Response, error)
+	GetDBWaitStatus(ctx context.Context, dbID string) (*postgres.DatabaseWaitStatus, *simpleresty.Response, error)
+	UnfollowDB(ctx context.Context, dbID string) (*postgres.GenericResponse, *simpleresty.Response, error)
+	GetMaintenanceWindow(ctx context.Context, dbID string) (*postgres.GenericResponse, *simpleresty.Response, error)
+	SetMaintenanceWindow(ctx context.Context, dbID, window string) (*postgres.MaintenanceWindowResponse, *simpleresty.Response, error)
+	ProvisionMTLS(ctx context.Context, nameOrID string) (*postgres.MTLS, *simpleresty.Response, error)
+	IsMTLSReady(ctx context.Context, nameOrID string) (bool, postgres.MTLSConfigStatus, error)
+	DeprovisionMTLS(ctx context.Context, nameOrID string) (*postgres.MTLS, *simpleresty.Response, error)
+	GetMTLS(ctx context.Context, nameOrID string) (*postgres.MTLS, *simpleresty.Response, error)
+	ListMTLSCerts(ctx context.Context, dbNameOrID string) ([]*postgres.MTLSCert, *simpleresty.Response, error)
+	GetMTLSCert(ctx context.Context, dbNameOrID, certID string) (*postgres.MTLSCert, *simpleresty.Response, error)
+	CreateMTLSCert(ctx context.Context, dbNameOrID string) (*postgres.MTLSCert, *simpleresty.Response, error)
+	DeleteMTLSCert(ctx context.Context, dbNameOrID, certID string) (*postgres.MTLSCert, *simpleresty.Response, error)
+	ListMTLSIPRules(ctx context.Context, dbNameOrID string) ([]*general.MtlsIPRule, *simpleresty.Response, error)
+	GetMTLSIPRule(ctx context.Context, dbNameOrID, ipRuleID string) (*general.MtlsIPRule, *simpleresty.Response, error)
+	CreateMTLSIPRule(ctx context.Context, dbNameOrID string, opts *general.MTLSIPRuleRequest) (*general.MtlsIPRule, *simpleresty.Response, error)
+	DeleteMTLSIPRule(ctx context.Context, dbNameOrID, ipRuleID string) (*simpleresty.Response, error)
+	CreatePrivatelink(ctx context.Context, addonID string, opts *postgres.PrivatelinkRequest) (*postgres.Privatelink, *simpleresty.Response, error)
+	GetPrivatelink(ctx context.Context, addonID string) (*postgres.Privatelink, *simpleresty.Response, error)
+	DeletePrivatelink(ctx context.Context, addonID string) (*postgres.Privatelink, *simpleresty.Response, error)
+	RemovePrivatelinkAllowedAccounts(ctx context.Context, addonID string, opts *postgres.PrivatelinkRequest) (*postgres.Privatelink, *simpleresty.Response, error)
+	AddPrivatelinkAllowedAccounts(ctx context.Context, addonID string, opts *postgres.PrivatelinkRequest) (*postgres.Privatelink, *simpleresty.Response, error)
+	GetSettings(ctx context.Context, nameOrID string) (*postgres.Settings, *simpleresty.Response, error)
+	UpdateSettings(ctx context.Context, nameOrID string, opts *postgres.SettingsRequest) (*postgres.Settings, *simpleresty.Response, error)
+}
+
+// RedisService represents the Heroku Redis APIs.
+type RedisService interface {
+	GetConfig(ctx context.Context, id string) (*redis.Config, *simpleresty.Response, error)
+	UpdateConfig(ctx context.Context, id string, opts *redis.ConfigUpdateRequest) (*redis.Config, *simpleresty.Response, error)
+	GetMaintenanceWindow(ctx context.Context, dbID string) (*redis.GenericResponse, *simpleresty.Response, error)
+	SetMaintenanceWindow(ctx context.Context, dbID, window string) (*redis.MaintenanceWindowResponse, *simpleresty.Response, error)
+}
+
+// RegistryService represents the Heroku container registry.
+type RegistryService interface {
+	GetAppProcessManifests(ctx context.Context, appIDorName, processType, tag string) (*registry.Manifest, *simpleresty.Response, error)
+}
+
+// SchedulerService represents the Heroku Scheduler APIs.
+type SchedulerService interface {
+	List(ctx context.Context, appID string) (*scheduler.Jobs, *simpleresty.Response, error)
+	FindByID(ctx context.Context, appID, jobID string) (*scheduler.Job, *simpleresty.Response, error)
+	Create(ctx context.Context, appID string, opts *scheduler.JobRequest) (*scheduler.JobModifyResponse, *simpleresty.Response, error)
+	Update(ctx context.Context, appID, jobID string, opts *scheduler.JobRequest) (*scheduler.JobModifyResponse, *simpleresty.Response, error)
+	Delete(ctx context.Context, appID, jobID string) (*simpleresty.Response, error)
+}
+
+// Make sure the api sub-clients implement their service interface.
+var (
+	_ ConnectService   = (*connect.Connect)(nil)
+	_ DataService      = (*data.Data)(nil)
+	_ KafkaService     = (*kafka.Kafka)(nil)
+	_ KolkrabbiService = (*kolkrabbi.Kolkrabbi)(nil)
+	_ MetricsService   = (*metrics.Metrics)(nil)
+	_ PlatformService  = (*platform.Platform)(nil)
+	_ PostgresService  = (*postgres.Postgres)(nil)
+	_ RedisService     = (*redis.Redis)(nil)
+	_ RegistryService  = (*registry.Registry)(nil)
+	_ SchedulerService = (*scheduler.Scheduler)(nil)
+)
@@ -0,0 +1,362 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/scheduler"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// command is a node of the command tree. Leaf commands have a run function that takes exactly len(args) arguments.
+type command struct {
+	name     string
+	args     []string
+	summary  string
+	commands []*command
+	run      func(ctx context.Context, client *api.Client, args []string) (*result, error)
+}
+
+// commands is the root of the command tree.
+var commands = &command{
+	commands: []*command{
+		{
+			name: "kafka",
+			commands: []*command{
+				{
+					name: "cluster",
+					commands: []*command{
+						{
+							name:    "get",
+							args:    []string{"CLUSTER_ID"},
+							summary: "Show a Kafka cluster with its limits and capabilities",
+							run:     runKafkaClusterGet,
+						},
+					},
+				},
+				{
+					name: "topics",
+					commands: []*command{
+						{
+							name:    "ls",
+							args:    []string{"CLUSTER_ID"},
+							summary: "List the topics of a Kafka cluster",
+							run:     runKafkaTopicsList,
+						},
+					},
+				},
+				{
+					name: "consumer-groups",
+					commands: []*command{
+						{
+							name:    "ls",
+							args:    []string{"CLUSTER_ID"},
+							summary: "List the consumer groups of a Kafka cluster",
+							run:     runKafkaConsumerGroupsList,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pg",
+			commands: []*command{
+				{
+					name: "settings",
+					commands: []*command{
+						{
+							name:    "get",
+							args:    []string{"DATABASE"},
+							summary: "Show the settings of a Postgres database",
+							run:     runPostgresSettingsGet,
+						},
+					},
+				},
+				{
+					name: "mtls-certs",
+					commands: []*command{
+						{
+							name:    "ls",
+							args:    []string{"DATABASE"},
+							summary: "List the MTLS certificates of a Postgres database",
+							run:     runPostgresMTLSCertsList,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "monitors",
+			commands: []*command{
+				{
+					name:    "ls",
+					args:    []string{"APP_ID", "PROCESS_TYPE"},
+					summary: "List the alerts and autoscaling monitors of a formation",
+					run:     runMonitorsList,
+				},
+			},
+		},
+		{
+			name: "scheduler",
+			commands: []*command{
+				{
+					name: "jobs",
+					commands: []*command{
+						{
+							name:    "ls",
+							args:    []string{"APP_ID"},
+							summary: "List the Heroku Scheduler jobs of an app",
+							run:     runSchedulerJobsList,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "generate",
+			commands: []*command{
+				{
+					name:    "kafka",
+					args:    []string{"CLUSTER_ID"},
+					summary: "Generate resource and import blocks for the topics, consumer groups and IP rules of a Kafka cluster",
+					run:     runGenerateKafka,
+				},
+				{
+					name:    "app",
+					args:    []string{"APP_ID"},
+					summary: "Generate resource and import blocks for the scheduler jobs, webhooks and web monitors of an app",
+					run:     runGenerateApp,
+				},
+				{
+					name:    "pg",
+					args:    []string{"DATABASE"},
+					summary: "Generate resource and import blocks for the backup schedule, credentials and IP rules of a database",
+					run:     runGeneratePostgres,
+				},
+				{
+					name:    "connect",
+					args:    []string{"APP_ID", "CONNECT_ID"},
+					summary: "Generate resource and import blocks for the mappings of a Heroku Connect connection",
+					run:     runGenerateConnect,
+				},
+			},
+		},
+		{
+			name: "dataclips",
+			commands: []*command{
+				{
+					name:    "ls",
+					summary: "List the dataclips you have access to",
+					run:     runDataclipsList,
+				},
+			},
+		},
+	},
+}
+
+// find returns the deepest command matching the leading args and the number of args that name it.
+func (c *command) find(args []string) (*command, int) {
+	if len(args) == 0 || c.run != nil {
+		return c, 0
+	}
+
+	for _, sub := range c.commands {
+		if sub.name == args[0] {
+			found, n := sub.find(args[1:])
+			return found, n + 1
+		}
+	}
+
+	return c, 0
+}
+
+// printTree prints every leaf command under c. Path is the names of the commands leading to c.
+func (c *command) printTree(w io.Writer, path []string) {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	c.walk(path, func(names []string, leaf *command) {
+		fmt.Fprintf(tw, "  %s\t%s\n", strings.Join(append(names, leaf.args...), " "), leaf.summary)
+	})
+	tw.Flush()
+}
+
+func (c *command) walk(path []string, fn func(names []string, leaf *command)) {
+	if c.run != nil {
+		fn(path, c)
+		return
+	}
+
+	for _, sub := range c.commands {
+		sub.walk(append(append([]string{}, path...), sub.name), fn)
+	}
+}
+
+func runKafkaClusterGet(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	cluster, _, err := client.Kafka.Get(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	state, limits, capabilities := cluster.GetState(), cluster.GetLimits(), cluster.GetCapabilities()
+	if state == nil {
+		state = &kafka.ClusterState{}
+	}
+	if limits == nil {
+		limits = &kafka.ClusterLimits{}
+	}
+	if capabilities == nil {
+		capabilities = &kafka.ClusterCapabilities{}
+	}
+
+	return &result{
+		value:  cluster,
+		header: []string{"FIELD", "VALUE"},
+		rows: [][]string{
+			{"name", format(cluster.Name)},
+			{"status", format(state.Status)},
+			{"versions", format(cluster.Versions)},
+			{"shared_cluster", format(cluster.SharedCluster)},
+			{"topic_prefix", format(cluster.TopicPrefix)},
+			{"topics", format(len(cluster.Topics))},
+			{"limits.max_topics", format(limits.MaxTopics)},
+			{"limits.max_partitions_per_topic", format(limits.MaxNumberOfPartitionsPerTopic)},
+			{"limits.max_total_partitions", format(limits.MaxNumberOfTotalPartitions)},
+			{"limits.min_replication", format(limits.MinimumReplication)},
+			{"limits.max_replication", format(limits.MaximumReplication)},
+			{"limits.min_retention_ms", format(limits.MinimumReplicationMS)},
+			{"limits.max_retention_ms", format(limits.MaximumReplicationMS)},
+			{"capabilities.supports_mixed_cleanup_policy", format(capabilities.SupportsMixedCleanupPolicy)},
+		},
+	}, nil
+}
+
+func runKafkaTopicsList(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	topics, _, err := client.Kafka.ListTopics(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res := &result{
+		value:  topics,
+		header: []string{"NAME", "STATUS", "PARTITIONS", "REPLICATION", "RETENTION_MS", "CLEANUP_POLICY"},
+	}
+	for _, t := range topics.Topics {
+		res.rows = append(res.rows, []string{format(t.Name), format(t.Status), format(t.Partitions),
+			format(t.ReplicationFactor), format(t.RetentionTimeInMS), format(t.CleanupPolicy)})
+	}
+
+	return res, nil
+}
+
+func runKafkaConsumerGroupsList(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	groups, _, err := client.Kafka.ListConsumerGroups(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res := &result{value: groups, header: []string{"NAME"}}
+	for _, g := range groups.ConsumerGroups {
+		res.rows = append(res.rows, []string{format(g.Name)})
+	}
+
+	return res, nil
+}
+
+func runPostgresSettingsGet(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	settings, _, err := client.Postgres.GetSettings(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res := &result{value: settings, header: []string{"SETTING", "VALUE", "DEFAULT"}}
+	if s := settings.LogConnections; s != nil {
+		res.rows = append(res.rows, []string{"log_connections", format(s.Value), format(s.Default)})
+	}
+	if s := settings.LogLockWaits; s != nil {
+		res.rows = append(res.rows, []string{"log_lock_waits", format(s.Value), format(s.Default)})
+	}
+	if s := settings.LogMinDurationStatement; s != nil {
+		res.rows = append(res.rows, []string{"log_min_duration_statement", format(s.Value), format(s.Default)})
+	}
+	if s := settings.LogStatement; s != nil {
+		res.rows = append(res.rows, []string{"log_statement", format(s.Value), format(s.Default)})
+	}
+
+	return res, nil
+}
+
+func runPostgresMTLSCertsList(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	certs, _, err := client.Postgres.ListMTLSCerts(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res := &result{value: certs, header: []string{"ID", "NAME", "STATUS", "CREATED_AT", "EXPIRES_AT"}}
+	for _, c := range certs {
+		res.rows = append(res.rows, []string{format(c.ID), format(c.Name), format(c.Status),
+			format(c.CreatedAt), format(c.ExpiresAt)})
+	}
+
+	return res, nil
+}
+
+func runMonitorsList(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	monitors, _, err := client.Metrics.ListMonitors(ctx, args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	res := &result{
+		value:  monitors,
+		header: []string{"ID", "NAME", "ACTION_TYPE", "ACTIVE", "VALUE", "MIN_QUANTITY", "MAX_QUANTITY"},
+	}
+	for _, m := range monitors {
+		res.rows = append(res.rows, []string{format(m.ID), format(m.Name), format(m.ActionType),
+			format(m.IsActive), format(m.Value), format(m.MinQuantity), format(m.MaxQuantity)})
+	}
+
+	return res, nil
+}
+
+func runSchedulerJobsList(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	jobs, _, err := client.Scheduler.List(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res := &result{value: jobs, header: []string{"ID", "COMMAND", "DYNO_SIZE", "EVERY", "AT", "RAN_AT"}}
+	for _, j := range jobs.Data {
+		attrs := j.GetAttributes()
+		if attrs == nil {
+			attrs = &scheduler.JobAttributes{}
+		}
+		res.rows = append(res.rows, []string{format(j.ID), format(attrs.Command), format(attrs.DynoSize),
+			format(attrs.Every), format(attrs.At), format(attrs.RanAt)})
+	}
+
+	return res, nil
+}
+
+func runDataclipsList(ctx context.Context, client *api.Client, _ []string) (*result, error) {
+	clips, _, err := client.Data.ListPostgresDataclips(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &result{value: clips, header: []string{"SLUG", "TITLE", "ADDON", "CREATOR", "EDITED_AT"}}
+	for _, c := range clips {
+		var addonName, creator *string
+		if c.Datasource != nil {
+			addonName = c.Datasource.AddonName
+		}
+		if c.Creator != nil {
+			creator = c.Creator.Email
+		}
+		res.rows = append(res.rows, []string{format(c.Slug), format(c.Title), format(addonName), format(creator),
+			format(c.EditedAt)})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,349 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/metrics"
+	"strings"
+)
+
+// webProcessType is the only process type with formation alerts and autoscaling.
+const webProcessType = "web"
+
+// generated returns the result of a generate command, which is HCL unless the output is JSON.
+func generated(resources []*resource) (*result, error) {
+	if len(resources) == 0 {
+		return &result{value: resources, text: "# No resources found.\n"}, nil
+	}
+
+	var b strings.Builder
+	if err := writeHCL(&b, resources); err != nil {
+		return nil, err
+	}
+
+	return &result{value: resources, text: b.String()}, nil
+}
+
+// ignoreNotFound returns nil if err is a 404, which means an optional feature, such as MTLS or Heroku Scheduler,
+// is not enabled.
+func ignoreNotFound(err error) error {
+	if errors.Is(err, api.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func runGenerateKafka(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	clusterID := args[0]
+	names := resourceNames{}
+	var resources []*resource
+
+	topics, _, err := client.Kafka.ListTopics(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range topics.Topics {
+		retentionTime := "disable"
+		if ms := t.GetRetentionTimeInMS(); ms != 0 {
+			if retentionTime, err = kafka.ConvertMillisecondsToDuration(ms); err != nil {
+				return nil, err
+			}
+		}
+
+		resources = append(resources, &resource{
+			Type:     "herokux_kafka_topic",
+			Name:     names.next("herokux_kafka_topic", t.GetName()),
+			ImportID: clusterID + ":" + t.GetName(),
+			Attributes: []*attribute{
+				{Name: "kafka_id", Value: clusterID},
+				{Name: "name", Value: t.GetName()},
+				{Name: "partitions", Value: t.GetPartitions()},
+				{Name: "replication_factor", Value: t.GetReplicationFactor()},
+				{Name: "retention_time", Value: retentionTime},
+				{Name: "cleanup_policy", Value: t.EffectiveCleanupPolicy().ToString()},
+			},
+		})
+	}
+
+	groups, _, err := client.Kafka.ListConsumerGroups(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups.ConsumerGroups {
+		resources = append(resources, &resource{
+			Type:     "herokux_kafka_consumer_group",
+			Name:     names.next("herokux_kafka_consumer_group", g.GetName()),
+			ImportID: clusterID + ":" + g.GetName(),
+			Attributes: []*attribute{
+				{Name: "kafka_id", Value: clusterID},
+				{Name: "name", Value: g.GetName()},
+			},
+		})
+	}
+
+	rules, _, err := client.Kafka.ListMTLSIPRules(ctx, clusterID)
+	if err = ignoreNotFound(err); err != nil {
+		return nil, err
+	}
+	for _, r := range rules {
+		resources = append(resources, ipRuleResource(names, "herokux_kafka_mtls_iprule", "kafka_id", clusterID,
+			r.GetCIDR(), r.GetDescription()))
+	}
+
+	return generated(resources)
+}
+
+func runGenerateApp(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	appID := args[0]
+	names := resourceNames{}
+	var resources []*resource
+
+	jobs, _, err := client.Scheduler.List(ctx, appID)
+	if err = ignoreNotFound(err); err != nil {
+		return nil, err
+	}
+	if jobs != nil {
+		for _, j := range jobs.Data {
+			attrs := j.GetAttributes()
+			freq, freqErr := frequency(attrs.GetEvery(), attrs.GetAt())
+			if freqErr != nil {
+				return nil, fmt.Errorf("scheduler job %s: %w", j.GetID(), freqErr)
+			}
+
+			resources = append(resources, &resource{
+				Type:     "herokux_scheduler_job",
+				Name:     names.next("herokux_scheduler_job", attrs.GetCommand()),
+				ImportID: appID + ":" + j.GetID(),
+				Attributes: []*attribute{
+					{Name: "app_id", Value: appID},
+					{Name: "command", Value: attrs.GetCommand()},
+					{Name: "dyno_size", Value: attrs.GetDynoSize()},
+					{Name: "frequency", Value: freq},
+				},
+			})
+		}
+	}
+
+	webhooks, _, err := client.Platform.ListAppWebhooks(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range webhooks {
+		eventTypes := make([]string, 0, len(w.EventTypes))
+		for _, t := range w.EventTypes {
+			eventTypes = append(eventTypes, string(t))
+		}
+
+		attributes := []*attribute{
+			{Name: "app_id", Value: w.GetApp().GetID()},
+			{Name: "level", Value: format(w.Level)},
+			{Name: "url", Value: w.GetURL()},
+			{Name: "event_types", Value: eventTypes},
+		}
+		if w.GetName() != "" {
+			attributes = append(attributes, &attribute{Name: "name", Value: w.GetName()})
+		}
+
+		resources = append(resources, &resource{
+			Type:       "herokux_app_webhook",
+			Name:       names.next("herokux_app_webhook", w.GetName()),
+			ImportID:   w.GetApp().GetID() + ":" + w.GetID(),
+			Attributes: attributes,
+		})
+	}
+
+	monitors, _, err := client.Metrics.ListMonitors(ctx, appID, webProcessType)
+	if err = ignoreNotFound(err); err != nil {
+		return nil, err
+	}
+	for _, m := range monitors {
+		if r := monitorResource(names, appID, m); r != nil {
+			resources = append(resources, r)
+		}
+	}
+
+	return generated(resources)
+}
+
+// monitorResource returns the formation alert or autoscaling resource of a monitor, or nil if the provider has none.
+func monitorResource(names resourceNames, appID string, m *metrics.FormationMonitor) *resource {
+	channels := m.NotificationChannels
+	if channels == nil {
+		channels = []string{}
+	}
+
+	var name metrics.FormationMonitorName
+	if m.Name != nil {
+		name = *m.Name
+	}
+
+	switch name {
+	case metrics.FormationMonitorNames.Latency, metrics.FormationMonitorNames.ErrorRate:
+		return &resource{
+			Type:     "herokux_formation_alert",
+			Name:     names.next("herokux_formation_alert", webProcessType+"_"+name.ToString()),
+			ImportID: fmt.Sprintf("%s:%s:%s", appID, webProcessType, name),
+			Attributes: []*attribute{
+				{Name: "app_id", Value: appID},
+				{Name: "process_type", Value: webProcessType},
+				{Name: "name", Value: name.ToString()},
+				{Name: "threshold", Value: m.GetValue().String()},
+				{Name: "sensitivity", Value: m.GetPeriod()},
+				{Name: "is_active", Value: m.GetIsActive()},
+				{Name: "notification_channels", Value: channels},
+				{Name: "notification_frequency", Value: m.GetNotificationPeriod()},
+			},
+		}
+	case metrics.FormationMonitorNames.LatencyScale:
+		p95, _ := m.GetValue().Int64()
+
+		return &resource{
+			Type:     "herokux_formation_autoscaling",
+			Name:     names.next("herokux_formation_autoscaling", webProcessType),
+			ImportID: appID + ":" + webProcessType,
+			Attributes: []*attribute{
+				{Name: "app_id", Value: appID},
+				{Name: "process_type", Value: webProcessType},
+				{Name: "is_active", Value: m.GetIsActive()},
+				{Name: "min_quantity", Value: m.GetMinQuantity()},
+				{Name: "max_quantity", Value: m.GetMaxQuantity()},
+				{Name: "desired_p95_response_time", Value: int(p95)},
+				{Name: "dyno_size", Value: nil, Comment: "Set to the dyno size of the formation, which the metrics API does not return."},
+				{Name: "notification_channels", Value: channels},
+				{Name: "notification_period", Value: m.GetNotificationPeriod()},
+			},
+		}
+	}
+
+	return nil
+}
+
+func runGeneratePostgres(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	database := args[0]
+	names := resourceNames{}
+	var resources []*resource
+
+	schedules, _, err := client.Postgres.ListBackupSchedules(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range schedules {
+		hour, _ := s.GetHour().Int64()
+
+		resources = append(resources, &resource{
+			Type:     "herokux_postgres_backup_schedule",
+			Name:     names.next("herokux_postgres_backup_schedule", database),
+			ImportID: database,
+			Attributes: []*attribute{
+				{Name: "postgres_id", Value: database},
+				{Name: "hour", Value: int(hour)},
+				{Name: "timezone", Value: s.GetTimezone()},
+			},
+		})
+	}
+
+	credentials, _, err := client.Postgres.ListCredentials(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range credentials {
+		// The default credential cannot be imported as it cannot be destroyed.
+		if strings.EqualFold(c.GetName(), "default") {
+			continue
+		}
+
+		resources = append(resources, &resource{
+			Type:     "herokux_postgres_credential",
+			Name:     names.next("herokux_postgres_credential", c.GetName()),
+			ImportID: database + ":" + c.GetName(),
+			Attributes: []*attribute{
+				{Name: "postgres_id", Value: database},
+				{Name: "name", Value: c.GetName()},
+			},
+		})
+	}
+
+	rules, _, err := client.Postgres.ListMTLSIPRules(ctx, database)
+	if err = ignoreNotFound(err); err != nil {
+		return nil, err
+	}
+	for _, r := range rules {
+		resources = append(resources, ipRuleResource(names, "herokux_postgres_mtls_iprule", "database_name", database,
+			r.GetCIDR(), r.GetDescription()))
+	}
+
+	return generated(resources)
+}
+
+func runGenerateConnect(ctx context.Context, client *api.Client, args []string) (*result, error) {
+	appID, connectID := args[0], args[1]
+
+	baseURL, err := client.Connect.RegionalBaseURL(ctx, appID, connectID)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings, _, err := client.Connect.ExportMappings(ctx, baseURL, connectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var exported map[string]interface{}
+	if mappings != nil {
+		exported = *mappings
+	}
+
+	return generated([]*resource{
+		{
+			Type:     "herokux_connect_mappings",
+			Name:     resourceNames{}.next("herokux_connect_mappings", "mappings"),
+			ImportID: appID + ":" + connectID,
+			Attributes: []*attribute{
+				{Name: "app_id", Value: appID},
+				{Name: "connect_id", Value: connectID},
+				{Name: "mappings", Value: &call{Func: "jsonencode", Arg: exported}},
+			},
+		},
+	})
+}
+
+// ipRuleResource returns a Kafka or Postgres MTLS IP rule resource, which are both imported by parent ID and CIDR.
+func ipRuleResource(names resourceNames, resourceType, parentAttr, parentID, cidr, description string) *resource {
+	label := "cidr_" + cidr
+	if description != "" {
+		label = description
+	}
+
+	attributes := []*attribute{
+		{Name: parentAttr, Value: parentID},
+		{Name: "cidr", Value: cidr},
+	}
+	if description != "" {
+		attributes = append(attributes, &attribute{Name: "description", Value: description})
+	}
+
+	return &resource{
+		Type:       resourceType,
+		Name:       names.next(resourceType, label),
+		ImportID:   parentID + ":" + cidr,
+		Attributes: attributes,
+	}
+}
+
+// frequency converts the every and at values of a scheduler job to the frequency attribute
+// of the herokux_scheduler_job resource.
+func frequency(every, at int) (string, error) {
+	switch every {
+	case 10:
+		return "every_ten_minutes", nil
+	case 60:
+		return fmt.Sprintf("every_hour_at_%d", at), nil
+	case 1440:
+		return fmt.Sprintf("every_day_at_%d:%02d", at/60, at%60), nil
+	}
+
+	return "", fmt.Errorf("unable to convert every (%d) and at (%d) to a frequency", every, at)
+}
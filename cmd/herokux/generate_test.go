@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/metrics"
+	"github.com/davidji99/terraform-provider-herokux/api/mock"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	"github.com/davidji99/terraform-provider-herokux/api/platform"
+	"github.com/davidji99/terraform-provider-herokux/api/scheduler"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRun_GenerateKafka(t *testing.T) {
+	server := newTestServer(t)
+	server.AddKafkaCluster("cluster", nil)
+
+	client, err := newClient(server.Options()...)
+	assert.Nil(t, err)
+
+	retention := 86400000
+	_, _, err = client.Kafka.CreateTopic(context.Background(), "cluster",
+		&kafka.TopicRequest{Name: "user.events", Partitions: 8, ReplicationFactor: 3, RetentionTimeMS: &retention})
+	assert.Nil(t, err)
+	_, _, err = client.Kafka.CreateConsumerGroup(context.Background(), "cluster", &kafka.ConsumerGroupRequest{Name: "workers"})
+	assert.Nil(t, err)
+
+	code, stdout, stderr := runCommand(server, "generate", "kafka", "cluster")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, `import {
+  to = herokux_kafka_topic.user_events
+  id = "cluster:user.events"
+}
+
+resource "herokux_kafka_topic" "user_events" {
+  kafka_id           = "cluster"
+  name               = "user.events"
+  partitions         = 8
+  replication_factor = 3
+  retention_time     = "1d"
+  cleanup_policy     = "delete"
+}
+
+import {
+  to = herokux_kafka_consumer_group.workers
+  id = "cluster:workers"
+}
+
+resource "herokux_kafka_consumer_group" "workers" {
+  kafka_id = "cluster"
+  name     = "workers"
+}
+`, stdout)
+
+	code, stdout, stderr = runCommand(server, "generate", "kafka", "cluster", "-o", "json")
+	assert.Equal(t, 0, code, stderr)
+
+	var resources []*resource
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &resources))
+	assert.Len(t, resources, 2)
+	assert.Equal(t, "cluster:workers", resources[1].ImportID)
+}
+
+func TestRunGenerateApp(t *testing.T) {
+	var jobs *scheduler.Jobs
+	var webhooks []*platform.AppWebhook
+	var monitors []*metrics.FormationMonitor
+	assert.Nil(t, json.Unmarshal([]byte(`{"data": [
+		{"id": "job", "attributes": {"command": "rake cleanup", "dyno-size": "Standard-1X", "every": 1440, "at": 90}}
+	]}`), &jobs))
+	assert.Nil(t, json.Unmarshal([]byte(`[
+		{"id": "hook", "app": {"id": "app-uuid"}, "level": "notify", "url": "https://example.com/${x}", "include": ["api:release"]}
+	]`), &webhooks))
+	assert.Nil(t, json.Unmarshal([]byte(`[
+		{"id": "m1", "name": "LATENCY", "value": 1000, "period": 5, "is_active": true, "notification_period": 60},
+		{"id": "m2", "name": "LATENCY_SCALE", "value": 500, "is_active": false, "min_quantity": 1, "max_quantity": 4,
+			"notification_channels": ["app"]}
+	]`), &monitors))
+
+	client := &api.Client{
+		Scheduler: &mock.SchedulerService{
+			ListFunc: func(ctx context.Context, appID string) (*scheduler.Jobs, *simpleresty.Response, error) {
+				return jobs, nil, nil
+			},
+		},
+		Platform: &mock.PlatformService{
+			ListAppWebhooksFunc: func(ctx context.Context, appID string) ([]*platform.AppWebhook, *simpleresty.Response, error) {
+				return webhooks, nil, nil
+			},
+		},
+		Metrics: &mock.MetricsService{
+			ListMonitorsFunc: func(ctx context.Context, appID, formationName string) ([]*metrics.FormationMonitor, *simpleresty.Response, error) {
+				assert.Equal(t, "web", formationName)
+				return monitors, nil, nil
+			},
+		},
+	}
+
+	res, err := runGenerateApp(context.Background(), client, []string{"app-uuid"})
+	assert.Nil(t, err)
+
+	for _, block := range []string{
+		"  to = herokux_scheduler_job.rake_cleanup\n  id = \"app-uuid:job\"\n",
+		"  frequency = \"every_day_at_1:30\"\n",
+		"  to = herokux_app_webhook.app_webhook\n  id = \"app-uuid:hook\"\n",
+		"  url         = \"https://example.com/$${x}\"\n",
+		"  event_types = [\n    \"api:release\",\n  ]\n",
+		"  to = herokux_formation_alert.web_latency\n  id = \"app-uuid:web:LATENCY\"\n",
+		"  threshold              = \"1000\"\n",
+		"  to = herokux_formation_autoscaling.web\n  id = \"app-uuid:web\"\n",
+		"  desired_p95_response_time = 500\n",
+		"  dyno_size                 = null\n",
+	} {
+		assert.Contains(t, res.text, block)
+	}
+}
+
+func TestRunGenerateApp_SchedulerNotInstalled(t *testing.T) {
+	client := &api.Client{
+		Scheduler: &mock.SchedulerService{
+			ListFunc: func(ctx context.Context, appID string) (*scheduler.Jobs, *simpleresty.Response, error) {
+				return nil, nil, &apierror.Error{StatusCode: http.StatusNotFound}
+			},
+		},
+		Platform: &mock.PlatformService{
+			ListAppWebhooksFunc: func(ctx context.Context, appID string) ([]*platform.AppWebhook, *simpleresty.Response, error) {
+				return nil, nil, nil
+			},
+		},
+		Metrics: &mock.MetricsService{
+			ListMonitorsFunc: func(ctx context.Context, appID, formationName string) ([]*metrics.FormationMonitor, *simpleresty.Response, error) {
+				return nil, nil, nil
+			},
+		},
+	}
+
+	res, err := runGenerateApp(context.Background(), client, []string{"app-uuid"})
+	assert.Nil(t, err)
+	assert.Equal(t, "# No resources found.\n", res.text)
+}
+
+func TestWriteValue(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{name: "escapes", value: "a \"b\" \\ ${c} %{d} $e\n", expected: `"a \"b\" \\ $${c} %%{d} $e\n"`},
+		{name: "null", value: nil, expected: "null"},
+		{name: "empty list", value: []string{}, expected: "[]"},
+		{
+			name:     "jsonencode",
+			value:    &call{Func: "jsonencode", Arg: map[string]interface{}{"b": []interface{}{1.5, true}, "a": "x"}},
+			expected: "jsonencode({\n  \"a\" = \"x\"\n  \"b\" = [\n    1.5,\n    true,\n  ]\n})",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var b strings.Builder
+			writeValue(&b, tc.value, "")
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+}
+
+func TestResourceNames(t *testing.T) {
+	names := resourceNames{}
+
+	assert.Equal(t, "orders_created", names.next("herokux_kafka_topic", "Orders.Created"))
+	assert.Equal(t, "orders_created_2", names.next("herokux_kafka_topic", "orders created"))
+	assert.Equal(t, "orders_created", names.next("herokux_kafka_consumer_group", "orders.created"))
+	assert.Equal(t, "_10_0_0_0_16", names.next("herokux_kafka_mtls_iprule", "10.0.0.0/16"))
+	assert.Equal(t, "kafka_topic", names.next("herokux_kafka_topic", "..."))
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// resource is a generated herokux resource with the ID that imports it into state.
+type resource struct {
+	Type       string       `json:"type"`
+	Name       string       `json:"name"`
+	ImportID   string       `json:"import_id"`
+	Attributes []*attribute `json:"attributes"`
+}
+
+// attribute is a resource argument. Value is a string, bool, int, json.Number, []string, []interface{},
+// map[string]interface{}, *call or nil. Comment, if set, is written on the line above the argument.
+type attribute struct {
+	Name    string      `json:"name"`
+	Value   interface{} `json:"value"`
+	Comment string      `json:"comment,omitempty"`
+}
+
+// call is a function call expression with a single argument, such as jsonencode.
+type call struct {
+	Func string      `json:"func"`
+	Arg  interface{} `json:"arg"`
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// resourceNames returns unique resource names of a resource type. The zero value is ready to use.
+type resourceNames map[string]int
+
+// next converts label to a valid resource name, appending a number if the type already has a resource named so.
+func (n resourceNames) next(resourceType, label string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(label), "_"), "_")
+	if name == "" {
+		name = strings.TrimPrefix(resourceType, "herokux_")
+	}
+	if name[0] >= '0' && name[0] <= '9' || name[0] == '-' {
+		name = "_" + name
+	}
+
+	key := resourceType + "." + name
+	n[key]++
+	if count := n[key]; count > 1 {
+		name = fmt.Sprintf("%s_%d", name, count)
+	}
+
+	return name
+}
+
+// writeHCL writes an import block and a resource block for each resource, formatted as terraform fmt would.
+func writeHCL(w io.Writer, resources []*resource) error {
+	var b strings.Builder
+	for i, r := range resources {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(&b, "import {\n  to = %s.%s\n  id = %s\n}\n\n", r.Type, r.Name, quote(r.ImportID))
+		fmt.Fprintf(&b, "resource %s %s {\n", quote(r.Type), quote(r.Name))
+
+		width := 0
+		for _, a := range r.Attributes {
+			width = max(width, len(a.Name))
+		}
+		for _, a := range r.Attributes {
+			if a.Comment != "" {
+				fmt.Fprintf(&b, "  # %s\n", a.Comment)
+			}
+			fmt.Fprintf(&b, "  %-*s = ", width, a.Name)
+			writeValue(&b, a.Value, "  ")
+			b.WriteString("\n")
+		}
+
+		b.WriteString("}\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeValue writes v as an HCL expression. Indent is the indentation of the line v starts on.
+func writeValue(b *strings.Builder, v interface{}, indent string) {
+	switch value := v.(type) {
+	case nil:
+		b.WriteString("null")
+	case string:
+		b.WriteString(quote(value))
+	case bool:
+		b.WriteString(strconv.FormatBool(value))
+	case int:
+		b.WriteString(strconv.Itoa(value))
+	case float64:
+		b.WriteString(strconv.FormatFloat(value, 'f', -1, 64))
+	case json.Number:
+		b.WriteString(value.String())
+	case []string:
+		items := make([]interface{}, 0, len(value))
+		for _, s := range value {
+			items = append(items, s)
+		}
+		writeValue(b, items, indent)
+	case []interface{}:
+		if len(value) == 0 {
+			b.WriteString("[]")
+			return
+		}
+		b.WriteString("[\n")
+		for _, item := range value {
+			b.WriteString(indent + "  ")
+			writeValue(b, item, indent+"  ")
+			b.WriteString(",\n")
+		}
+		b.WriteString(indent + "]")
+	case map[string]interface{}:
+		if len(value) == 0 {
+			b.WriteString("{}")
+			return
+		}
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b.WriteString("{\n")
+		for _, k := range keys {
+			b.WriteString(indent + "  " + quote(k) + " = ")
+			writeValue(b, value[k], indent+"  ")
+			b.WriteString("\n")
+		}
+		b.WriteString(indent + "}")
+	case *call:
+		b.WriteString(value.Func + "(")
+		writeValue(b, value.Arg, indent)
+		b.WriteString(")")
+	default:
+		b.WriteString(quote(fmt.Sprint(value)))
+	}
+}
+
+// quote returns s as an HCL string literal. Template sequences are escaped so the value is taken literally.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$', '%':
+			b.WriteByte(c)
+			if i+1 < len(s) && s[i+1] == '{' {
+				b.WriteByte(c)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
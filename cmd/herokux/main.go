@@ -0,0 +1,148 @@
+// Command herokux inspects Heroku resources that the Heroku CLI does not show, such as Kafka cluster limits,
+// formation monitors and Postgres settings, using the same api client as the Terraform provider.
+//
+// The generate commands print herokux resource blocks and Terraform 1.5+ import blocks for the existing resources
+// of a Kafka cluster, app, Postgres database or Heroku Connect connection, so they can be adopted with terraform plan
+// and apply instead of one terraform import command per resource.
+//
+// Usage:
+//
+//	herokux [-o table|json] [-timeout 1m] <command> [<subcommand>...] [args...]
+//
+// The API token is read from the HEROKU_API_KEY environment variable, or else from the api.heroku.com machine
+// in the netrc file, which is NETRC_PATH if set or ~/.netrc otherwise.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/version"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+)
+
+// UserAgent is the user agent of every API request made by the CLI.
+var UserAgent = fmt.Sprintf("herokux-cli/v%s", version.ProviderVersion)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit code. Additional client options, such as the base URLs
+// of a fake server in tests, are applied after the defaults.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer, opts ...config.Option) int {
+	fs := flag.NewFlagSet("herokux", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	output := fs.String("o", outputTable, "output format: table or json")
+	timeout := fs.Duration("timeout", time.Minute, "maximum duration of the command")
+	fs.Usage = func() { printUsage(stderr, fs) }
+
+	positional, parseErr := parseInterspersed(fs, args)
+	if parseErr != nil {
+		if errors.Is(parseErr, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	if *output != outputTable && *output != outputJSON {
+		fmt.Fprintf(stderr, "herokux: unsupported output format %q\n", *output)
+		return 2
+	}
+
+	cmd, n := commands.find(positional)
+	path, cmdArgs := positional[:n], positional[n:]
+	if cmd.run == nil {
+		if len(cmdArgs) > 0 {
+			fmt.Fprintf(stderr, "herokux: unknown command %q\n\n", strings.Join(positional, " "))
+		}
+		printCommandUsage(stderr, cmd, path)
+		return 2
+	}
+
+	if len(cmdArgs) != len(cmd.args) {
+		printCommandUsage(stderr, cmd, path)
+		return 2
+	}
+
+	client, clientErr := newClient(opts...)
+	if clientErr != nil {
+		fmt.Fprintf(stderr, "herokux: %s\n", clientErr)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	res, runErr := cmd.run(ctx, client, cmdArgs)
+	if runErr != nil {
+		fmt.Fprintf(stderr, "herokux: %s\n", runErr)
+		return 1
+	}
+
+	if writeErr := res.write(stdout, *output); writeErr != nil {
+		fmt.Fprintf(stderr, "herokux: %s\n", writeErr)
+		return 1
+	}
+
+	return 0
+}
+
+// newClient constructs an api client authenticated the same way as the provider when it has no api_key set.
+func newClient(opts ...config.Option) (*api.Client, error) {
+	ts, tokenErr := config.DefaultTokenSource()
+	if tokenErr != nil {
+		return nil, tokenErr
+	}
+
+	return api.New(append([]config.Option{
+		config.APITokenSource(ts),
+		config.UserAgent(UserAgent),
+	}, opts...)...)
+}
+
+// parseInterspersed parses flags that appear anywhere in args, such as after the command,
+// and returns the remaining positional arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func printUsage(w io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintf(w, "Usage: herokux [flags] <command> [args...]\n\nCommands:\n")
+	commands.printTree(w, nil)
+	fmt.Fprintf(w, "\nFlags:\n")
+	fs.PrintDefaults()
+}
+
+func printCommandUsage(w io.Writer, cmd *command, path []string) {
+	prefix := strings.Join(append([]string{"herokux"}, path...), " ")
+	if cmd.run != nil {
+		fmt.Fprintf(w, "Usage: %s\n\n%s\n", strings.Join(append([]string{prefix}, cmd.args...), " "), cmd.summary)
+		return
+	}
+
+	fmt.Fprintf(w, "Usage: %s <command> [args...]\n\nCommands:\n", prefix)
+	cmd.printTree(w, path)
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/davidji99/terraform-provider-herokux/api/fake"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/scheduler"
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestServer(t *testing.T) *fake.Server {
+	t.Setenv(config.APIKeyEnvVar, "token")
+
+	server := fake.NewServer(fake.PendingReads(0))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func runCommand(server *fake.Server, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, &stdout, &stderr, append(server.Options(), config.MaxRetries(0))...)
+
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_KafkaClusterGet(t *testing.T) {
+	server := newTestServer(t)
+	server.AddKafkaCluster("cluster", nil)
+
+	code, stdout, stderr := runCommand(server, "kafka", "cluster", "get", "cluster")
+	assert.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `limits\.max_partitions_per_topic\s+256`, stdout)
+	assert.Regexp(t, `capabilities\.supports_mixed_cleanup_policy\s+true`, stdout)
+}
+
+func TestRun_KafkaTopicsList_JSON(t *testing.T) {
+	server := newTestServer(t)
+	server.AddKafkaCluster("cluster", nil)
+
+	retention := 86400000
+	opts := &kafka.TopicRequest{Name: "events", Partitions: 8, ReplicationFactor: 3, RetentionTimeMS: &retention}
+	client, err := newClient(server.Options()...)
+	assert.Nil(t, err)
+	_, _, err = client.Kafka.CreateTopic(context.Background(), "cluster", opts)
+	assert.Nil(t, err)
+
+	// Flags are accepted after the command.
+	code, stdout, stderr := runCommand(server, "kafka", "topics", "ls", "cluster", "-o", "json")
+	assert.Equal(t, 0, code, stderr)
+
+	var topics kafka.Topics
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &topics))
+	assert.Len(t, topics.Topics, 1)
+	assert.Equal(t, "events", topics.Topics[0].GetName())
+	assert.Equal(t, 8, topics.Topics[0].GetPartitions())
+}
+
+func TestRun_SchedulerJobsList(t *testing.T) {
+	server := newTestServer(t)
+
+	client, err := newClient(server.Options()...)
+	assert.Nil(t, err)
+	_, _, err = client.Scheduler.Create(context.Background(), "app",
+		&scheduler.JobRequest{Command: "rake cleanup", DynoSize: "Standard-1X", Every: 60, At: 30})
+	assert.Nil(t, err)
+
+	code, stdout, stderr := runCommand(server, "scheduler", "jobs", "ls", "app")
+	assert.Equal(t, 0, code, stderr)
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Len(t, lines, 2)
+	assert.Regexp(t, `^ID\s+COMMAND\s+DYNO_SIZE`, lines[0])
+	assert.Regexp(t, `rake cleanup\s+Standard-1X\s+60\s+30`, lines[1])
+}
+
+func TestRun_Errors(t *testing.T) {
+	server := newTestServer(t)
+
+	testCases := []struct {
+		name   string
+		args   []string
+		code   int
+		stderr string
+	}{
+		{name: "unknown command", args: []string{"kafka", "brokers"}, code: 2, stderr: `unknown command "kafka brokers"`},
+		{name: "missing argument", args: []string{"kafka", "topics", "ls"}, code: 2, stderr: "Usage: herokux kafka topics ls CLUSTER_ID"},
+		{name: "unsupported output", args: []string{"-o", "yaml", "dataclips", "ls"}, code: 2, stderr: "unsupported output format"},
+		{name: "not found", args: []string{"kafka", "cluster", "get", "missing"}, code: 1, stderr: "herokux: "},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, _, stderr := runCommand(server, tc.args...)
+			assert.Equal(t, tc.code, code)
+			assert.Contains(t, stderr, tc.stderr)
+		})
+	}
+}
+
+func TestRun_NoToken(t *testing.T) {
+	server := newTestServer(t)
+	t.Setenv(config.APIKeyEnvVar, "")
+	t.Setenv(config.NetrcPathEnvVar, filepath.Join(t.TempDir(), ".netrc"))
+
+	code, _, stderr := runCommand(server, "dataclips", "ls")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "no API token found")
+}
+
+func TestFormat(t *testing.T) {
+	partitions := 8
+	var missing *string
+
+	assert.Equal(t, "8", format(&partitions))
+	assert.Equal(t, "", format(missing))
+	assert.Equal(t, "", format(nil))
+	assert.Equal(t, "2.4,3.0", format([]string{"2.4", "3.0"}))
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Output formats.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// result is the output of a command. The JSON output is the api value as is,
+// whereas the table output is a summary of the most useful fields, or text if set.
+type result struct {
+	value  interface{}
+	header []string
+	rows   [][]string
+	text   string
+}
+
+func (r *result) write(w io.Writer, output string) error {
+	if output == outputJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r.value)
+	}
+
+	if r.text != "" {
+		_, err := io.WriteString(w, r.text)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(r.header, "\t"))
+	for _, row := range r.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// format returns v as a table cell. Pointers are dereferenced, nil is empty, and slices are comma separated.
+func format(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return ""
+		}
+		rv = rv.Elem()
+	}
+
+	if !rv.IsValid() {
+		return ""
+	}
+
+	switch value := rv.Interface().(type) {
+	case time.Time:
+		return value.Format(time.RFC3339)
+	case json.Number:
+		return value.String()
+	}
+
+	if rv.Kind() == reflect.Slice {
+		items := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			items = append(items, format(rv.Index(i).Interface()))
+		}
+		return strings.Join(items, ",")
+	}
+
+	return fmt.Sprint(rv.Interface())
+}
@@ -60,15 +60,50 @@ func (k TestConfigKey) String() (name string) {
 	return
 }
 
-type TestConfig struct{}
+// secretTestConfigKeys are never written to a cassette.
+var secretTestConfigKeys = map[TestConfigKey]bool{
+	TestConfigHerokuxAPIKey:       true,
+	TestConfigHerokuxCustomAPIKey: true,
+}
+
+// liveTestConfigKeys are always read from the environment, even when replaying a cassette.
+var liveTestConfigKeys = map[TestConfigKey]bool{
+	TestConfigRunE2ETests:       true,
+	TestConfigAcceptanceTestKey: true,
+}
+
+type TestConfig struct {
+	vcr *VCR
+}
 
 func NewTestConfig() *TestConfig {
-	return &TestConfig{}
+	return &TestConfig{vcr: NewVCR(DefaultCassetteDir)}
+}
+
+// VCR returns the VCR used to record and replay HTTP interactions.
+func (t *TestConfig) VCR() *VCR {
+	return t.vcr
+}
+
+// RandString returns a random string of length n for the resource names of testing. The VCR is started first,
+// so the string is the same when the test is replayed as when it was recorded.
+func (t *TestConfig) RandString(testing *testing.T, n int) string {
+	t.vcr.Start(testing)
+	return t.vcr.RandString(n)
+}
+
+// RandIntRange returns a random integer between min (inclusive) and max (exclusive) the same way as RandString.
+func (t *TestConfig) RandIntRange(testing *testing.T, min, max int) int {
+	t.vcr.Start(testing)
+	return t.vcr.RandIntRange(min, max)
 }
 
 func (t *TestConfig) Get(keys ...TestConfigKey) (val string) {
 	for _, key := range keys {
 		val = os.Getenv(key.String())
+		if !liveTestConfigKeys[key] {
+			val, _ = t.vcr.Env(key.String(), val, secretTestConfigKeys[key])
+		}
 		if val != "" {
 			break
 		}
@@ -78,6 +113,7 @@ func (t *TestConfig) Get(keys ...TestConfigKey) (val string) {
 
 func (t *TestConfig) GetOrSkip(testing *testing.T, keys ...TestConfigKey) (val string) {
 	t.SkipUnlessAccTest(testing)
+	t.vcr.Start(testing)
 	val = t.Get(keys...)
 	if val == "" {
 		testing.Skipf("skipping test: config %v not set", keys)
@@ -87,6 +123,7 @@ func (t *TestConfig) GetOrSkip(testing *testing.T, keys ...TestConfigKey) (val s
 
 func (t *TestConfig) GetOrAbort(testing *testing.T, keys ...TestConfigKey) (val string) {
 	t.SkipUnlessAccTest(testing)
+	t.vcr.Start(testing)
 	val = t.Get(keys...)
 	if val == "" {
 		testing.Fatalf("stopping test: config %v must be set", keys)
@@ -2,46 +2,24 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/bgentry/go-netrc/netrc"
 	heroku "github.com/davidji99/heroku-go/v5"
 	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/retry"
 	"github.com/davidji99/terraform-provider-herokux/version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/mitchellh/go-homedir"
 	"log"
 	"net/http"
-	"net/url"
-	"os"
-	"runtime"
+	"time"
 )
 
 const (
-	DefaultMTLSProvisionVerifyTimeout                    = int64(10)
-	DefaultMTLSMTLSDeprovisionVerifyTimeout              = int64(10)
-	DefaultMTLSIPRuleCreateVerifyTimeout                 = int64(20)
-	DefaultMTLSCertificateCreateVerifyTimeout            = int64(10)
-	DefaultMTLSCertificateDeleteVerifyTimeout            = int64(10)
-	DefaultKafkaCGCreateVerifyTimeout                    = int64(10)
-	DefaultKafkaCGDeleteVerifyTimeout                    = int64(10)
-	DefaultKafkaTopicCreateVerifyTimeout                 = int64(10)
-	DefaultKafkaTopicUpdateVerifyTimeout                 = int64(10)
-	DefaultPrivatelinkCreateVerifyTimeout                = int64(15)
-	DefaultPrivatelinkDeleteVerifyTimeout                = int64(15)
-	DefaultPrivatelinkAllowedAccountsAddVerifyTimeout    = int64(10)
-	DefaultPrivatelinkAllowedAccountsRemoveVerifyTimeout = int64(10)
-	DefaultDataConnectorCreateVerifyTimeout              = int64(20)
-	DefaultDataConnectorSettingsUpdateVerifyTimeout      = int64(10)
-	DefaultDataConnectorDeleteVerifyTimeout              = int64(10)
-	DefaultDataConnectorStatusUpdateVerifyTimeout        = int64(10)
-	DefaultPostgresCredentialPreCreateVerifyTimeout      = int64(45)
-	DefaultPostgresCredentialCreateVerifyTimeout         = int64(10)
-	DefaultPostgresCredentialDeleteVerifyTimeout         = int64(10)
-	DefaultPrivateSpaceCreateVerifyTimeout               = int64(20)
-	DefaultAppContainerReleaseVerifyTimeout              = int64(20)
-
 	DefaultPostgresSettingsModifyDelay = int64(2)
 	DefaultConnectMappingModifyDelay   = int64(15)
+
+	DefaultRetryMaxRetries = retry.DefaultMaxRetries
+	DefaultRetryMinWait    = int64(retry.DefaultWaitMin / time.Second)
+	DefaultRetryMaxWait    = int64(retry.DefaultWaitMax / time.Second)
 )
 
 var (
@@ -51,21 +29,21 @@ var (
 type Config struct {
 	API         *api.Client
 	PlatformAPI *heroku.Service
-	token       string
+	tokenSource config.TokenSource
 	Headers     map[string]string
 
-	// Custom API URLs
-	platformURL       string
-	metricsURL        string
-	postgresURL       string
-	dataURL           string
-	redisURL          string
-	connectCentralURL string
-	registryURL       string
-	kolkrabbiURL      string
-	schedulerURL      string
-
-	// Custom Timeouts
+	// Transport, if set, is used for all HTTP requests instead of the default transports.
+	// Acceptance tests use this to record and replay HTTP interactions.
+	Transport http.RoundTripper
+
+	// endpoints are the custom API base URLs keyed by endpoint name.
+	endpoints map[string]string
+
+	// kafkaTopicPlans are the topics planned by herokux_kafka_topic resources, used to validate cluster limits.
+	kafkaTopicPlans kafkaTopicPlans
+
+	// Custom Timeouts, which are deprecated in favor of the timeouts block of each resource.
+	// Zero means not set.
 	MTLSProvisionVerifyTimeout                    int64
 	MTLSDeprovisionVerifyTimeout                  int64
 	MTLSIPRuleCreateVerifyTimeout                 int64
@@ -92,53 +70,48 @@ type Config struct {
 	// Custom Delays
 	PostgresSettingsModifyDelay int64
 	ConnectMappingModifyDelay   int64
+
+	// Custom Retries
+	RetryMaxRetries int
+	RetryMinWait    int64
+	RetryMaxWait    int64
 }
 
 func NewConfig() *Config {
 	c := &Config{
-		MTLSProvisionVerifyTimeout:                    DefaultMTLSProvisionVerifyTimeout,
-		MTLSDeprovisionVerifyTimeout:                  DefaultMTLSMTLSDeprovisionVerifyTimeout,
-		MTLSIPRuleCreateVerifyTimeout:                 DefaultMTLSIPRuleCreateVerifyTimeout,
-		MTLSCertificateCreateVerifyTimeout:            DefaultMTLSCertificateCreateVerifyTimeout,
-		MTLSCertificateDeleteVerifyTimeout:            DefaultMTLSCertificateDeleteVerifyTimeout,
-		KafkaCGCreateVerifyTimeout:                    DefaultKafkaCGCreateVerifyTimeout,
-		KafkaCGDeleteVerifyTimeout:                    DefaultKafkaCGDeleteVerifyTimeout,
-		KafkaTopicCreateVerifyTimeout:                 DefaultKafkaTopicCreateVerifyTimeout,
-		KafkaTopicUpdateVerifyTimeout:                 DefaultKafkaTopicUpdateVerifyTimeout,
-		PrivatelinkCreateVerifyTimeout:                DefaultPrivatelinkCreateVerifyTimeout,
-		PrivatelinkDeleteVerifyTimeout:                DefaultPrivatelinkDeleteVerifyTimeout,
-		PrivatelinkAllowedAccountsAddVerifyTimeout:    DefaultPrivatelinkAllowedAccountsAddVerifyTimeout,
-		PrivatelinkAllowedAccountsRemoveVerifyTimeout: DefaultPrivatelinkAllowedAccountsRemoveVerifyTimeout,
-		DataConnectorCreateVerifyTimeout:              DefaultDataConnectorCreateVerifyTimeout,
-		DataConnectorSettingsUpdateVerifyTimeout:      DefaultDataConnectorSettingsUpdateVerifyTimeout,
-		DataConnectorDeleteVerifyTimeout:              DefaultDataConnectorDeleteVerifyTimeout,
-		DataConnectorStatusUpdateVerifyTimeout:        DefaultDataConnectorStatusUpdateVerifyTimeout,
-		PostgresCredentialPreCreateVerifyTimeout:      DefaultPostgresCredentialPreCreateVerifyTimeout,
-		PostgresCredentialCreateVerifyTimeout:         DefaultPostgresCredentialCreateVerifyTimeout,
-		PostgresCredentialDeleteVerifyTimeout:         DefaultPostgresCredentialDeleteVerifyTimeout,
-		PrivateSpaceCreateVerifyTimeout:               DefaultPrivateSpaceCreateVerifyTimeout,
-		AppContainerReleaseVerifyTimeout:              DefaultAppContainerReleaseVerifyTimeout,
-
 		PostgresSettingsModifyDelay: DefaultPostgresSettingsModifyDelay,
 		ConnectMappingModifyDelay:   DefaultConnectMappingModifyDelay,
+
+		RetryMaxRetries: DefaultRetryMaxRetries,
+		RetryMinWait:    DefaultRetryMinWait,
+		RetryMaxWait:    DefaultRetryMaxWait,
 	}
 	return c
 }
 
 func (c *Config) initializeAPI() error {
 	// Initialize the custom API client for non Heroku Platform APIs
-	api, clientInitErr := api.New(config.APIToken(c.token), config.BasicAuth("", c.token),
+	opts := []config.Option{config.BasicAuth("", ""),
 		config.CustomHTTPHeaders(c.Headers),
 		config.UserAgent(UserAgent),
-		config.MetricsBaseURL(c.metricsURL),
-		config.PostgresBaseURL(c.postgresURL),
-		config.PlatformBaseURL(c.platformURL),
-		config.RedisBaseURL(c.redisURL),
-		config.ConnectCentralBaseURL(c.connectCentralURL),
-		config.RegistryBaseURL(c.registryURL),
-		config.KolkrabbiBaseURL(c.kolkrabbiURL),
-		config.SchedulerBaseURL(c.schedulerURL),
-	)
+		config.MaxRetries(c.RetryMaxRetries),
+		config.RetryWaitTime(time.Duration(c.RetryMinWait)*time.Second, time.Duration(c.RetryMaxWait)*time.Second),
+		config.RequestLogger(apiLogger{}),
+	}
+	opts = append(opts, c.endpointOptionsList()...)
+
+	platformTransport := http.RoundTripper(heroku.RoundTripWithRetryBackoff{})
+	if c.Transport != nil {
+		opts = append(opts, config.Transport(c.Transport))
+		platformTransport = c.Transport
+	}
+
+	if c.tokenSource != nil {
+		opts = append(opts, config.APITokenSource(c.tokenSource))
+		platformTransport = &tokenTransport{source: c.tokenSource, transport: platformTransport}
+	}
+
+	api, clientInitErr := api.New(opts...)
 	if clientInitErr != nil {
 		return clientInitErr
 	}
@@ -147,13 +120,13 @@ func (c *Config) initializeAPI() error {
 	// Initialize the Heroku Platform API client
 	c.PlatformAPI = heroku.NewService(&http.Client{
 		Transport: &heroku.Transport{
-			Username:  "", // Email is not required
-			Password:  c.token,
 			UserAgent: UserAgent,
-			Transport: heroku.RoundTripWithRetryBackoff{},
+			Transport: platformTransport,
 		},
 	})
-	c.PlatformAPI.URL = c.platformURL
+	if platformURL, ok := c.endpoints[EndpointPlatform]; ok {
+		c.PlatformAPI.URL = platformURL
+	}
 
 	log.Printf("[INFO] Herokux Client configured")
 
@@ -172,49 +145,27 @@ func (c *Config) applySchema(d *schema.ResourceData) (err error) {
 		c.Headers = h
 	}
 
-	if v, ok := d.GetOk("metrics_api_url"); ok {
-		vs := v.(string)
-		c.metricsURL = vs
-	}
-
-	if v, ok := d.GetOk("postgres_api_url"); ok {
-		vs := v.(string)
-		c.postgresURL = vs
-	}
-
-	if v, ok := d.GetOk("data_api_url"); ok {
-		vs := v.(string)
-		c.dataURL = vs
-	}
-
-	if v, ok := d.GetOk("redis_api_url"); ok {
-		vs := v.(string)
-		c.redisURL = vs
-	}
-
-	if v, ok := d.GetOk("connect_central_api_url"); ok {
-		vs := v.(string)
-		c.connectCentralURL = vs
-	}
-
-	if v, ok := d.GetOk("registry_api_url"); ok {
-		vs := v.(string)
-		c.registryURL = vs
-	}
-
-	if v, ok := d.GetOk("kolkrabbi_api_url"); ok {
-		vs := v.(string)
-		c.kolkrabbiURL = vs
-	}
+	if v, ok := d.GetOk("api_key_command"); ok {
+		command := make([]string, 0)
+		for _, arg := range v.([]interface{}) {
+			command = append(command, arg.(string))
+		}
 
-	if v, ok := d.GetOk("scheduler_api_url"); ok {
-		vs := v.(string)
-		c.schedulerURL = vs
+		c.tokenSource = config.ExecTokenSource(command[0], command[1:]...)
+	} else if v, ok := d.GetOk("oauth"); ok {
+		vL := v.([]interface{})
+		if len(vL) > 1 {
+			return fmt.Errorf("provider configuration error: only 1 oauth config is permitted")
+		}
+		for _, v := range vL {
+			oauthConfig := v.(map[string]interface{})
+			c.tokenSource = config.OAuthTokenSource(oauthConfig["client_secret"].(string),
+				oauthConfig["refresh_token"].(string))
+		}
 	}
 
-	if v, ok := d.GetOk("platform_api_url"); ok {
-		vs := v.(string)
-		c.platformURL = vs
+	if err := c.applyEndpoints(d); err != nil {
+		return err
 	}
 
 	if v, ok := d.GetOk("delays"); ok {
@@ -234,6 +185,31 @@ func (c *Config) applySchema(d *schema.ResourceData) (err error) {
 		}
 	}
 
+	if v, ok := d.GetOk("retries"); ok {
+		vL := v.([]interface{})
+		if len(vL) > 1 {
+			return fmt.Errorf("provider configuration error: only 1 retries config is permitted")
+		}
+		for _, v := range vL {
+			retriesConfig := v.(map[string]interface{})
+			if v, ok := retriesConfig["max_retries"].(int); ok {
+				c.RetryMaxRetries = v
+			}
+
+			if v, ok := retriesConfig["min_wait"].(int); ok {
+				c.RetryMinWait = int64(v)
+			}
+
+			if v, ok := retriesConfig["max_wait"].(int); ok {
+				c.RetryMaxWait = int64(v)
+			}
+		}
+
+		if c.RetryMinWait > c.RetryMaxWait {
+			return fmt.Errorf("provider configuration error: retries min_wait cannot be greater than max_wait")
+		}
+	}
+
 	if v, ok := d.GetOk("timeouts"); ok {
 		vL := v.([]interface{})
 		if len(vL) > 1 {
@@ -334,57 +310,40 @@ func (c *Config) applySchema(d *schema.ResourceData) (err error) {
 }
 
 func (c *Config) applyNetrcFile() error {
-	// Get the netrc file path. If path not shown, then fall back to default netrc path value
-	path := os.Getenv("NETRC_PATH")
-
-	if path == "" {
-		filename := ".netrc"
-		if runtime.GOOS == "windows" {
-			filename = "_netrc"
-		}
+	ts, err := config.DefaultNetrcTokenSource()
+	if err != nil {
+		return err
+	}
 
-		var err error
-		path, err = homedir.Expand("~/" + filename)
-		if err != nil {
-			return err
-		}
+	// If the file or the Heroku machine is not found, then do nothing.
+	if ts != nil {
+		c.tokenSource = ts
 	}
 
-	// If the file is not a file, then do nothing
-	if fi, err := os.Stat(path); err != nil {
-		// File doesn't exist, do nothing
-		if os.IsNotExist(err) {
-			return nil
-		}
+	return nil
+}
 
-		// Some other error!
-		return err
-	} else if fi.IsDir() {
-		// File is directory, ignore
-		return nil
-	}
+// setAPIKey sets a static API key as the token source.
+func (c *Config) setAPIKey(token string) {
+	c.tokenSource = config.StaticTokenSource(token)
+}
 
-	// Load up the netrc file
-	net, err := netrc.ParseFile(path)
-	if err != nil {
-		return fmt.Errorf("error parsing netrc file at %q: %s", path, err)
-	}
+// tokenTransport sets the Authorization header of each Heroku Platform API request using a token source,
+// so a rotated token is used without reconfiguring the provider.
+type tokenTransport struct {
+	source    config.TokenSource
+	transport http.RoundTripper
+}
 
-	// Reference the default Heroku Platform API url from heroku-go as that's the host URL used in ~/.netrc.
-	// Doing this is okay because although this provider uses different base endpoints,
-	//the authentication among all of the endpoints.
-	u, err := url.Parse(heroku.DefaultURL)
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token(req.Context())
 	if err != nil {
-		return err
-	}
-
-	machine := net.FindMachine(u.Host)
-	if machine == nil {
-		// Machine not found, no problem
-		return nil
+		return nil, fmt.Errorf("unable to retrieve API token: %w", err)
 	}
 
-	c.token = machine.Password
+	// The email is not required, only the token.
+	req = req.Clone(req.Context())
+	req.SetBasicAuth("", token)
 
-	return nil
+	return t.transport.RoundTrip(req)
 }
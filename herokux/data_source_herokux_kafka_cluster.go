@@ -0,0 +1,450 @@
+package herokux
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
+)
+
+func dataSourceHerokuxKafkaCluster() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceHerokuxKafkaClusterRead,
+		Schema: map[string]*schema.Schema{
+			"kafka_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"attachment_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"shared_cluster": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"topic_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"topics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"admin_topic_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"partition_replica_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"data_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"messages_in_per_sec": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_in_per_sec": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_out_per_sec": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"healthy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"waiting": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"degraded_topics": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"degraded_brokers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"limits": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minimum_replication": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"maximum_replication": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"minimum_retention_ms": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"maximum_retention_ms": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"max_partition_replica_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"max_number_of_total_partitions": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"max_number_of_partitions_per_topic": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"max_number_of_topics": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"max_topics": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"produce_quota_bytes_per_second": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"consume_quota_bytes_per_second": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"data_size": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"limit_bytes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"subcritical_percentage": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"critical_percentage": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"supercritical_percentage": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"defaults": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"retention_time_ms": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"capabilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"supports_mixed_cleanup_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"formation": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"kafka_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"zookeeper_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"robot": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"is_robot": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"robot_ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHerokuxKafkaClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*Config).API
+	kafkaID := getKafkaID(d)
+
+	cluster, _, getErr := client.Kafka.Get(ctx, kafkaID)
+	if getErr != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to retrieve kafka %s", kafkaID),
+			Detail:   getErr.Error(),
+		})
+		return diags
+	}
+
+	createdAt := ""
+	if cluster.CreatedAt != nil {
+		createdAt = cluster.GetCreatedAt().Format(time.RFC3339)
+	}
+
+	d.SetId(kafkaID)
+	d.Set("kafka_id", kafkaID)
+	d.Set("name", cluster.GetName())
+	d.Set("attachment_name", cluster.GetAddonAttachmentConfigVar())
+	d.Set("created_at", createdAt)
+	d.Set("versions", cluster.Versions)
+	d.Set("shared_cluster", cluster.GetSharedCluster())
+	d.Set("topic_prefix", cluster.GetTopicPrefix())
+	d.Set("topics", cluster.Topics)
+	d.Set("admin_topic_names", cluster.AdminTopicNames)
+	d.Set("partition_replica_count", cluster.GetPartitionReplicaCount())
+	d.Set("data_size", int(cluster.GetDataSize()))
+	d.Set("messages_in_per_sec", int(cluster.GetMessagesInPerSec()))
+	d.Set("bytes_in_per_sec", int(cluster.GetBytesInPerSec()))
+	d.Set("bytes_out_per_sec", int(cluster.GetBytesOutPerSec()))
+
+	if err := d.Set("state", flattenKafkaClusterState(cluster.State)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("limits", flattenKafkaClusterLimits(cluster.Limits)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("defaults", flattenKafkaClusterDefaults(cluster.Defaults)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("capabilities", flattenKafkaClusterCapabilities(cluster.Capabilities)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("formation", flattenKafkaClusterFormation(cluster.Formation)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("robot", flattenKafkaClusterRobot(cluster.Robot)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func flattenKafkaClusterState(s *kafka.ClusterState) []interface{} {
+	if s == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"status":           s.GetStatus(),
+		"message":          s.GetMessage(),
+		"healthy":          s.GetHealthy(),
+		"waiting":          s.GetWaiting(),
+		"degraded_topics":  s.DegradedTopics,
+		"degraded_brokers": s.DegradedBrokers,
+	}}
+}
+
+func flattenKafkaClusterLimits(l *kafka.ClusterLimits) []interface{} {
+	if l == nil {
+		return []interface{}{}
+	}
+
+	dataSize := []interface{}{}
+	if ds := l.DataSize; ds != nil {
+		dataSize = append(dataSize, map[string]interface{}{
+			"limit_bytes":              int(ds.GetLimitByte()),
+			"subcritical_percentage":   ds.GetSubCriticalPercentage(),
+			"critical_percentage":      ds.GetCriticalPercentage(),
+			"supercritical_percentage": ds.GetSuperCriticalPercentage(),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"minimum_replication":                l.GetMinimumReplication(),
+		"maximum_replication":                l.GetMaximumReplication(),
+		"minimum_retention_ms":               int(l.GetMinimumReplicationMS()),
+		"maximum_retention_ms":               int(l.GetMaximumReplicationMS()),
+		"max_partition_replica_count":        l.GetMaxPartitionReplicaCount(),
+		"max_number_of_total_partitions":     l.GetMaxNumberOfTotalPartitions(),
+		"max_number_of_partitions_per_topic": l.GetMaxNumberOfPartitionsPerTopic(),
+		"max_number_of_topics":               l.GetMaxNumberOfTopics(),
+		"max_topics":                         l.GetMaxTopics(),
+		"produce_quota_bytes_per_second":     int(l.GetProduceQuotaBytesPerSecond()),
+		"consume_quota_bytes_per_second":     int(l.GetConsumeQuotaBytesPerSecond()),
+		"data_size":                          dataSize,
+	}}
+}
+
+func flattenKafkaClusterDefaults(defaults *kafka.ClusterDefaults) []interface{} {
+	if defaults == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"partition_count":   defaults.GetPartitionCount(),
+		"retention_time_ms": int(defaults.GetRetentionTimeMS()),
+	}}
+}
+
+func flattenKafkaClusterCapabilities(c *kafka.ClusterCapabilities) []interface{} {
+	if c == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"supports_mixed_cleanup_policy": c.GetSupportsMixedCleanupPolicy(),
+	}}
+}
+
+func flattenKafkaClusterFormation(f *kafka.ClusterFormation) []interface{} {
+	if f == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"id":            f.GetID(),
+		"kafka_ids":     f.KafkaIDs,
+		"zookeeper_ids": f.ZookeeperIDs,
+	}}
+}
+
+func flattenKafkaClusterRobot(r *kafka.ClusterRobot) []interface{} {
+	if r == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"is_robot":  r.GetIsRobot(),
+		"robot_ttl": r.GetRobotTTL(),
+	}}
+}
@@ -0,0 +1,95 @@
+package herokux
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAccDatasourceHerokuxKafkaCluster_Basic(t *testing.T) {
+	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuxKafkaCluster_Basic(kafkaID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.herokux_kafka_cluster.foobar", "kafka_id", kafkaID),
+					resource.TestCheckResourceAttrSet(
+						"data.herokux_kafka_cluster.foobar", "name"),
+					resource.TestCheckResourceAttr(
+						"data.herokux_kafka_cluster.foobar", "state.0.healthy", "true"),
+					resource.TestCheckResourceAttrSet(
+						"data.herokux_kafka_cluster.foobar", "limits.0.max_number_of_partitions_per_topic"),
+					resource.TestCheckResourceAttrSet(
+						"data.herokux_kafka_cluster.foobar", "defaults.0.partition_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuxKafkaCluster_Basic(kafkaID string) string {
+	return fmt.Sprintf(`
+data "herokux_kafka_cluster" "foobar" {
+  kafka_id = "%s"
+}
+`, kafkaID)
+}
+
+func TestDataSourceHerokuxKafkaClusterRead(t *testing.T) {
+	var cluster *kafka.Cluster
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"name": "kafka-shaped-12345",
+		"attachment_name": "KAFKA",
+		"created_at": "2021-03-01T10:00:00Z",
+		"version": ["2.8.1"],
+		"topic_prefix": "",
+		"admin_topic_names": ["__consumer_offsets"],
+		"state": {"status": "degraded", "healthy?": false, "degraded_topics": ["orders"]},
+		"limits": {"minimum_replication": 3, "maximum_retention_ms": 1209600000, "max_number_of_partitions_per_topic": 256,
+			"data_size": {"limit_bytes": 322122547200, "critical_percentage": 90}},
+		"defaults": {"partition_count": 8, "retention_time_ms": 86400000},
+		"capabilities": {"supports_mixed_cleanup_policy": true}
+	}`), &cluster))
+
+	kafkaID := "6b5e1f4e-8a3b-4c0b-9e3d-1f2a3b4c5d6e"
+	client := &api.Client{Kafka: &mock.KafkaService{
+		GetFunc: func(ctx context.Context, clusterID string) (*kafka.Cluster, *simpleresty.Response, error) {
+			assert.Equal(t, kafkaID, clusterID)
+			return cluster, nil, nil
+		},
+	}}
+
+	d := schema.TestResourceDataRaw(t, dataSourceHerokuxKafkaCluster().Schema, map[string]interface{}{"kafka_id": kafkaID})
+	diags := dataSourceHerokuxKafkaClusterRead(context.Background(), d, &Config{API: client})
+	assert.Empty(t, diags)
+
+	assert.Equal(t, kafkaID, d.Id())
+	assert.Equal(t, "kafka-shaped-12345", d.Get("name"))
+	assert.Equal(t, "2021-03-01T10:00:00Z", d.Get("created_at"))
+	assert.Equal(t, []interface{}{"2.8.1"}, d.Get("versions"))
+	assert.Equal(t, false, d.Get("state.0.healthy"))
+	assert.Equal(t, []interface{}{"orders"}, d.Get("state.0.degraded_topics"))
+	assert.Equal(t, 3, d.Get("limits.0.minimum_replication"))
+	assert.Equal(t, 1209600000, d.Get("limits.0.maximum_retention_ms"))
+	assert.Equal(t, 256, d.Get("limits.0.max_number_of_partitions_per_topic"))
+	assert.Equal(t, 90, d.Get("limits.0.data_size.0.critical_percentage"))
+	assert.Equal(t, 8, d.Get("defaults.0.partition_count"))
+	assert.Equal(t, 86400000, d.Get("defaults.0.retention_time_ms"))
+	assert.Equal(t, true, d.Get("capabilities.0.supports_mixed_cleanup_policy"))
+	assert.Equal(t, 0, d.Get("formation.#"))
+}
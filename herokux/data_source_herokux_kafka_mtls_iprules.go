@@ -55,7 +55,7 @@ func dataSourceHerokuxKafkaMTLSIPRulesRead(ctx context.Context, d *schema.Resour
 	kafkaID := getKafkaID(d)
 	rules := make([]map[string]string, 0)
 
-	ipRules, _, listErr := client.Kafka.ListMTLSIPRules(kafkaID)
+	ipRules, _, listErr := client.Kafka.ListMTLSIPRules(ctx, kafkaID)
 	if listErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,172 @@
+package herokux
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"strings"
+)
+
+func dataSourceHerokuxKafkaTopics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceHerokuxKafkaTopicsRead,
+		Schema: map[string]*schema.Schema{
+			"kafka_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"topic_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"max_topics": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"topics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status_label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"partitions": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"replication_factor": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"retention_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"retention_time_ms": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"cleanup_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"data_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"messages_in_per_second": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+
+						"bytes_in_per_second": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+
+						"bytes_out_per_second": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHerokuxKafkaTopicsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*Config).API
+	kafkaID := getKafkaID(d)
+	namePrefix := d.Get("name_prefix").(string)
+	status := d.Get("status").(string)
+
+	topics, _, listErr := client.Kafka.ListTopics(ctx, kafkaID)
+	if listErr != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to retrieve topics for kafka %s", kafkaID),
+			Detail:   listErr.Error(),
+		})
+		return diags
+	}
+
+	results := make([]map[string]interface{}, 0, len(topics.Topics))
+	for _, t := range topics.Topics {
+		if !strings.HasPrefix(t.GetName(), namePrefix) || (status != "" && t.GetStatus() != status) {
+			continue
+		}
+
+		retentionTime, convErr := kafkaTopicRetentionTime(t)
+		if convErr != nil {
+			return diag.FromErr(convErr)
+		}
+
+		// Throughput is not reported for topics without traffic.
+		messagesIn, _ := t.GetMessageInPerSecond().Float64()
+		bytesIn, _ := t.GetBytesInPerSecond().Float64()
+		bytesOut, _ := t.GetBytesOutPerSecond().Float64()
+
+		results = append(results, map[string]interface{}{
+			"name":                   t.GetName(),
+			"status":                 t.GetStatus(),
+			"status_label":           t.GetStatusLabel(),
+			"partitions":             t.GetPartitions(),
+			"replication_factor":     t.GetReplicationFactor(),
+			"retention_time":         retentionTime,
+			"retention_time_ms":      t.GetRetentionTimeInMS(),
+			"cleanup_policy":         t.EffectiveCleanupPolicy().ToString(),
+			"data_size":              t.GetDataSize(),
+			"messages_in_per_second": messagesIn,
+			"bytes_in_per_second":    bytesIn,
+			"bytes_out_per_second":   bytesOut,
+		})
+	}
+
+	d.SetId(kafkaID)
+	d.Set("kafka_id", kafkaID)
+	d.Set("topic_prefix", topics.GetPrefix())
+	d.Set("max_topics", topics.GetLimits().GetMaxTopics())
+
+	if err := d.Set("topics", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,115 @@
+package herokux
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAccDatasourceHerokuxKafkaTopics_Basic(t *testing.T) {
+	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuxKafkaTopics_Basic(kafkaID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.herokux_kafka_topics.foobar", "kafka_id", kafkaID),
+					resource.TestCheckResourceAttrSet(
+						"data.herokux_kafka_topics.foobar", "topics.0.name"),
+					resource.TestCheckResourceAttr(
+						"data.herokux_kafka_topics.foobar", "topics.0.status", "ready"),
+					resource.TestCheckResourceAttrSet(
+						"data.herokux_kafka_topics.foobar", "topics.0.partitions"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuxKafkaTopics_Basic(kafkaID string) string {
+	return fmt.Sprintf(`
+data "herokux_kafka_topics" "foobar" {
+  kafka_id = "%s"
+  status   = "ready"
+}
+`, kafkaID)
+}
+
+func TestDataSourceHerokuxKafkaTopicsRead(t *testing.T) {
+	var topics *kafka.Topics
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"prefix": "",
+		"limits": {"max_topics": 40},
+		"topics": [
+			{"name": "orders.eu", "status": "ready", "partitions": 8, "replication_factor": 3,
+				"retention_time_ms": 172800000, "data_size": 1048576,
+				"messages_in_per_second": 12.5, "bytes_in_per_second": 2048, "bytes_out_per_second": 4096},
+			{"name": "orders.us", "status": "pending", "partitions": 8, "replication_factor": 3},
+			{"name": "customers", "status": "ready", "partitions": 4, "replication_factor": 3,
+				"compaction_enabled": true, "retention_enabled": false}
+		]
+	}`), &topics))
+
+	client := &api.Client{Kafka: &mock.KafkaService{
+		ListTopicsFunc: func(ctx context.Context, clusterID string) (*kafka.Topics, *simpleresty.Response, error) {
+			assert.Equal(t, testKafkaID, clusterID)
+			return topics, nil, nil
+		},
+	}}
+
+	testCases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected []string
+	}{
+		{name: "all", raw: map[string]interface{}{}, expected: []string{"orders.eu", "orders.us", "customers"}},
+		{name: "prefix", raw: map[string]interface{}{"name_prefix": "orders."}, expected: []string{"orders.eu", "orders.us"}},
+		{name: "status", raw: map[string]interface{}{"status": "ready"}, expected: []string{"orders.eu", "customers"}},
+		{name: "prefix and status", raw: map[string]interface{}{"name_prefix": "orders.", "status": "ready"},
+			expected: []string{"orders.eu"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.raw["kafka_id"] = testKafkaID
+			d := schema.TestResourceDataRaw(t, dataSourceHerokuxKafkaTopics().Schema, tc.raw)
+			diags := dataSourceHerokuxKafkaTopicsRead(context.Background(), d, &Config{API: client})
+			assert.Empty(t, diags)
+
+			var names []string
+			for _, topic := range d.Get("topics").([]interface{}) {
+				names = append(names, topic.(map[string]interface{})["name"].(string))
+			}
+			assert.Equal(t, tc.expected, names)
+			assert.Equal(t, 40, d.Get("max_topics"))
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceHerokuxKafkaTopics().Schema, map[string]interface{}{"kafka_id": testKafkaID})
+	assert.Empty(t, dataSourceHerokuxKafkaTopicsRead(context.Background(), d, &Config{API: client}))
+
+	assert.Equal(t, "2d", d.Get("topics.0.retention_time"))
+	assert.Equal(t, 172800000, d.Get("topics.0.retention_time_ms"))
+	assert.Equal(t, "delete", d.Get("topics.0.cleanup_policy"))
+	assert.Equal(t, 1048576, d.Get("topics.0.data_size"))
+	assert.Equal(t, 12.5, d.Get("topics.0.messages_in_per_second"))
+	assert.Equal(t, float64(2048), d.Get("topics.0.bytes_in_per_second"))
+	assert.Equal(t, float64(4096), d.Get("topics.0.bytes_out_per_second"))
+	assert.Equal(t, float64(0), d.Get("topics.1.messages_in_per_second"))
+	assert.Equal(t, "disable", d.Get("topics.2.retention_time"))
+	assert.Equal(t, "compact", d.Get("topics.2.cleanup_policy"))
+}
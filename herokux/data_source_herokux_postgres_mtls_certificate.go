@@ -58,7 +58,7 @@ func dataSourceHerokuxPostgresMTLSCertRead(ctx context.Context, d *schema.Resour
 	dbName := getDatabaseName(d)
 	certID := d.Get("cert_id").(string)
 
-	cert, _, getErr := client.Postgres.GetMTLSCert(dbName, certID)
+	cert, _, getErr := client.Postgres.GetMTLSCert(ctx, dbName, certID)
 	if getErr != nil {
 		return diag.FromErr(getErr)
 	}
@@ -60,7 +60,7 @@ func dataSourceHerokuxRegistryImageRead(ctx context.Context, d *schema.ResourceD
 	processType := d.Get("process_type").(string)
 	dockerTag := d.Get("docker_tag").(string)
 
-	image, _, getErr := client.Registry.GetAppProcessManifests(appID, processType, dockerTag)
+	image, _, getErr := client.Registry.GetAppProcessManifests(ctx, appID, processType, dockerTag)
 	if getErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,163 @@
+package herokux
+
+import (
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"sort"
+)
+
+// Endpoints that can be overridden in the endpoints and endpoint_profile blocks.
+const (
+	EndpointPlatform       = "platform"
+	EndpointMetrics        = "metrics"
+	EndpointPostgres       = "postgres"
+	EndpointKafka          = "kafka"
+	EndpointData           = "data"
+	EndpointRedis          = "redis"
+	EndpointConnect        = "connect"
+	EndpointConnectCentral = "connect_central"
+	EndpointRegistry       = "registry"
+	EndpointKolkrabbi      = "kolkrabbi"
+	EndpointScheduler      = "scheduler"
+)
+
+// endpointOptions maps each endpoint to the api client option that sets its base URL.
+var endpointOptions = map[string]func(string) config.Option{
+	EndpointPlatform:       config.PlatformBaseURL,
+	EndpointMetrics:        config.MetricsBaseURL,
+	EndpointPostgres:       config.PostgresBaseURL,
+	EndpointKafka:          config.KafkaBaseURL,
+	EndpointData:           config.DataBaseURL,
+	EndpointRedis:          config.RedisBaseURL,
+	EndpointConnect:        config.ConnectBaseURL,
+	EndpointConnectCentral: config.ConnectCentralBaseURL,
+	EndpointRegistry:       config.RegistryBaseURL,
+	EndpointKolkrabbi:      config.KolkrabbiBaseURL,
+	EndpointScheduler:      config.SchedulerBaseURL,
+}
+
+// legacyEndpointAttributes maps the deprecated provider URL attributes to their endpoint.
+var legacyEndpointAttributes = map[string]string{
+	"platform_api_url":        EndpointPlatform,
+	"metrics_api_url":         EndpointMetrics,
+	"postgres_api_url":        EndpointPostgres,
+	"data_api_url":            EndpointData,
+	"redis_api_url":           EndpointRedis,
+	"connect_central_api_url": EndpointConnectCentral,
+	"registry_api_url":        EndpointRegistry,
+	"kolkrabbi_api_url":       EndpointKolkrabbi,
+	"scheduler_api_url":       EndpointScheduler,
+}
+
+// endpointsSchema returns the schema of the endpoint URL attributes shared by the endpoints and
+// endpoint_profile blocks merged with additional attributes.
+func endpointsSchema(additional map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"base_url": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+	}
+
+	for endpoint := range endpointOptions {
+		s[endpoint] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		}
+	}
+
+	for k, v := range additional {
+		s[k] = v
+	}
+
+	return s
+}
+
+// applyEndpoints sets the base URL of every endpoint. The deprecated URL attributes, which default to production,
+// are applied first, followed by the selected endpoint profile and finally the endpoints block.
+func (c *Config) applyEndpoints(d *schema.ResourceData) error {
+	c.endpoints = make(map[string]string)
+
+	for attr, endpoint := range legacyEndpointAttributes {
+		if v, ok := d.GetOk(attr); ok {
+			c.endpoints[endpoint] = v.(string)
+		}
+	}
+
+	if v, ok := d.GetOk("endpoints"); ok {
+		vL := v.([]interface{})
+		if len(vL) > 1 {
+			return fmt.Errorf("provider configuration error: only 1 endpoints config is permitted")
+		}
+
+		if endpointsConfig, ok := vL[0].(map[string]interface{}); ok {
+			if profile := endpointsConfig["profile"].(string); profile != "" {
+				profileConfig, err := findEndpointProfile(d, profile)
+				if err != nil {
+					return err
+				}
+
+				c.applyEndpointURLs(profileConfig)
+			}
+
+			c.applyEndpointURLs(endpointsConfig)
+		}
+	}
+
+	// The Kafka APIs are served by the Postgres API unless set explicitly.
+	if _, ok := c.endpoints[EndpointKafka]; !ok {
+		if postgresURL, ok := c.endpoints[EndpointPostgres]; ok {
+			c.endpoints[EndpointKafka] = postgresURL
+		}
+	}
+
+	return nil
+}
+
+// applyEndpointURLs sets every endpoint to the base_url of an endpoints or endpoint_profile block,
+// if present, and then any endpoints set explicitly.
+func (c *Config) applyEndpointURLs(endpointsConfig map[string]interface{}) {
+	if baseURL, ok := endpointsConfig["base_url"].(string); ok && baseURL != "" {
+		for endpoint := range endpointOptions {
+			c.endpoints[endpoint] = baseURL
+		}
+	}
+
+	for endpoint := range endpointOptions {
+		if url, ok := endpointsConfig[endpoint].(string); ok && url != "" {
+			c.endpoints[endpoint] = url
+		}
+	}
+}
+
+// endpointOptionsList returns the api client options for every endpoint with a base URL.
+func (c *Config) endpointOptionsList() []config.Option {
+	endpoints := make([]string, 0, len(c.endpoints))
+	for endpoint := range c.endpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	opts := make([]config.Option, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		opts = append(opts, endpointOptions[endpoint](c.endpoints[endpoint]))
+	}
+
+	return opts
+}
+
+// findEndpointProfile returns the endpoint_profile block with the given name.
+func findEndpointProfile(d *schema.ResourceData, name string) (map[string]interface{}, error) {
+	for _, v := range d.Get("endpoint_profile").([]interface{}) {
+		profileConfig, ok := v.(map[string]interface{})
+		if ok && profileConfig["name"].(string) == name {
+			return profileConfig, nil
+		}
+	}
+
+	return nil, fmt.Errorf("provider configuration error: endpoint profile %q is not defined", name)
+}
@@ -0,0 +1,164 @@
+package herokux
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newEndpointsServer returns a server that records the first path segment of every request it receives.
+func newEndpointsServer(t *testing.T) (*httptest.Server, func() []string) {
+	var mu sync.Mutex
+	reached := make(map[string]bool)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		reached[strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[0]] = true
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"id":"not_found","message":"not found"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		result := make([]string, 0, len(reached))
+		for k := range reached {
+			result = append(result, k)
+		}
+		sort.Strings(result)
+
+		return result
+	}
+}
+
+// configureTestProvider configures the provider with raw and returns its Config.
+func configureTestProvider(t *testing.T, raw map[string]interface{}) *Config {
+	d := schema.TestResourceDataRaw(t, New().Schema, raw)
+
+	meta, diags := configureProvider(context.Background(), d, NewConfig())
+	assert.False(t, diags.HasError(), "%v", diags)
+
+	return meta.(*Config)
+}
+
+// callEveryEndpoint makes a request with every sub-client.
+func callEveryEndpoint(config *Config) {
+	ctx := context.Background()
+	client := config.API
+
+	config.PlatformAPI.AccountInfo(ctx)
+	client.Platform.GetAppWebhook(ctx, "app", "webhook")
+	client.Metrics.ListMonitors(ctx, "app", "web")
+	client.Postgres.GetDB(ctx, "db")
+	client.Kafka.Get(ctx, "cluster")
+	client.Data.GetPostgresDataclip(ctx, "clip")
+	client.Redis.GetConfig(ctx, "db")
+	client.Connect.RegionalBaseURL(ctx, "app", "connection")
+	client.Connect.GetMapping(ctx, config.endpoints[EndpointConnect], "mapping")
+	client.Registry.GetAppProcessManifests(ctx, "app", "web", "latest")
+	client.Kolkrabbi.GetAppGithubIntegration(ctx, "app")
+	client.Scheduler.List(ctx, "app")
+}
+
+func TestProvider_Endpoints_ReachSubClients(t *testing.T) {
+	server, reached := newEndpointsServer(t)
+
+	endpoints := map[string]interface{}{}
+	expected := make([]string, 0)
+	for endpoint := range endpointOptions {
+		endpoints[endpoint] = server.URL + "/" + endpoint
+		if endpoint != EndpointConnectCentral {
+			expected = append(expected, endpoint)
+		}
+	}
+	sort.Strings(expected)
+
+	config := configureTestProvider(t, map[string]interface{}{
+		"api_key":   "token",
+		"endpoints": []interface{}{endpoints},
+		"retries":   []interface{}{map[string]interface{}{"max_retries": 0}},
+	})
+
+	callEveryEndpoint(config)
+
+	// Connect Central is not called when the Connect base URL is overridden.
+	assert.Equal(t, expected, reached())
+}
+
+func TestProvider_Endpoints_ConnectCentral(t *testing.T) {
+	server, reached := newEndpointsServer(t)
+
+	config := configureTestProvider(t, map[string]interface{}{
+		"api_key": "token",
+		"endpoints": []interface{}{map[string]interface{}{
+			EndpointConnectCentral: server.URL + "/" + EndpointConnectCentral,
+		}},
+		"retries": []interface{}{map[string]interface{}{"max_retries": 0}},
+	})
+
+	config.API.Connect.RegionalBaseURL(context.Background(), "app", "connection")
+
+	assert.Equal(t, []string{EndpointConnectCentral}, reached())
+}
+
+func TestProvider_Endpoints_Profile(t *testing.T) {
+	server, reached := newEndpointsServer(t)
+
+	config := configureTestProvider(t, map[string]interface{}{
+		"api_key": "token",
+		"endpoint_profile": []interface{}{
+			map[string]interface{}{"name": "local", "base_url": server.URL + "/local"},
+			map[string]interface{}{"name": "staging", "base_url": "https://staging.example.com"},
+		},
+		"endpoints": []interface{}{map[string]interface{}{
+			"profile":   "local",
+			"scheduler": server.URL + "/scheduler",
+		}},
+		"retries": []interface{}{map[string]interface{}{"max_retries": 0}},
+	})
+
+	callEveryEndpoint(config)
+
+	assert.Equal(t, []string{"local", "scheduler"}, reached())
+}
+
+func TestProvider_Endpoints_UndefinedProfile(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"api_key":   "token",
+		"endpoints": []interface{}{map[string]interface{}{"profile": "staging"}},
+	})
+
+	_, diags := configureProvider(context.Background(), d, NewConfig())
+	assert.True(t, diags.HasError())
+}
+
+func TestProvider_LegacyEndpointAttributes(t *testing.T) {
+	server, reached := newEndpointsServer(t)
+
+	raw := map[string]interface{}{
+		"api_key": "token",
+		"retries": []interface{}{map[string]interface{}{"max_retries": 0}},
+	}
+	expected := make([]string, 0)
+	for attr, endpoint := range legacyEndpointAttributes {
+		raw[attr] = server.URL + "/" + endpoint
+		expected = append(expected, endpoint)
+	}
+	sort.Strings(expected)
+
+	callEveryEndpoint(configureTestProvider(t, raw))
+
+	// The Kafka APIs share the Postgres API's base URL and Connect uses the regional URL from Connect Central.
+	assert.Equal(t, expected, reached())
+}
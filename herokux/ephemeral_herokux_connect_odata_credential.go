@@ -0,0 +1,110 @@
+package herokux
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+)
+
+// uuidRegex matches the UUIDs of Heroku resources, such as apps and add-ons.
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+var (
+	_ ephemeral.EphemeralResource              = &herokuxConnectODataCredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &herokuxConnectODataCredentialEphemeralResource{}
+)
+
+type herokuxConnectODataCredentialEphemeralResource struct {
+	frameworkMeta
+}
+
+type herokuxConnectODataCredentialModel struct {
+	AppID     types.String `tfsdk:"app_id"`
+	ConnectID types.String `tfsdk:"connect_id"`
+	ODataID   types.String `tfsdk:"odata_id"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	AccessURL types.String `tfsdk:"access_url"`
+}
+
+func newHerokuxConnectODataCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &herokuxConnectODataCredentialEphemeralResource{}
+}
+
+func (r *herokuxConnectODataCredentialEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_odata_credential"
+}
+
+func (r *herokuxConnectODataCredentialEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables the OData credential of a Heroku Connect connection and retrieves it " +
+			"without storing it in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the app with the Heroku Connect add-on.",
+				Validators:  []validator.String{stringvalidator.RegexMatches(uuidRegex, "must be a UUID")},
+			},
+			"connect_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the Heroku Connect add-on.",
+				Validators:  []validator.String{stringvalidator.RegexMatches(uuidRegex, "must be a UUID")},
+			},
+			"odata_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the OData service.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"access_url": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *herokuxConnectODataCredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data herokuxConnectODataCredentialModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := r.config(&resp.Diagnostics)
+	if config == nil {
+		return
+	}
+	client := config.API
+
+	// The regional base URL is only used for this credential, so other connections are not affected.
+	baseURL, baseURLErr := client.Connect.RegionalBaseURL(ctx, data.AppID.ValueString(), data.ConnectID.ValueString())
+	if baseURLErr != nil {
+		resp.Diagnostics.AddError("Unable determine the root API URL for your Heroku Connect connection",
+			baseURLErr.Error())
+		return
+	}
+
+	cred, _, createErr := client.Connect.CreateCredential(ctx, baseURL, data.ODataID.ValueString())
+	if createErr != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to enable the OData credential of %s", data.ODataID.ValueString()),
+			createErr.Error())
+		return
+	}
+
+	data.Username = types.StringValue(cred.GetCredentials().GetUser())
+	data.Password = types.StringValue(cred.GetCredentials().GetPassword())
+	data.AccessURL = types.StringValue(cred.GetAccessURL())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
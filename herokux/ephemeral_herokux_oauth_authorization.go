@@ -0,0 +1,192 @@
+package herokux
+
+import (
+	"context"
+	"encoding/json"
+	heroku "github.com/davidji99/heroku-go/v5"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"log"
+	"regexp"
+)
+
+// oauthAuthorizationPrivateKey is the key of the private data of an opened herokux_oauth_authorization.
+const oauthAuthorizationPrivateKey = "authorization"
+
+var (
+	_ ephemeral.EphemeralResource              = &herokuxOauthAuthorizationEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &herokuxOauthAuthorizationEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &herokuxOauthAuthorizationEphemeralResource{}
+)
+
+type herokuxOauthAuthorizationEphemeralResource struct {
+	frameworkMeta
+}
+
+type herokuxOauthAuthorizationModel struct {
+	Scope          []string     `tfsdk:"scope"`
+	AuthAPIKeyName types.String `tfsdk:"auth_api_key_name"`
+	TimeToLive     types.Int64  `tfsdk:"time_to_live"`
+	Description    types.String `tfsdk:"description"`
+	AccessToken    types.String `tfsdk:"access_token"`
+	ExpiresIn      types.Int64  `tfsdk:"expires_in"`
+	TokenID        types.String `tfsdk:"token_id"`
+}
+
+// oauthAuthorizationPrivate is the private data of an opened herokux_oauth_authorization,
+// which identifies the authorization to delete when it is closed.
+type oauthAuthorizationPrivate struct {
+	ID             string `json:"id"`
+	AuthAPIKeyName string `json:"auth_api_key_name,omitempty"`
+}
+
+func newHerokuxOauthAuthorizationEphemeralResource() ephemeral.EphemeralResource {
+	return &herokuxOauthAuthorizationEphemeralResource{}
+}
+
+func (r *herokuxOauthAuthorizationEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_authorization"
+}
+
+func (r *herokuxOauthAuthorizationEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an OAuth authorization for the duration of a Terraform run without storing its " +
+			"access token in the plan or state. The authorization is deleted when Terraform no longer needs it.",
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Set custom OAuth scopes",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("global", "read",
+						"write", "read-protected", "write-protected", "identity")),
+				},
+			},
+			"auth_api_key_name": schema.StringAttribute{
+				Optional: true,
+				Description: "Any word character (letter, number, underscore) string " +
+					"representing the API key used to create the new authorization",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\w{1,32}$`),
+						"may only include words, letters, or underscore with max length of 32 characters"),
+				},
+			},
+			"time_to_live": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Set expiration in seconds. No expiration if not set.",
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Set a custom authorization description",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The actual access token value",
+			},
+			"expires_in": schema.Int64Attribute{
+				Computed:    true,
+				Description: "How long in seconds before the token expires",
+			},
+			"token_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The token UUID",
+			},
+		},
+	}
+}
+
+func (r *herokuxOauthAuthorizationEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data herokuxOauthAuthorizationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := r.config(&resp.Diagnostics)
+	if config == nil {
+		return
+	}
+
+	keyName := data.AuthAPIKeyName.ValueString()
+	client, clientErr := platformAPIClient(config, keyName)
+	if clientErr != nil {
+		resp.Diagnostics.AddError("Unable to create the API client", clientErr.Error())
+		return
+	}
+
+	opts := heroku.OAuthAuthorizationCreateOpts{Scope: data.Scope}
+	if !data.TimeToLive.IsNull() {
+		ttl := int(data.TimeToLive.ValueInt64())
+		opts.ExpiresIn = &ttl
+	}
+	if description := data.Description.ValueString(); description != "" {
+		opts.Description = &description
+	}
+
+	log.Printf("[DEBUG] Creating new ephemeral OAuth authorization")
+
+	auth, createErr := client.OAuthAuthorizationCreate(ctx, opts)
+	if createErr != nil {
+		resp.Diagnostics.AddError("Unable to create OAuth authorization", createErr.Error())
+		return
+	}
+
+	private, marshalErr := json.Marshal(oauthAuthorizationPrivate{ID: auth.ID, AuthAPIKeyName: keyName})
+	if marshalErr != nil {
+		resp.Diagnostics.AddError("Unable to save the OAuth authorization", marshalErr.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, oauthAuthorizationPrivateKey, private)...)
+
+	data.AccessToken = types.StringValue("")
+	data.ExpiresIn = types.Int64Value(0)
+	data.TokenID = types.StringValue("")
+	if auth.AccessToken != nil {
+		data.AccessToken = types.StringValue(auth.AccessToken.Token)
+		data.TokenID = types.StringValue(auth.AccessToken.ID)
+		if auth.AccessToken.ExpiresIn != nil {
+			data.ExpiresIn = types.Int64Value(int64(*auth.AccessToken.ExpiresIn))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *herokuxOauthAuthorizationEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, oauthAuthorizationPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var p oauthAuthorizationPrivate
+	if unmarshalErr := json.Unmarshal(private, &p); unmarshalErr != nil {
+		resp.Diagnostics.AddError("Unable to read the OAuth authorization", unmarshalErr.Error())
+		return
+	}
+
+	config := r.config(&resp.Diagnostics)
+	if config == nil {
+		return
+	}
+
+	client, clientErr := platformAPIClient(config, p.AuthAPIKeyName)
+	if clientErr != nil {
+		resp.Diagnostics.AddError("Unable to create the API client", clientErr.Error())
+		return
+	}
+
+	log.Printf("[DEBUG] Deleting ephemeral OAuth authorization %s", p.ID)
+
+	if _, deleteErr := client.OAuthAuthorizationDelete(ctx, p.ID); deleteErr != nil {
+		resp.Diagnostics.AddError("Unable to delete OAuth authorization "+p.ID, deleteErr.Error())
+	}
+}
@@ -0,0 +1,132 @@
+package herokux
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &herokuxPostgresCredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &herokuxPostgresCredentialEphemeralResource{}
+)
+
+type herokuxPostgresCredentialEphemeralResource struct {
+	frameworkMeta
+}
+
+type herokuxPostgresCredentialModel struct {
+	PostgresID types.String                           `tfsdk:"postgres_id"`
+	Name       types.String                           `tfsdk:"name"`
+	UUID       types.String                           `tfsdk:"uuid"`
+	State      types.String                           `tfsdk:"state"`
+	Database   types.String                           `tfsdk:"database"`
+	Host       types.String                           `tfsdk:"host"`
+	Port       types.Int64                            `tfsdk:"port"`
+	Secrets    []herokuxPostgresCredentialSecretModel `tfsdk:"secrets"`
+}
+
+type herokuxPostgresCredentialSecretModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	State    types.String `tfsdk:"state"`
+}
+
+func newHerokuxPostgresCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &herokuxPostgresCredentialEphemeralResource{}
+}
+
+func (r *herokuxPostgresCredentialEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_credential"
+}
+
+func (r *herokuxPostgresCredentialEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the secrets of a Postgres credential without storing them in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"postgres_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID or name of the Postgres database.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the credential.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(ValidCredentialNameRegex),
+						"is restricted to alphanumeric characters(- and _ are also supported) and up to 50 characters"),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+			"database": schema.StringAttribute{
+				Computed: true,
+			},
+			"host": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"port": schema.Int64Attribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			// Protocol version 5 does not support nested attributes, so the secrets are a list of objects.
+			"secrets": schema.ListAttribute{
+				Computed:  true,
+				Sensitive: true,
+				ElementType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"username": types.StringType,
+					"password": types.StringType,
+					"state":    types.StringType,
+				}},
+			},
+		},
+	}
+}
+
+func (r *herokuxPostgresCredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data herokuxPostgresCredentialModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := r.config(&resp.Diagnostics)
+	if config == nil {
+		return
+	}
+
+	cred, _, getErr := config.API.Postgres.GetCredential(ctx, data.PostgresID.ValueString(), data.Name.ValueString())
+	if getErr != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve credential %s", data.Name.ValueString()),
+			getErr.Error())
+		return
+	}
+
+	data.UUID = types.StringValue(cred.GetID())
+	data.State = types.StringValue(cred.State.ToString())
+	data.Database = types.StringValue(cred.GetDatabase())
+	data.Host = types.StringValue(cred.GetHost())
+	data.Port = types.Int64Value(int64(cred.GetPort()))
+
+	data.Secrets = make([]herokuxPostgresCredentialSecretModel, 0, len(cred.Credentials))
+	for _, s := range cred.Credentials {
+		data.Secrets = append(data.Secrets, herokuxPostgresCredentialSecretModel{
+			Username: types.StringValue(s.GetUser()),
+			Password: types.StringValue(s.GetPassword()),
+			State:    types.StringValue(s.GetState()),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,246 @@
+package herokux
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+)
+
+// frameworkProvider serves the features of the provider built on terraform-plugin-framework, such as
+// provider-defined functions and ephemeral resources. It is combined with the SDKv2 provider, which it shares the provider schema with,
+// by NewProviderServer.
+type frameworkProvider struct {
+	sdk *schema.Provider
+}
+
+func newFrameworkProvider(sdk *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdk: sdk}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "herokux"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	s, schemaErr := frameworkProviderSchema(ctx, p.sdk)
+	if schemaErr != nil {
+		resp.Diagnostics.AddError("Unable to convert the provider schema", schemaErr.Error())
+		return
+	}
+
+	resp.Schema = s
+}
+
+// Configure passes the SDKv2 provider, which configures the API clients, to the ephemeral resources.
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.EphemeralResourceData = p.sdk
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		newKafkaDurationToMSFunction,
+		newPostgresPlanNameFunction,
+	}
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newHerokuxConnectODataCredentialEphemeralResource,
+		newHerokuxOauthAuthorizationEphemeralResource,
+		newHerokuxPostgresCredentialEphemeralResource,
+	}
+}
+
+// frameworkMeta gives the ephemeral resources access to the Config of the SDKv2 provider.
+type frameworkMeta struct {
+	sdk *schema.Provider
+}
+
+func (m *frameworkMeta) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if sdk, ok := req.ProviderData.(*schema.Provider); ok {
+		m.sdk = sdk
+	}
+}
+
+// config returns the Config of the SDKv2 provider, which is only set once the provider is configured.
+func (m *frameworkMeta) config(diags *diag.Diagnostics) *Config {
+	if m.sdk != nil {
+		if config, ok := m.sdk.Meta().(*Config); ok {
+			return config
+		}
+	}
+
+	diags.AddError("Provider is not configured", "The provider must be configured to open ephemeral resources.")
+	return nil
+}
+
+// frameworkProviderSchema returns the provider schema of the SDKv2 provider as a framework schema,
+// as the schemas of the combined providers must be identical.
+func frameworkProviderSchema(ctx context.Context, sdk *schema.Provider) (providerschema.Schema, error) {
+	resp, err := schema.NewGRPCProviderServer(sdk).GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return providerschema.Schema{}, err
+	}
+
+	attributes, blocks, convErr := frameworkSchemaBlock(resp.Provider.Block, sdk.Schema)
+	if convErr != nil {
+		return providerschema.Schema{}, convErr
+	}
+
+	return providerschema.Schema{Attributes: attributes, Blocks: blocks}, nil
+}
+
+// frameworkSchemaBlock converts the attributes and nested blocks of b, whose SDKv2 schema is sdkSchema.
+func frameworkSchemaBlock(b *tfprotov5.SchemaBlock, sdkSchema map[string]*schema.Schema) (map[string]providerschema.Attribute, map[string]providerschema.Block, error) {
+	attributes := make(map[string]providerschema.Attribute, len(b.Attributes))
+	for _, a := range b.Attributes {
+		attribute, err := frameworkSchemaAttribute(a, sdkSchema[a.Name].Deprecated)
+		if err != nil {
+			return nil, nil, err
+		}
+		attributes[a.Name] = attribute
+	}
+
+	blocks := make(map[string]providerschema.Block, len(b.BlockTypes))
+	for _, nb := range b.BlockTypes {
+		nestedAttributes, nestedBlocks, err := frameworkSchemaBlock(nb.Block, sdkSchema[nb.TypeName].Elem.(*schema.Resource).Schema)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		description, markdownDescription := frameworkDescriptions(nb.Block.Description, nb.Block.DescriptionKind)
+		deprecationMessage := sdkSchema[nb.TypeName].Deprecated
+		object := providerschema.NestedBlockObject{Attributes: nestedAttributes, Blocks: nestedBlocks}
+
+		switch nb.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			blocks[nb.TypeName] = providerschema.ListNestedBlock{NestedObject: object, Description: description,
+				MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			blocks[nb.TypeName] = providerschema.SetNestedBlock{NestedObject: object, Description: description,
+				MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}
+		case tfprotov5.SchemaNestedBlockNestingModeSingle:
+			blocks[nb.TypeName] = providerschema.SingleNestedBlock{Attributes: nestedAttributes, Blocks: nestedBlocks,
+				Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}
+		default:
+			return nil, nil, fmt.Errorf("unsupported nesting mode %s of block %s", nb.Nesting, nb.TypeName)
+		}
+	}
+
+	return attributes, blocks, nil
+}
+
+func frameworkSchemaAttribute(a *tfprotov5.SchemaAttribute, deprecationMessage string) (providerschema.Attribute, error) {
+	if a.Computed {
+		return nil, fmt.Errorf("provider attribute %s cannot be computed", a.Name)
+	}
+
+	description, markdownDescription := frameworkDescriptions(a.Description, a.DescriptionKind)
+
+	switch typ := a.Type.(type) {
+	case tftypes.List, tftypes.Set, tftypes.Map:
+		elemType, err := frameworkAttrType(typ)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", a.Name, err)
+		}
+
+		switch elemType := elemType.(type) {
+		case types.ListType:
+			return providerschema.ListAttribute{ElementType: elemType.ElemType, Required: a.Required, Optional: a.Optional,
+				Sensitive: a.Sensitive, Description: description, MarkdownDescription: markdownDescription,
+				DeprecationMessage: deprecationMessage}, nil
+		case types.SetType:
+			return providerschema.SetAttribute{ElementType: elemType.ElemType, Required: a.Required, Optional: a.Optional,
+				Sensitive: a.Sensitive, Description: description, MarkdownDescription: markdownDescription,
+				DeprecationMessage: deprecationMessage}, nil
+		default:
+			return providerschema.MapAttribute{ElementType: elemType.(types.MapType).ElemType, Required: a.Required,
+				Optional: a.Optional, Sensitive: a.Sensitive, Description: description,
+				MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+		}
+	}
+
+	switch {
+	case a.Type.Is(tftypes.String):
+		return providerschema.StringAttribute{Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive,
+			Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+	case a.Type.Is(tftypes.Number):
+		return providerschema.NumberAttribute{Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive,
+			Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+	case a.Type.Is(tftypes.Bool):
+		return providerschema.BoolAttribute{Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive,
+			Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s of attribute %s", a.Type, a.Name)
+}
+
+// frameworkAttrType returns the framework type of a primitive or collection of primitives.
+func frameworkAttrType(typ tftypes.Type) (attr.Type, error) {
+	var elemType tftypes.Type
+
+	switch t := typ.(type) {
+	case tftypes.List:
+		elemType = t.ElementType
+	case tftypes.Set:
+		elemType = t.ElementType
+	case tftypes.Map:
+		elemType = t.ElementType
+	default:
+		switch {
+		case typ.Is(tftypes.String):
+			return types.StringType, nil
+		case typ.Is(tftypes.Number):
+			return types.NumberType, nil
+		case typ.Is(tftypes.Bool):
+			return types.BoolType, nil
+		}
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+
+	elem, err := frameworkAttrType(elemType)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ.(type) {
+	case tftypes.List:
+		return types.ListType{ElemType: elem}, nil
+	case tftypes.Set:
+		return types.SetType{ElemType: elem}, nil
+	default:
+		return types.MapType{ElemType: elem}, nil
+	}
+}
+
+func frameworkDescriptions(description string, kind tfprotov5.StringKind) (string, string) {
+	if kind == tfprotov5.StringKindMarkdown {
+		return "", description
+	}
+	return description, ""
+}
@@ -0,0 +1,51 @@
+package herokux
+
+import (
+	"context"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &kafkaDurationToMSFunction{}
+
+type kafkaDurationToMSFunction struct{}
+
+func newKafkaDurationToMSFunction() function.Function {
+	return &kafkaDurationToMSFunction{}
+}
+
+func (f *kafkaDurationToMSFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kafka_duration_to_ms"
+}
+
+func (f *kafkaDurationToMSFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a Kafka retention duration to milliseconds",
+		MarkdownDescription: "Converts a duration such as `1d`, `12h` or `2 weeks` to the number of milliseconds, " +
+			"as expected by the retention.ms topic configuration.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "duration",
+				MarkdownDescription: "The duration, made of a number and a unit of `ms`, `s`, `m`, `h`, `d` or `w`.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *kafkaDurationToMSFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var duration string
+	resp.Error = req.Arguments.Get(ctx, &duration)
+	if resp.Error != nil {
+		return
+	}
+
+	ms, convErr := kafka.ConvertDurationToMilliseconds(duration)
+	if convErr != nil {
+		resp.Error = function.NewArgumentFuncError(0, "unsupported duration "+duration+
+			": format needs to be a number followed by ms, s, m, h, d or w")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, int64(ms))
+}
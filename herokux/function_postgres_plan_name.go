@@ -0,0 +1,61 @@
+package herokux
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"strings"
+)
+
+const postgresAddonService = "heroku-postgresql"
+
+var _ function.Function = &postgresPlanNameFunction{}
+
+type postgresPlanNameFunction struct{}
+
+func newPostgresPlanNameFunction() function.Function {
+	return &postgresPlanNameFunction{}
+}
+
+func (f *postgresPlanNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "postgres_plan_name"
+}
+
+func (f *postgresPlanNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a Heroku Postgres plan name",
+		MarkdownDescription: "Returns the full plan name, such as `heroku-postgresql:standard-0`, of a plan written with or " +
+			"without the `heroku-postgresql:` prefix and in any case.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "plan",
+				MarkdownDescription: "The plan name, such as `standard-0` or `heroku-postgresql:standard-0`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *postgresPlanNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var plan string
+	resp.Error = req.Arguments.Get(ctx, &plan)
+	if resp.Error != nil {
+		return
+	}
+
+	name := normalizePostgresPlanName(plan)
+	if name == postgresAddonService+":" {
+		resp.Error = function.NewArgumentFuncError(0, "plan must not be empty")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, name)
+}
+
+// normalizePostgresPlanName returns the lowercase plan name prefixed with heroku-postgresql:, which is added
+// unless the plan already has it.
+func normalizePostgresPlanName(plan string) string {
+	plan = strings.ToLower(strings.TrimSpace(plan))
+	plan = strings.TrimPrefix(plan, postgresAddonService+":")
+
+	return postgresAddonService + ":" + strings.TrimSpace(plan)
+}
@@ -2,7 +2,6 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
@@ -10,7 +9,7 @@ import (
 
 func TestAccHerokuxAppWebhook_importBasic(t *testing.T) {
 	appID := testAccConfig.GetAppIDorSkip(t)
-	name := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	name := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
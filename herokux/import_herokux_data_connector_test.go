@@ -2,7 +2,6 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
@@ -11,7 +10,7 @@ import (
 func TestAccHerokuxDataConnector_importBasic(t *testing.T) {
 	postgresID := testAccConfig.GetAddonIDorSkip(t)
 	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
-	name := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	name := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
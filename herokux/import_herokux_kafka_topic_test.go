@@ -2,14 +2,13 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
 )
 
 func TestAccHerokuxKafkaTopic_importBasic(t *testing.T) {
 	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
-	topicName := fmt.Sprintf("tftest-%s", acctest.RandString(15))
+	topicName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 15))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
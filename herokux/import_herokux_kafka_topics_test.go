@@ -0,0 +1,47 @@
+package herokux
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
+	"testing"
+)
+
+func TestAccHerokuxKafkaTopics_importBasic(t *testing.T) {
+	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
+	prefix := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuxKafkaTopics_basic(kafkaID, prefix, "1d"),
+			},
+			{
+				ResourceName: "herokux_kafka_topics.foobar",
+				ImportState:  true,
+				// All topics of the cluster are imported, which may include topics not created by this test.
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					names := make(map[string]bool)
+					for k, v := range states[0].Attributes {
+						if strings.HasPrefix(k, "topic.") && strings.HasSuffix(k, ".name") {
+							names[v] = true
+						}
+					}
+
+					for _, name := range []string{prefix + "-orders", prefix + "-customers"} {
+						if !names[name] {
+							return fmt.Errorf("topic %s was not imported", name)
+						}
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -2,15 +2,14 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"strings"
 	"testing"
 )
 
 func TestAccHerokuxPostgresConnectionPooling_importBasic(t *testing.T) {
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
-	name := strings.ToUpper(fmt.Sprintf("tftest_%s", acctest.RandString(10)))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
+	name := strings.ToUpper(fmt.Sprintf("tftest_%s", testAccConfig.RandString(t, 10)))
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccHerokuxPostgresConnectionPooling_importBasic(t *testing.T) {
 }
 
 func TestAccHerokuxPostgresConnectionPooling_importNoName(t *testing.T) {
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
 
 	resource.Test(t, resource.TestCase{
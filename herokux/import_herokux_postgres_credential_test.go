@@ -2,14 +2,13 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
 )
 
 func TestAccHerokuxPostgresCredential_importBasic(t *testing.T) {
 	postgresID := testAccConfig.GetAddonIDorSkip(t)
-	name := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	name := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -2,7 +2,6 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
@@ -10,7 +9,7 @@ import (
 
 func TestAccHerokuxPostgresDataclipTeamAssociation_importBasic(t *testing.T) {
 	attachmentID := testAccConfig.GetAttachmentIDorSkip(t)
-	title := fmt.Sprintf("tftest_%s", acctest.RandString(10))
+	title := fmt.Sprintf("tftest_%s", testAccConfig.RandString(t, 10))
 	teamID := testAccConfig.GetTeamIDorSkip(t)
 	sql := "select * from pg_catalog"
 
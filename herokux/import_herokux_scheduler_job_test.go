@@ -2,7 +2,6 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
@@ -10,7 +9,7 @@ import (
 
 func TestAccHerokuxSchedulerJob_importBasic(t *testing.T) {
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	plan := "scheduler:standard"
 	frequency := "every_day_at_17:30"
 
@@ -0,0 +1,234 @@
+package herokux
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"sync"
+)
+
+// kafkaTopicDefaultRetentionTime is the retention_time of a topic whose cleanup policy is not compact
+// if not configured.
+const kafkaTopicDefaultRetentionTime = "1d"
+
+// kafkaTopicPlans tracks the topics planned for creation by all herokux_kafka_topic resources, keyed by cluster,
+// so the cluster-wide limits, such as the maximum number of topics, account for every topic in the plan
+// and not only the one being diffed.
+type kafkaTopicPlans struct {
+	mu       sync.Mutex
+	clusters map[string]*kafkaClusterTopicPlan
+}
+
+type kafkaClusterTopicPlan struct {
+	// planned are the partitions of the topics to be created, keyed by name.
+	planned map[string]int
+
+	// replaced are the names of existing topics to be destroyed because they are replaced.
+	replaced map[string]bool
+}
+
+// add records a topic to be created, which replaces the existing topic named replaces if not empty,
+// and returns the partitions of all topics the cluster will have once the plan is applied, keyed by name.
+func (p *kafkaTopicPlans) add(clusterID, name string, partitions int, replaces string, existing []*kafka.Topic) map[string]int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.clusters == nil {
+		p.clusters = make(map[string]*kafkaClusterTopicPlan)
+	}
+
+	c, ok := p.clusters[clusterID]
+	if !ok {
+		c = &kafkaClusterTopicPlan{planned: make(map[string]int), replaced: make(map[string]bool)}
+		p.clusters[clusterID] = c
+	}
+
+	c.planned[name] = partitions
+	if replaces != "" {
+		c.replaced[replaces] = true
+	}
+
+	topics := make(map[string]int, len(existing)+len(c.planned))
+	for _, t := range existing {
+		if !c.replaced[t.GetName()] {
+			topics[t.GetName()] = t.GetPartitions()
+		}
+	}
+
+	for n, partitions := range c.planned {
+		topics[n] = partitions
+	}
+
+	return topics
+}
+
+// resourceHerokuxKafkaTopicCustomizeDiff fails the plan if the topic is outside the limits or capabilities
+// of its cluster, which the API would otherwise only report when applying.
+func resourceHerokuxKafkaTopicCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := setKafkaTopicDefaultRetentionTime(d); err != nil {
+		return err
+	}
+
+	isNew := d.Id() == "" || d.HasChanges("kafka_id", "name", "partitions")
+	if !isNew && !d.HasChanges("replication_factor", "retention_time", "cleanup_policy") {
+		return nil
+	}
+
+	policy := configuredCleanupPolicy(d.GetRawConfig())
+	if policy != "" && d.NewValueKnown("retention_time") {
+		if err := validateKafkaTopicCleanupPolicy(policy, d.Get("retention_time").(string)); err != nil {
+			return err
+		}
+	}
+
+	// The cluster may not exist yet.
+	if !d.NewValueKnown("kafka_id") {
+		return nil
+	}
+
+	config := meta.(*Config)
+	kafkaID := d.Get("kafka_id").(string)
+
+	cluster, _, getErr := config.API.Kafka.Get(ctx, kafkaID)
+	if getErr != nil {
+		return fmt.Errorf("unable to retrieve kafka %s to validate the topic against its limits: %w", kafkaID, getErr)
+	}
+
+	if policy == kafka.TopicCleanupPolicies.COMPACT_DELETE && !cluster.GetCapabilities().GetSupportsMixedCleanupPolicy() {
+		return fmt.Errorf("kafka %s does not support the %q cleanup_policy", kafkaID, policy)
+	}
+
+	limits := cluster.GetLimits()
+	if limits == nil {
+		log.Printf("[DEBUG] kafka %s has no limits, skipping topic validation", kafkaID)
+		return nil
+	}
+
+	if d.NewValueKnown("replication_factor") {
+		rf := d.Get("replication_factor").(int)
+		if (limits.MinimumReplication != nil && rf < limits.GetMinimumReplication()) ||
+			(limits.MaximumReplication != nil && rf > limits.GetMaximumReplication()) {
+			return fmt.Errorf("replication_factor %d is outside the range allowed by kafka %s: %d to %d",
+				rf, kafkaID, limits.GetMinimumReplication(), limits.GetMaximumReplication())
+		}
+	}
+
+	if d.NewValueKnown("retention_time") {
+		if err := validateKafkaTopicRetentionTime(d.Get("retention_time").(string), kafkaID, limits); err != nil {
+			return err
+		}
+	}
+
+	if !isNew || !d.NewValueKnown("name") || !d.NewValueKnown("partitions") {
+		return nil
+	}
+
+	partitions := d.Get("partitions").(int)
+	if limits.MaxNumberOfPartitionsPerTopic != nil && partitions > limits.GetMaxNumberOfPartitionsPerTopic() {
+		return fmt.Errorf("partitions %d exceeds the maximum number of partitions per topic allowed by kafka %s: %d",
+			partitions, kafkaID, limits.GetMaxNumberOfPartitionsPerTopic())
+	}
+
+	if limits.MaxNumberOfTotalPartitions == nil && limits.MaxNumberOfTopics == nil {
+		return nil
+	}
+
+	existing, _, listErr := config.API.Kafka.ListTopics(ctx, kafkaID)
+	if listErr != nil {
+		return fmt.Errorf("unable to retrieve the topics of kafka %s to validate the topic against its limits: %w",
+			kafkaID, listErr)
+	}
+
+	// The existing topic is destroyed before its replacement is created, unless it is on another cluster.
+	var replaces string
+	if d.Id() != "" {
+		oldKafkaID, _ := d.GetChange("kafka_id")
+		oldName, _ := d.GetChange("name")
+		if oldKafkaID.(string) == kafkaID {
+			replaces = oldName.(string)
+		}
+	}
+
+	topics := config.kafkaTopicPlans.add(kafkaID, d.Get("name").(string), partitions, replaces, existing.Topics)
+
+	return validateKafkaClusterTopics(topics, kafkaID, limits)
+}
+
+// setKafkaTopicDefaultRetentionTime plans the default retention_time of the cleanup policy if retention_time
+// is not configured: disabled for a compact policy, whose messages are never deleted, and one day otherwise.
+func setKafkaTopicDefaultRetentionTime(d *schema.ResourceDiff) error {
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.IsKnown() || rawConfig.IsNull() || !rawConfig.Type().HasAttribute("retention_time") ||
+		!rawConfig.GetAttr("retention_time").IsNull() {
+		return nil
+	}
+
+	if !rawConfig.GetAttr("cleanup_policy").IsKnown() {
+		return d.SetNewComputed("retention_time")
+	}
+
+	retentionTime := kafkaTopicDefaultRetentionTime
+	if configuredCleanupPolicy(rawConfig) == kafka.TopicCleanupPolicies.COMPACT {
+		retentionTime = kafka.RetentionTimeDisableVal
+	}
+
+	return d.SetNew("retention_time", retentionTime)
+}
+
+// validateKafkaTopicCleanupPolicy returns an error if the retention time does not match the cleanup policy.
+// Messages are only deleted after the retention time, so it must be disabled if and only if the policy is compact.
+func validateKafkaTopicCleanupPolicy(policy kafka.TopicCleanupPolicy, retentionTime string) error {
+	disabled := retentionTime == kafka.RetentionTimeDisableVal
+
+	switch {
+	case policy == kafka.TopicCleanupPolicies.COMPACT && !disabled:
+		return fmt.Errorf("retention_time must be %q when cleanup_policy is %q", kafka.RetentionTimeDisableVal, policy)
+	case policy != kafka.TopicCleanupPolicies.COMPACT && disabled:
+		return fmt.Errorf("retention_time cannot be %q when cleanup_policy is %q", kafka.RetentionTimeDisableVal, policy)
+	}
+
+	return nil
+}
+
+// validateKafkaTopicRetentionTime returns an error if the retention time is outside the limits of the cluster.
+func validateKafkaTopicRetentionTime(retentionTime, kafkaID string, limits *kafka.ClusterLimits) error {
+	if retentionTime == kafka.RetentionTimeDisableVal {
+		return nil
+	}
+
+	ms, convErr := kafka.ConvertDurationToMilliseconds(retentionTime)
+	if convErr != nil {
+		return convErr
+	}
+
+	if (limits.MinimumReplicationMS != nil && int64(ms) < limits.GetMinimumReplicationMS()) ||
+		(limits.MaximumReplicationMS != nil && int64(ms) > limits.GetMaximumReplicationMS()) {
+		return fmt.Errorf("retention_time %s (%dms) is outside the range allowed by kafka %s: %dms to %dms",
+			retentionTime, ms, kafkaID, limits.GetMinimumReplicationMS(), limits.GetMaximumReplicationMS())
+	}
+
+	return nil
+}
+
+// validateKafkaClusterTopics returns an error if the topics of a cluster, with their partitions keyed by name,
+// exceed the maximum number of topics or partitions of the cluster.
+func validateKafkaClusterTopics(topics map[string]int, kafkaID string, limits *kafka.ClusterLimits) error {
+	if limits.MaxNumberOfTopics != nil && len(topics) > limits.GetMaxNumberOfTopics() {
+		return fmt.Errorf("kafka %s would have %d topics, which exceeds its maximum number of topics: %d",
+			kafkaID, len(topics), limits.GetMaxNumberOfTopics())
+	}
+
+	total := 0
+	for _, partitions := range topics {
+		total += partitions
+	}
+
+	if limits.MaxNumberOfTotalPartitions != nil && total > limits.GetMaxNumberOfTotalPartitions() {
+		return fmt.Errorf("kafka %s would have %d partitions across all topics, which exceeds its maximum: %d",
+			kafkaID, total, limits.GetMaxNumberOfTotalPartitions())
+	}
+
+	return nil
+}
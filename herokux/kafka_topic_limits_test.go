@@ -0,0 +1,212 @@
+package herokux
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/mock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const testKafkaID = "6b5e1f4e-8a3b-4c0b-9e3d-1f2a3b4c5d6e"
+
+// testKafkaTopicConfig returns a Config whose cluster is unmarshalled from clusterJSON and has a single existing topic
+// with 100 partitions.
+func testKafkaTopicConfig(t *testing.T, clusterJSON string) *Config {
+	var cluster *kafka.Cluster
+	assert.Nil(t, json.Unmarshal([]byte(clusterJSON), &cluster))
+
+	var topics *kafka.Topics
+	assert.Nil(t, json.Unmarshal([]byte(`{"topics": [{"name": "existing", "partitions": 100}]}`), &topics))
+
+	return &Config{API: &api.Client{Kafka: &mock.KafkaService{
+		GetFunc: func(ctx context.Context, clusterID string) (*kafka.Cluster, *simpleresty.Response, error) {
+			return cluster, nil, nil
+		},
+		ListTopicsFunc: func(ctx context.Context, clusterID string) (*kafka.Topics, *simpleresty.Response, error) {
+			return topics, nil, nil
+		},
+	}}}
+}
+
+func testKafkaTopicDiff(config *Config, state *terraform.InstanceState, raw map[string]interface{}) error {
+	raw["kafka_id"] = testKafkaID
+	_, err := resourceHerokuxKafkaTopic().Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), config)
+	return err
+}
+
+func TestResourceHerokuxKafkaTopicCustomizeDiff(t *testing.T) {
+	cluster := `{"limits": {"minimum_replication": 3, "maximum_replication": 5, "minimum_retention_ms": 86400000,
+		"maximum_retention_ms": 1209600000, "max_number_of_partitions_per_topic": 256}}`
+
+	testCases := []struct {
+		name string
+		raw  map[string]interface{}
+		err  string
+	}{
+		{
+			name: "within limits",
+			raw:  map[string]interface{}{"name": "orders", "partitions": 8, "replication_factor": 5, "retention_time": "2w"},
+		},
+		{
+			name: "retention disabled",
+			raw:  map[string]interface{}{"name": "orders", "partitions": 8, "retention_time": "disable"},
+		},
+		{
+			name: "replication factor too high",
+			raw:  map[string]interface{}{"name": "orders", "partitions": 8, "replication_factor": 6},
+			err:  "replication_factor 6 is outside the range allowed by kafka " + testKafkaID + ": 3 to 5",
+		},
+		{
+			name: "retention time too long",
+			raw:  map[string]interface{}{"name": "orders", "partitions": 8, "retention_time": "3w"},
+			err:  "retention_time 3w (1814400000ms) is outside the range allowed by kafka " + testKafkaID,
+		},
+		{
+			name: "too many partitions",
+			raw:  map[string]interface{}{"name": "orders", "partitions": 512},
+			err:  "partitions 512 exceeds the maximum number of partitions per topic allowed by kafka " + testKafkaID + ": 256",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := testKafkaTopicDiff(testKafkaTopicConfig(t, cluster), nil, tc.raw)
+			if tc.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), tc.err)
+			}
+		})
+	}
+}
+
+func TestResourceHerokuxKafkaTopicCustomizeDiff_MaxNumberOfTopics(t *testing.T) {
+	config := testKafkaTopicConfig(t, `{"limits": {"max_number_of_topics": 3}}`)
+
+	assert.Nil(t, testKafkaTopicDiff(config, nil, map[string]interface{}{"name": "a", "partitions": 1}))
+	assert.Nil(t, testKafkaTopicDiff(config, nil, map[string]interface{}{"name": "b", "partitions": 1}))
+
+	// Diffing the same topic again does not count it twice.
+	assert.Nil(t, testKafkaTopicDiff(config, nil, map[string]interface{}{"name": "b", "partitions": 1}))
+
+	err := testKafkaTopicDiff(config, nil, map[string]interface{}{"name": "c", "partitions": 1})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "would have 4 topics, which exceeds its maximum number of topics: 3")
+	}
+}
+
+func TestResourceHerokuxKafkaTopicCustomizeDiff_MaxNumberOfTotalPartitions(t *testing.T) {
+	config := testKafkaTopicConfig(t, `{"limits": {"max_number_of_total_partitions": 200}}`)
+
+	assert.Nil(t, testKafkaTopicDiff(config, nil, map[string]interface{}{"name": "a", "partitions": 60}))
+
+	err := testKafkaTopicDiff(config, nil, map[string]interface{}{"name": "b", "partitions": 60})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "would have 220 partitions across all topics, which exceeds its maximum: 200")
+	}
+}
+
+func TestResourceHerokuxKafkaTopicCustomizeDiff_Replacement(t *testing.T) {
+	config := testKafkaTopicConfig(t, `{"limits": {"max_number_of_topics": 1, "max_number_of_total_partitions": 150}}`)
+	state := &terraform.InstanceState{
+		ID: testKafkaID + ":existing",
+		Attributes: map[string]string{
+			"kafka_id":           testKafkaID,
+			"name":               "existing",
+			"partitions":         "100",
+			"replication_factor": "3",
+			"retention_time":     "1d",
+		},
+	}
+
+	// The existing topic is replaced, so it is not counted.
+	assert.Nil(t, testKafkaTopicDiff(config, state, map[string]interface{}{"name": "renamed", "partitions": 150}))
+}
+
+func TestResourceHerokuxKafkaTopicCustomizeDiff_CleanupPolicy(t *testing.T) {
+	testCases := []struct {
+		name          string
+		policy        string
+		retentionTime string
+		mixed         bool
+		err           string
+	}{
+		{name: "delete", policy: "delete", retentionTime: "1d"},
+		{name: "compact", policy: "compact", retentionTime: "disable"},
+		{name: "compact with retention", policy: "compact", retentionTime: "1d",
+			err: `retention_time must be "disable" when cleanup_policy is "compact"`},
+		{name: "delete without retention", policy: "delete", retentionTime: "disable",
+			err: `retention_time cannot be "disable" when cleanup_policy is "delete"`},
+		{name: "mixed", policy: "compact,delete", retentionTime: "1d", mixed: true},
+		{name: "mixed not supported", policy: "compact,delete", retentionTime: "1d",
+			err: `kafka ` + testKafkaID + ` does not support the "compact,delete" cleanup_policy`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := testKafkaTopicConfig(t, fmt.Sprintf(`{"capabilities": {"supports_mixed_cleanup_policy": %t}}`, tc.mixed))
+			state := &terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{"cleanup_policy": cty.StringVal(tc.policy)}),
+			}
+
+			err := testKafkaTopicDiff(config, state, map[string]interface{}{
+				"name":           "orders",
+				"partitions":     8,
+				"retention_time": tc.retentionTime,
+				"cleanup_policy": tc.policy,
+			})
+			if tc.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), tc.err)
+			}
+		})
+	}
+}
+
+func TestResourceHerokuxKafkaTopicCustomizeDiff_DefaultRetentionTime(t *testing.T) {
+	testCases := []struct {
+		name          string
+		policy        cty.Value
+		retentionTime string
+	}{
+		{name: "no policy", policy: cty.NullVal(cty.String), retentionTime: "1d"},
+		{name: "delete", policy: cty.StringVal("delete"), retentionTime: "1d"},
+		{name: "compact", policy: cty.StringVal("compact"), retentionTime: "disable"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw := map[string]interface{}{"kafka_id": testKafkaID, "name": "orders", "partitions": 8}
+			if !tc.policy.IsNull() {
+				raw["cleanup_policy"] = tc.policy.AsString()
+			}
+
+			state := &terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{
+					"cleanup_policy": tc.policy,
+					"retention_time": cty.NullVal(cty.String),
+				}),
+			}
+
+			diff, err := resourceHerokuxKafkaTopic().Diff(context.Background(), state,
+				terraform.NewResourceConfigRaw(raw), testKafkaTopicConfig(t, `{}`))
+			if assert.Nil(t, err) {
+				assert.Equal(t, tc.retentionTime, diff.Attributes["retention_time"].New)
+			}
+		})
+	}
+}
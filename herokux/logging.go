@@ -0,0 +1,28 @@
+package herokux
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiLogSubsystem is the logging subsystem for requests made by the api client.
+//
+// Requests are logged with TF_LOG=DEBUG and their headers and bodies with TF_LOG=TRACE.
+// TF_LOG_PROVIDER_HEROKUX_API sets the level for this subsystem only.
+const apiLogSubsystem = "herokux_api"
+
+// apiLogger logs requests made by the api client to the apiLogSubsystem.
+type apiLogger struct{}
+
+func (apiLogger) Debug(ctx context.Context, msg string, fields map[string]interface{}) {
+	tflog.SubsystemDebug(newAPILogSubsystem(ctx), apiLogSubsystem, msg, fields)
+}
+
+func (apiLogger) Trace(ctx context.Context, msg string, fields map[string]interface{}) {
+	tflog.SubsystemTrace(newAPILogSubsystem(ctx), apiLogSubsystem, msg, fields)
+}
+
+// newAPILogSubsystem returns a copy of ctx with the apiLogSubsystem logger.
+func newAPILogSubsystem(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, apiLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_HEROKUX_API"))
+}
@@ -31,61 +31,127 @@ func New() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("HEROKU_API_KEY", nil),
 			},
 
+			"api_key_command": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"oauth": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+
+						"refresh_token": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
 			"metrics_api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "Use endpoints.metrics instead.",
 				DefaultFunc: schema.EnvDefaultFunc("HEROKUX_METRICS_API_URL", api.DefaultMetricAPIBaseURL),
 			},
 
 			"postgres_api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "Use endpoints.postgres instead.",
 				DefaultFunc: schema.EnvDefaultFunc("HEROKUX_POSTGRES_API_URL", api.DefaultPostgresAPIBaseURL),
 			},
 
 			"data_api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "Use endpoints.data instead.",
 				DefaultFunc: schema.EnvDefaultFunc("HEROKUX_DATA_API_URL", api.DefaultDataAPIBaseURL),
 			},
 
 			"redis_api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "Use endpoints.redis instead.",
 				DefaultFunc: schema.EnvDefaultFunc("HEROKUX_REDIS_API_URL", api.DefaultRedisAPIBaseURL),
 			},
 
 			"connect_central_api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "Use endpoints.connect_central instead.",
 				DefaultFunc: schema.EnvDefaultFunc("HEROKUX_CONNECT_CENTRAL_API_URL", api.DefaultConnectCentralBaseURL),
 			},
 
 			"registry_api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "Use endpoints.registry instead.",
 				DefaultFunc: schema.EnvDefaultFunc("HEROKUX_REGISTRY_API_URL", api.DefaultRegistryBaseURL),
 			},
 
 			"kolkrabbi_api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "Use endpoints.kolkrabbi instead.",
 				DefaultFunc: schema.EnvDefaultFunc("HEROKUX_KOLKRABBI_API_URL", api.DefaultKolkrabbiAPIBaseURL),
 			},
 
 			"scheduler_api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "Use endpoints.scheduler instead.",
 				DefaultFunc: schema.EnvDefaultFunc("HEROKUX_SCHEDULER_API_URL", api.DefaultSchedulerAPIBaseURL),
 			},
 
 			"platform_api_url": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Use endpoints.platform instead.",
 				// Same environment variable to keep things consistent with the Heroku provider.
 				DefaultFunc: schema.EnvDefaultFunc("HEROKU_API_URL", heroku.DefaultURL),
 			},
 
+			"endpoints": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: endpointsSchema(map[string]*schema.Schema{
+						"profile": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					}),
+				},
+			},
+
+			"endpoint_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: endpointsSchema(map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					}),
+				},
+			},
+
 			"headers": {
 				Type:     schema.TypeMap,
 				Elem:     schema.TypeString,
@@ -101,154 +167,154 @@ func New() *schema.Provider {
 						"mtls_provision_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultMTLSProvisionVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_postgres_mtls instead.",
 							ValidateFunc: validation.IntAtLeast(5),
 						},
 
 						"mtls_deprovision_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultMTLSMTLSDeprovisionVerifyTimeout,
+							Deprecated:   "Use the delete argument of the timeouts block of herokux_postgres_mtls instead.",
 							ValidateFunc: validation.IntAtLeast(5),
 						},
 
 						"mtls_iprule_create_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultMTLSIPRuleCreateVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_postgres_mtls_iprule and herokux_kafka_mtls_iprule instead.",
 							ValidateFunc: validation.IntAtLeast(10),
 						},
 
 						"mtls_certificate_create_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultMTLSCertificateCreateVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_postgres_mtls_certificate instead.",
 							ValidateFunc: validation.IntAtLeast(1),
 						},
 
 						"mtls_certificate_delete_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultMTLSCertificateDeleteVerifyTimeout,
+							Deprecated:   "Use the delete argument of the timeouts block of herokux_postgres_mtls_certificate instead.",
 							ValidateFunc: validation.IntAtLeast(1),
 						},
 
 						"kafka_cg_create_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultKafkaCGCreateVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_kafka_consumer_group instead.",
 							ValidateFunc: validation.IntAtLeast(1),
 						},
 
 						"kafka_cg_delete_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultKafkaCGDeleteVerifyTimeout,
+							Deprecated:   "Use the delete argument of the timeouts block of herokux_kafka_consumer_group instead.",
 							ValidateFunc: validation.IntAtLeast(1),
 						},
 
 						"kafka_topic_create_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultKafkaTopicCreateVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_kafka_topic instead.",
 							ValidateFunc: validation.IntAtLeast(3),
 						},
 
 						"kafka_topic_update_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultKafkaTopicUpdateVerifyTimeout,
+							Deprecated:   "Use the update argument of the timeouts block of herokux_kafka_topic instead.",
 							ValidateFunc: validation.IntAtLeast(3),
 						},
 
 						"privatelink_create_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultPrivatelinkCreateVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_privatelink instead.",
 							ValidateFunc: validation.IntAtLeast(5),
 						},
 
 						"privatelink_delete_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultPrivatelinkDeleteVerifyTimeout,
+							Deprecated:   "Use the delete argument of the timeouts block of herokux_privatelink instead.",
 							ValidateFunc: validation.IntAtLeast(5),
 						},
 
 						"privatelink_allowed_acccounts_add_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultPrivatelinkAllowedAccountsAddVerifyTimeout,
+							Deprecated:   "Use the update argument of the timeouts block of herokux_privatelink instead.",
 							ValidateFunc: validation.IntAtLeast(2),
 						},
 
 						"privatelink_allowed_acccounts_remove_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultPrivatelinkAllowedAccountsRemoveVerifyTimeout,
+							Deprecated:   "This attribute has no effect and will be removed in a future version.",
 							ValidateFunc: validation.IntAtLeast(2),
 						},
 
 						"data_connector_create_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultDataConnectorCreateVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_data_connector instead.",
 							ValidateFunc: validation.IntAtLeast(10),
 						},
 
 						"data_connector_settings_update_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultDataConnectorSettingsUpdateVerifyTimeout,
+							Deprecated:   "Use the update argument of the timeouts block of herokux_data_connector instead.",
 							ValidateFunc: validation.IntAtLeast(10),
 						},
 
 						"data_connector_delete_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultDataConnectorDeleteVerifyTimeout,
+							Deprecated:   "Use the delete argument of the timeouts block of herokux_data_connector instead.",
 							ValidateFunc: validation.IntAtLeast(3),
 						},
 
 						"data_connector_status_update_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultDataConnectorStatusUpdateVerifyTimeout,
+							Deprecated:   "Use the update argument of the timeouts block of herokux_data_connector instead.",
 							ValidateFunc: validation.IntAtLeast(5),
 						},
 
 						"postgres_credential_pre_create_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultPostgresCredentialPreCreateVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_postgres_credential instead.",
 							ValidateFunc: validation.IntAtLeast(20),
 						},
 
 						"postgres_credential_create_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultPostgresCredentialCreateVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_postgres_credential instead.",
 							ValidateFunc: validation.IntAtLeast(5),
 						},
 
 						"postgres_credential_delete_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultPostgresCredentialDeleteVerifyTimeout,
+							Deprecated:   "Use the delete argument of the timeouts block of herokux_postgres_credential instead.",
 							ValidateFunc: validation.IntAtLeast(5),
 						},
 
 						"shield_private_space_create_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultPrivateSpaceCreateVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_shield_private_space instead.",
 							ValidateFunc: validation.IntAtLeast(10),
 						},
 
 						"app_container_release_verify_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      DefaultAppContainerReleaseVerifyTimeout,
+							Deprecated:   "Use the create argument of the timeouts block of herokux_app_container_release instead.",
 							ValidateFunc: validation.IntAtLeast(10),
 						},
 					},
@@ -277,13 +343,45 @@ func New() *schema.Provider {
 					},
 				},
 			},
+
+			"retries": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_retries": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      DefaultRetryMaxRetries,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"min_wait": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      DefaultRetryMinWait,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"max_wait": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      DefaultRetryMaxWait,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			//"herokux_connect": dataSourceHerokuxConnect(),
 			"herokux_addons":                    dataSourceHerokuxAddons(),
 			"herokux_app_addons":                dataSourceHerokuxAppAddons(),
+			"herokux_kafka_cluster":             dataSourceHerokuxKafkaCluster(),
 			"herokux_kafka_mtls_iprules":        dataSourceHerokuxMTLSIPRules(),
+			"herokux_kafka_topics":              dataSourceHerokuxKafkaTopics(),
 			"herokux_postgres_mtls_certificate": dataSourceHerokuxPostgresMTLSCertificate(),
 			"herokux_registry_image":            dataSourceHerokuxRegistryImage(),
 			"herokux_space_apps":                dataSourceHerokuxSpaceApps(),
@@ -300,6 +398,7 @@ func New() *schema.Provider {
 			"herokux_kafka_consumer_group":               resourceHerokuxKafkaConsumerGroup(),
 			"herokux_kafka_mtls_iprule":                  resourceHerokuxKafkaMTLSIPRule(),
 			"herokux_kafka_topic":                        resourceHerokuxKafkaTopic(),
+			"herokux_kafka_topics":                       resourceHerokuxKafkaTopics(),
 			"herokux_oauth_authorization":                resourceHerokuxOauthAuthorization(),
 			"herokux_pipeline_ephemeral_apps_config":     resourceHerokuxPipelineEphemeralAppsConfig(),
 			"herokux_pipeline_github_integration":        resourceHerokuxPipelineGithubIntegration(),
@@ -329,13 +428,16 @@ func New() *schema.Provider {
 	}
 }
 
-func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	return configureProvider(ctx, d, NewConfig())
+}
+
+// configureProvider applies the provider schema to config and initializes the API clients.
+func configureProvider(_ context.Context, d *schema.ResourceData, config *Config) (interface{}, diag.Diagnostics) {
 	log.Println("[INFO] Initializing HerokuX Provider")
 
 	var diags diag.Diagnostics
 
-	config := NewConfig()
-
 	if applySchemaErr := config.applySchema(d); applySchemaErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -346,18 +448,18 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		return nil, diags
 	}
 
-	if applyNetrcErr := config.applyNetrcFile(); applyNetrcErr != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to read and apply netrc file",
-			Detail:   applyNetrcErr.Error(),
-		})
-
-		return nil, diags
-	}
-
-	if token, ok := d.GetOk("api_key"); ok {
-		config.token = token.(string)
+	if config.tokenSource == nil {
+		if token, ok := d.GetOk("api_key"); ok {
+			config.setAPIKey(token.(string))
+		} else if applyNetrcErr := config.applyNetrcFile(); applyNetrcErr != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and apply netrc file",
+				Detail:   applyNetrcErr.Error(),
+			})
+
+			return nil, diags
+		}
 	}
 
 	if err := config.initializeAPI(); err != nil {
@@ -1,8 +1,14 @@
 package herokux
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	helper "github.com/davidji99/terraform-provider-herokux/helper/test"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,12 +18,51 @@ var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 var testAccConfig *helper.TestConfig
 
+// replayableTests are the acceptance tests with a cassette in test-fixtures/cassettes.
+// When replaying, these tests fail if their cassette is missing and all other tests are skipped.
+var replayableTests = []string{
+	"TestAccHerokuxConnectMapping_Basic",
+	"TestAccHerokuxConnectMapping_ExternalFileBasic",
+	"TestAccHerokuxConnectMapping_Update",
+	"TestAccHerokuxConnectMapping_importBasic",
+	"TestAccHerokuxKafkaTopic_Basic",
+	"TestAccHerokuxKafkaTopic_CleanupPolicy",
+	"TestAccHerokuxKafkaTopic_DisableRetention",
+	"TestAccHerokuxKafkaTopic_Simple",
+	"TestAccHerokuxKafkaTopic_UpdatePlan",
+	"TestAccHerokuxKafkaTopic_importBasic",
+	"TestAccHerokuxKafkaTopics_Basic",
+	"TestAccHerokuxKafkaTopics_importBasic",
+	"TestAccHerokuxShieldPrivateSpace_Basic",
+	"TestAccHerokuxShieldPrivateSpace_importBasic",
+}
+
 func init() {
+	testAccConfig = helper.NewTestConfig()
+	testAccConfig.VCR().ExpectCassettes(replayableTests...)
 	testAccProvider = New()
+	testAccProvider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		config := NewConfig()
+		if testAccConfig.VCR().Mode() != helper.VCRModeOff {
+			config.Transport = testAccConfig.VCR()
+		}
+		meta, diags := configureProvider(ctx, d, config)
+
+		// Replayed mappings are applied immediately, so there is no need to wait before reading them.
+		if testAccConfig.VCR().Mode() == helper.VCRModeReplay {
+			config.ConnectMappingModifyDelay = 0
+		}
+
+		return meta, diags
+	}
 	testAccProviders = map[string]*schema.Provider{
 		"herokux": testAccProvider,
 	}
-	testAccConfig = helper.NewTestConfig()
+
+	// Replayed interactions are instant, so there is no need to wait between polls.
+	if testAccConfig.VCR().Mode() == helper.VCRModeReplay {
+		StateRefreshPollInterval = time.Millisecond
+	}
 }
 
 func TestProvider(t *testing.T) {
@@ -30,6 +75,18 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = New()
 }
 
+func TestProvider_ReplayableTestsHaveCassettes(t *testing.T) {
+	cassettes, err := filepath.Glob(filepath.Join(helper.DefaultCassetteDir, "*.json"))
+	assert.Nil(t, err)
+
+	names := make([]string, 0, len(cassettes))
+	for _, cassette := range cassettes {
+		names = append(names, strings.TrimSuffix(filepath.Base(cassette), ".json"))
+	}
+
+	assert.ElementsMatch(t, replayableTests, names)
+}
+
 func testAccPreCheck(t *testing.T) {
 	testAccConfig.GetOrAbort(t, helper.TestConfigHerokuxAPIKey)
 }
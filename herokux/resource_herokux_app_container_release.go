@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	heroku "github.com/davidji99/heroku-go/v5"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/davidji99/terraform-provider-herokux/api/platform"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -34,7 +34,7 @@ func resourceHerokuxAppContainerRelease() *schema.Resource {
 			StateContext: resourceHerokuxAppContainerReleaseImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(30*time.Minute, 30*time.Minute, 30*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			"app_id": {
@@ -159,7 +159,7 @@ func resourceHerokuxAppContainerReleaseDelete(ctx context.Context, d *schema.Res
 	appID := compositeID[0]
 	processType := compositeID[1]
 
-	_, updateErr := clientAPI.Platform.FormationContainerUpdate(appID, processType,
+	_, updateErr := clientAPI.Platform.FormationContainerUpdate(ctx, appID, processType,
 		&platform.FormationDockerUpdateOpts{DockerImageID: nil})
 	if updateErr != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -214,7 +214,7 @@ func releaseContainer(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	// There's an inconsistency with this Platform API variant where it relies on the Formation endpoint
 	// to release the docker image instead of a dedicated Release endpoint (like how heroku_app_release functions).
-	_, _, updateErr := clientAPI.Platform.FormationContainerBatchUpdate(appID, opts)
+	_, _, updateErr := clientAPI.Platform.FormationContainerBatchUpdate(ctx, appID, opts)
 	if updateErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -226,16 +226,18 @@ func releaseContainer(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	log.Printf("[INFO] Begin checking if %s container release on app %s is successful", imageOpts.Type, appID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{ReleaseStatusPending, ReleaseStatusUnknown},
-		Target:  []string{ReleaseStatusSucceeded},
-		Refresh: containerReleaseStateRefreshFunc(platformAPI, appID, *imageOpts.DockerImageID, imageOpts.Type),
-		Timeout: time.Duration(config.AppContainerReleaseVerifyTimeout) * time.Minute,
-		Delay:   5 * time.Second,
+	waiter := &wait.Waiter[*heroku.Release, string]{
+		Description:  fmt.Sprintf("%s container release on app %s to succeed", imageOpts.Type, appID),
+		Pending:      []string{ReleaseStatusPending, ReleaseStatusUnknown},
+		Target:       []string{ReleaseStatusSucceeded},
+		Refresh:      containerReleaseStateRefreshFunc(platformAPI, appID, *imageOpts.DockerImageID, imageOpts.Type),
+		Timeout:      verifyTimeout(config.AppContainerReleaseVerifyTimeout),
+		Delay:        5 * time.Second,
+		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return diag.Errorf("error waiting for %s container release on app %s to succeed", imageOpts.Type, appID)
+	if _, err := waiter.Wait(ctx); err != nil {
+		return diag.Errorf("error waiting for %s container release on app %s to succeed: %s", imageOpts.Type, appID, err.Error())
 	}
 
 	log.Printf("[DEBUG] Released %s container on app %s", imageOpts.Type, appID)
@@ -243,10 +245,10 @@ func releaseContainer(ctx context.Context, d *schema.ResourceData, meta interfac
 	return diags
 }
 
-func containerReleaseStateRefreshFunc(client *heroku.Service, appID, imageID, processType string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func containerReleaseStateRefreshFunc(client *heroku.Service, appID, imageID, processType string) wait.RefreshFunc[*heroku.Release, string] {
+	return func(ctx context.Context) (*heroku.Release, string, error) {
 		// Retrieve list of recent releases.
-		releases, listErr := client.ReleaseList(context.Background(), appID,
+		releases, listErr := client.ReleaseList(ctx, appID,
 			&heroku.ListRange{Descending: true, Field: "version", Max: 20})
 		if listErr != nil {
 			return nil, ReleaseStatusError, listErr
@@ -261,18 +263,18 @@ func containerReleaseStateRefreshFunc(client *heroku.Service, appID, imageID, pr
 			if r.Description == targetReleaseDescription && r.Status == ReleaseStatusSucceeded {
 				log.Printf("[DEBUG] app %s's %s process - status: %s | current: %v",
 					appID, processType, r.Status, r.Current)
-				return r, r.Status, nil
+				return &r, r.Status, nil
 			}
 
 			if r.Description == targetReleaseDescription && r.Status != ReleaseStatusSucceeded {
 				log.Printf("[DEBUG] Still waiting for app %s's %s process - status: %s | current: %v",
 					appID, processType, r.Status, r.Current)
-				return r, r.Status, nil
+				return &r, r.Status, nil
 			}
 		}
 
 		log.Printf("[DEBUG] Unable to find app %s's %s process in releases", appID, processType)
 
-		return releases, ReleaseStatusUnknown, nil
+		return nil, ReleaseStatusUnknown, nil
 	}
 }
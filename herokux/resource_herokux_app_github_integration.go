@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/kolkrabbi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -90,7 +92,7 @@ func resourceHerokuxAppGithubIntegrationCreate(ctx context.Context, d *schema.Re
 
 	log.Printf("[DEBUG] Creating integration with Heroku app %s and Github", appID)
 
-	integrationData, _, createErr := client.Kolkrabbi.UpdateAppGithubIntegration(appID, opts)
+	integrationData, _, createErr := client.Kolkrabbi.UpdateAppGithubIntegration(ctx, appID, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -133,7 +135,7 @@ func resourceHerokuxAppGithubIntegrationUpdate(ctx context.Context, d *schema.Re
 
 	log.Printf("[DEBUG] Updating integration with Heroku app %s and Github", d.Id())
 
-	_, _, updateErr := client.Kolkrabbi.UpdateAppGithubIntegration(d.Id(), opts)
+	_, _, updateErr := client.Kolkrabbi.UpdateAppGithubIntegration(ctx, d.Id(), opts)
 	if updateErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -152,8 +154,14 @@ func resourceHerokuxAppGithubIntegrationRead(ctx context.Context, d *schema.Reso
 	var diags diag.Diagnostics
 	client := meta.(*Config).API
 
-	iData, _, readErr := client.Kolkrabbi.GetAppGithubIntegration(d.Id())
+	iData, _, readErr := client.Kolkrabbi.GetAppGithubIntegration(ctx, d.Id())
 	if readErr != nil {
+		if errors.Is(readErr, api.ErrNotFound) {
+			log.Printf("[WARN] App Github integration %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("Unable to retrieve Github integration for app %s", d.Id()),
@@ -184,7 +192,7 @@ func resourceHerokuxAppGithubIntegrationDelete(ctx context.Context, d *schema.Re
 		WaitForCI:  &allFalse,
 	}
 
-	_, _, deleteErr := client.Kolkrabbi.UpdateAppGithubIntegration(d.Id(), opts)
+	_, _, deleteErr := client.Kolkrabbi.UpdateAppGithubIntegration(ctx, d.Id(), opts)
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
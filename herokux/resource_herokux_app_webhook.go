@@ -2,9 +2,12 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/platform"
 	"github.com/davidji99/tfph"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -60,11 +63,29 @@ func resourceHerokuxAppWebhook() *schema.Resource {
 			},
 
 			"secret": {
-				Type:         schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"secret_wo"},
+			},
+
+			"secret_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"secret"},
+				Description:   "Same as secret but never stored in the plan or state. Requires Terraform 1.11 or later.",
+			},
+
+			"secret_wo_version": {
+				Type:         schema.TypeInt,
 				Optional:     true,
-				Computed:     true,
-				Sensitive:    true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"secret_wo"},
+				Description:  "Change this value to send a new secret_wo to Heroku.",
 			},
 
 			//"auth_header": {
@@ -105,7 +126,7 @@ func resourceHerokuxAppWebhookImport(ctx context.Context, d *schema.ResourceData
 	appIDorName := result[0]
 	webhookIDorName := result[1]
 
-	aw, _, getErr := client.Platform.GetAppWebhook(appIDorName, webhookIDorName)
+	aw, _, getErr := client.Platform.GetAppWebhook(ctx, appIDorName, webhookIDorName)
 	if getErr != nil {
 		return nil, getErr
 	}
@@ -124,7 +145,7 @@ func resourceHerokuxAppWebhookCreate(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[DEBUG] Creating webhook on app %s", appID)
 
-	w, r, createErr := client.Platform.CreateAppWebhook(appID, opts)
+	w, r, createErr := client.Platform.CreateAppWebhook(ctx, appID, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -139,8 +160,8 @@ func resourceHerokuxAppWebhookCreate(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[DEBUG] Created webhook on app %s", appID)
 
-	// Only set the signing_secret is the `secret` attribute is not set.
-	if _, ok := d.GetOk("secret"); !ok {
+	// Only set the signing_secret is the `secret` or `secret_wo` attribute is not set.
+	if _, ok := d.GetOk("secret"); !ok && opts.Secret == "" {
 		setErr := d.Set("signing_secret", r.Resp.Header().Get(platform.HerokuWebhookSecret))
 		if setErr != nil {
 			diag.FromErr(setErr)
@@ -176,8 +197,14 @@ func resourceHerokuxAppWebhookRead(ctx context.Context, d *schema.ResourceData,
 	appID := result[0]
 	webhookID := result[1]
 
-	aw, _, getErr := client.Platform.GetAppWebhook(appID, webhookID)
+	aw, _, getErr := client.Platform.GetAppWebhook(ctx, appID, webhookID)
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] App webhook %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to retrieve app webhook",
@@ -206,7 +233,7 @@ func resourceHerokuxAppWebhookUpdate(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[DEBUG] Updating webhook %s on app %s", webhookID, appID)
 
-	_, _, updateErr := client.Platform.UpdateAppWebhook(appID, webhookID, opts)
+	_, _, updateErr := client.Platform.UpdateAppWebhook(ctx, appID, webhookID, opts)
 	if updateErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -235,7 +262,7 @@ func resourceHerokuxAppWebhookDelete(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[DEBUG] Deleting webhook %s on app %s", webhookID, appID)
 
-	_, deleteErr := client.Platform.DeleteAppWebhook(appID, webhookID)
+	_, deleteErr := client.Platform.DeleteAppWebhook(ctx, appID, webhookID)
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -290,5 +317,11 @@ func constructAppWebhookOpts(d *schema.ResourceData) *platform.AppWebhookRequest
 		opts.Secret = vs
 	}
 
+	// The write-only secret is only available in the configuration, never in the plan or state.
+	if v, _ := d.GetRawConfigAt(cty.GetAttrPath("secret_wo")); v.Type().Equals(cty.String) && v.IsKnown() && !v.IsNull() {
+		log.Printf("[DEBUG] webhook secret_wo is set")
+		opts.Secret = v.AsString()
+	}
+
 	return opts
 }
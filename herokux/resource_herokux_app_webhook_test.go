@@ -2,14 +2,13 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
 )
 
 func TestAccHerokuxAppWebhook_Basic(t *testing.T) {
 	appID := testAccConfig.GetAppIDorSkip(t)
-	name := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	name := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -40,7 +39,7 @@ func TestAccHerokuxAppWebhook_Basic(t *testing.T) {
 
 func TestAccHerokuxAppWebhook_BasicWithSecret(t *testing.T) {
 	appID := testAccConfig.GetAppIDorSkip(t)
-	name := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	name := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -3,6 +3,7 @@ package herokux
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/connect"
@@ -99,9 +100,6 @@ func mappingsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 }
 
 func resourceHerokuxConnectMappingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	config := meta.(*Config)
-	client := config.API
-
 	result, parseErr := tfph.ParseCompositeID(d.Id(), 2)
 	if parseErr != nil {
 		return nil, parseErr
@@ -114,11 +112,6 @@ func resourceHerokuxConnectMappingsImport(ctx context.Context, d *schema.Resourc
 	d.Set("connect_id", connectID)
 	d.SetId(connectID)
 
-	setupClientErr := setupConnectAPIClient(client, appID, connectID)
-	if setupClientErr != nil {
-		return nil, fmt.Errorf("unable to setup API client")
-	}
-
 	readErr := resourceHerokuxConnectMappingsRead(ctx, d, meta)
 	if readErr.HasError() {
 		return nil, fmt.Errorf("unable to import existing connect mapping: %v", readErr[0])
@@ -127,20 +120,22 @@ func resourceHerokuxConnectMappingsImport(ctx context.Context, d *schema.Resourc
 	return []*schema.ResourceData{d}, nil
 }
 
-func setupConnectAPIClient(client *api.Client, appID, connectID string) diag.Diagnostics {
+// connectBaseURL returns the regional base URL of the Connect APIs for the connection,
+// which is passed to every Connect request made for it.
+func connectBaseURL(ctx context.Context, client *api.Client, appID, connectID string) (string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	setRootURLErr := client.Connect.SetRootAPIBaseURL(appID, connectID)
-	if setRootURLErr != nil {
+	baseURL, baseURLErr := client.Connect.RegionalBaseURL(ctx, appID, connectID)
+	if baseURLErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  "Unable determine and set the root API URL for your Heroku Connect connection",
-			Detail:   setRootURLErr.Error(),
+			Summary:  "Unable determine the root API URL for your Heroku Connect connection",
+			Detail:   baseURLErr.Error(),
 		})
-		return diags
+		return "", diags
 	}
 
-	return diags
+	return baseURL, diags
 }
 
 func resourceHerokuxConnectMappingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -150,9 +145,9 @@ func resourceHerokuxConnectMappingsCreate(ctx context.Context, d *schema.Resourc
 	appID := getAppID(d)
 	connectID := getConnectID(d)
 
-	setupClientErr := setupConnectAPIClient(client, appID, connectID)
-	if setupClientErr != nil {
-		return setupClientErr
+	baseURL, baseURLDiags := connectBaseURL(ctx, client, appID, connectID)
+	if baseURLDiags.HasError() {
+		return baseURLDiags
 	}
 
 	var mappings []byte
@@ -164,7 +159,7 @@ func resourceHerokuxConnectMappingsCreate(ctx context.Context, d *schema.Resourc
 
 	log.Printf("[DEBUG] Creating mappings on connection %s", getConnectID(d))
 
-	_, createErr := client.Connect.ImportMappings(getConnectID(d), mappings)
+	_, createErr := client.Connect.ImportMappings(ctx, baseURL, getConnectID(d), mappings)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -191,13 +186,19 @@ func resourceHerokuxConnectMappingsRead(ctx context.Context, d *schema.ResourceD
 	appID := getAppID(d)
 	connectID := getConnectID(d)
 
-	setupClientErr := setupConnectAPIClient(client, appID, connectID)
-	if setupClientErr != nil {
-		return setupClientErr
+	baseURL, baseURLDiags := connectBaseURL(ctx, client, appID, connectID)
+	if baseURLDiags.HasError() {
+		return baseURLDiags
 	}
 
-	connection, _, getErr := client.Connect.GetConnection(d.Id(), connect.ConnectionGetQueryParams{Deep: true})
+	connection, _, getErr := client.Connect.GetConnection(ctx, baseURL, d.Id(), connect.ConnectionGetQueryParams{Deep: true})
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Connect mappings %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("unable to retrieve mappings from connection %s", getConnectID(d)),
@@ -224,7 +225,7 @@ func resourceHerokuxConnectMappingsRead(ctx context.Context, d *schema.ResourceD
 	d.Set("mapping_data", mappingData)
 
 	// Set the resource's 'mapping' attribute to whatever the Export API returns
-	mappingExport, _, exportErr := client.Connect.ExportMappings(d.Id())
+	mappingExport, _, exportErr := client.Connect.ExportMappings(ctx, baseURL, d.Id())
 	if exportErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -259,9 +260,9 @@ func resourceHerokuxConnectMappingsUpdate(ctx context.Context, d *schema.Resourc
 	appID := getAppID(d)
 	connectID := getConnectID(d)
 
-	setupClientErr := setupConnectAPIClient(client, appID, connectID)
-	if setupClientErr != nil {
-		return setupClientErr
+	baseURL, baseURLDiags := connectBaseURL(ctx, client, appID, connectID)
+	if baseURLDiags.HasError() {
+		return baseURLDiags
 	}
 
 	var oldMapping, newMapping connect.MappingsExport
@@ -317,7 +318,7 @@ func resourceHerokuxConnectMappingsUpdate(ctx context.Context, d *schema.Resourc
 
 			log.Printf("Updating connection mappings by deleting mapping %s (%s)", mappingID, n)
 
-			_, deleteErr := client.Connect.DeleteMapping(mappingID)
+			_, deleteErr := client.Connect.DeleteMapping(ctx, baseURL, mappingID)
 			if deleteErr != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
@@ -334,7 +335,7 @@ func resourceHerokuxConnectMappingsUpdate(ctx context.Context, d *schema.Resourc
 	// Then 'update' the mappings by doing the same thing as the CREATE method
 	log.Printf("Updated mappings on connect %s", getConnectID(d))
 
-	_, update := client.Connect.ImportMappings(getConnectID(d), getConnectMappings(d))
+	_, update := client.Connect.ImportMappings(ctx, baseURL, getConnectID(d), getConnectMappings(d))
 	if update != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -358,9 +359,9 @@ func resourceHerokuxConnectMappingsDelete(ctx context.Context, d *schema.Resourc
 	appID := getAppID(d)
 	connectID := getConnectID(d)
 
-	setupClientErr := setupConnectAPIClient(client, appID, connectID)
-	if setupClientErr != nil {
-		return setupClientErr
+	baseURL, baseURLDiags := connectBaseURL(ctx, client, appID, connectID)
+	if baseURLDiags.HasError() {
+		return baseURLDiags
 	}
 
 	log.Printf("[DEBUG] Deleting all tracked mappings on connection %s", getConnectID(d))
@@ -380,7 +381,7 @@ func resourceHerokuxConnectMappingsDelete(ctx context.Context, d *schema.Resourc
 		mappingID := i.(string)
 		log.Printf("[DEBUG] Deleting mapping %s on connection %s", mappingID, getConnectID(d))
 
-		_, deleteErr := client.Connect.DeleteMapping(mappingID)
+		_, deleteErr := client.Connect.DeleteMapping(ctx, baseURL, mappingID)
 		if deleteErr != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
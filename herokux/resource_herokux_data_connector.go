@@ -2,12 +2,13 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/davidji99/terraform-provider-herokux/api/postgres"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -26,7 +27,7 @@ func resourceHerokuxDataConnector() *schema.Resource {
 			StateContext: resourceHerokuxDataConnectorImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(30*time.Minute, 30*time.Minute, 20*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			"source_id": {
@@ -129,7 +130,7 @@ func resourceHerokuxDataConnectorImport(ctx context.Context, d *schema.ResourceD
 	appName := result[0]
 	dataConnectorName := result[1]
 
-	dataConnectors, _, listErr := client.Postgres.ListDataConnectors(appName)
+	dataConnectors, _, listErr := client.Postgres.ListDataConnectors(ctx, appName)
 	if listErr != nil {
 		return nil, listErr
 	}
@@ -211,22 +212,23 @@ func resourceHerokuxDataConnectorCreate(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[DEBUG] Creating Data Connector between %s & %s", sourceID, storeID)
 
-	dc, _, createErr := client.Postgres.CreateDataConnector(storeID, opts)
+	dc, _, createErr := client.Postgres.CreateDataConnector(ctx, storeID, opts)
 	if createErr != nil {
 		return diag.FromErr(createErr)
 	}
 
 	log.Printf("[DEBUG] Waiting Data Connector %s to be provisioned", dc.GetID())
 
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{postgres.DataConnectorStatuses.CREATING.ToString()},
-		Target:       []string{postgres.DataConnectorStatuses.AVAILABLE.ToString()},
-		Refresh:      DataConnectorCreateStateRefreshFunc(client, dc.GetID()),
-		Timeout:      time.Duration(config.DataConnectorCreateVerifyTimeout) * time.Minute,
+	waiter := &wait.Waiter[*postgres.DataConnector, postgres.DataConnectorStatus]{
+		Description:  fmt.Sprintf("data connector %s to be provisioned", dc.GetID()),
+		Pending:      []postgres.DataConnectorStatus{postgres.DataConnectorStatuses.CREATING},
+		Target:       []postgres.DataConnectorStatus{postgres.DataConnectorStatuses.AVAILABLE},
+		Refresh:      DataConnectorStatusRefreshFunc(client, dc.GetID()),
+		Timeout:      verifyTimeout(config.DataConnectorCreateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for data connector to be provisioned on %s: %s", dc.GetID(), err.Error())
 	}
 
@@ -259,8 +261,14 @@ func resourceHerokuxDataConnectorCreate(ctx context.Context, d *schema.ResourceD
 func resourceHerokuxDataConnectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).API
 
-	dc, _, getErr := client.Postgres.GetDataConnector(d.Id())
+	dc, _, getErr := client.Postgres.GetDataConnector(ctx, d.Id())
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Data connector %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return diag.FromErr(getErr)
 	}
 
@@ -317,22 +325,23 @@ func updateSettingsDataConnector(ctx context.Context, d *schema.ResourceData, me
 
 	log.Printf("[DEBUG] Updating Data Connector settings %s", d.Id())
 
-	_, _, settingsErr := client.Postgres.UpdateDataConnectorSettings(d.Id(), &postgres.DataConnectSettings{Settings: settings})
+	_, _, settingsErr := client.Postgres.UpdateDataConnectorSettings(ctx, d.Id(), &postgres.DataConnectSettings{Settings: settings})
 	if settingsErr != nil {
 		return diag.FromErr(settingsErr)
 	}
 
 	log.Printf("[DEBUG] Checking if Data Connector settings were updated")
 
-	stateConf := &resource.StateChangeConf{
+	waiter := &wait.Waiter[*postgres.DataConnector, string]{
+		Description:  fmt.Sprintf("data connector %s settings to be updated", d.Id()),
 		Pending:      []string{"updating"},
 		Target:       []string{"updated"},
 		Refresh:      DataConnectorSettingsUpdateRefreshFunc(client, d.Id(), settings),
-		Timeout:      time.Duration(config.DataConnectorSettingsUpdateVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.DataConnectorSettingsUpdateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for Data Connector settings to be updated %s: %s", d.Id(), err.Error())
 	}
 
@@ -341,9 +350,9 @@ func updateSettingsDataConnector(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
-func DataConnectorSettingsUpdateRefreshFunc(client *api.Client, connectorID string, settings map[string]interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		dc, _, getErr := client.Postgres.GetDataConnector(connectorID)
+func DataConnectorSettingsUpdateRefreshFunc(client *api.Client, connectorID string, settings map[string]interface{}) wait.RefreshFunc[*postgres.DataConnector, string] {
+	return func(ctx context.Context) (*postgres.DataConnector, string, error) {
+		dc, _, getErr := client.Postgres.GetDataConnector(ctx, connectorID)
 		if getErr != nil {
 			return nil, "", getErr
 		}
@@ -359,7 +368,6 @@ func DataConnectorSettingsUpdateRefreshFunc(client *api.Client, connectorID stri
 		isUpdated := reflect.DeepEqual(settings, dc.Settings)
 
 		if isUpdated {
-			log.Printf("[DEBUG] Still waiting for data connector settings to be updated")
 			return dc, "updated", nil
 		}
 
@@ -378,7 +386,7 @@ func pauseResumeDataConnector(ctx context.Context, d *schema.ResourceData, meta
 		return nil
 	}
 
-	var pendingState, targetState string
+	var pendingState, targetState postgres.DataConnectorStatus
 
 	log.Printf("[DEBUG] Modifying status of Data Connector %s", d.Id())
 
@@ -386,23 +394,23 @@ func pauseResumeDataConnector(ctx context.Context, d *schema.ResourceData, meta
 	case postgres.DataConnectorStatuses.PAUSED.ToString():
 		log.Printf("[DEBUG] Pausing Data Connector %s", d.Id())
 
-		_, pauseErr := client.Postgres.PauseDataConnector(d.Id())
+		_, pauseErr := client.Postgres.PauseDataConnector(ctx, d.Id())
 		if pauseErr != nil {
 			return diag.FromErr(pauseErr)
 		}
-		pendingState = postgres.DataConnectorStatuses.AVAILABLE.ToString()
-		targetState = action
+		pendingState = postgres.DataConnectorStatuses.AVAILABLE
+		targetState = postgres.DataConnectorStatuses.PAUSED
 
 		log.Printf("[DEBUG] Paused Data Connector %s", d.Id())
 	case postgres.DataConnectorStatuses.AVAILABLE.ToString():
 		log.Printf("[DEBUG] Resuming Data Connector %s", d.Id())
 
-		_, resumeErr := client.Postgres.ResumeDataConnector(d.Id())
+		_, resumeErr := client.Postgres.ResumeDataConnector(ctx, d.Id())
 		if resumeErr != nil {
 			return diag.FromErr(resumeErr)
 		}
-		pendingState = postgres.DataConnectorStatuses.PAUSED.ToString()
-		targetState = action
+		pendingState = postgres.DataConnectorStatuses.PAUSED
+		targetState = postgres.DataConnectorStatuses.AVAILABLE
 
 		log.Printf("[DEBUG] Resumed Data Connector %s", d.Id())
 	default:
@@ -411,15 +419,16 @@ func pauseResumeDataConnector(ctx context.Context, d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] Waiting on Data Connector %s to %s", d.Id(), action)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{pendingState},
-		Target:       []string{targetState},
-		Refresh:      DataConnectorStatusRefreshFunc(client, d.Id(), pendingState, targetState),
-		Timeout:      time.Duration(config.DataConnectorStatusUpdateVerifyTimeout) * time.Minute,
+	waiter := &wait.Waiter[*postgres.DataConnector, postgres.DataConnectorStatus]{
+		Description:  fmt.Sprintf("data connector %s status to change to %s", d.Id(), targetState),
+		Pending:      []postgres.DataConnectorStatus{pendingState},
+		Target:       []postgres.DataConnectorStatus{targetState},
+		Refresh:      DataConnectorStatusRefreshFunc(client, d.Id()),
+		Timeout:      verifyTimeout(config.DataConnectorStatusUpdateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for data connector %s to %s: %s", d.Id(), action, err.Error())
 	}
 
@@ -434,22 +443,23 @@ func resourceHerokuxDataConnectorDelete(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[DEBUG] Deleting Data Connector %s", d.Id())
 
-	_, _, deleteErr := client.Postgres.DeleteDataConnector(d.Id())
+	_, _, deleteErr := client.Postgres.DeleteDataConnector(ctx, d.Id())
 	if deleteErr != nil {
 		return diag.FromErr(deleteErr)
 	}
 
 	log.Printf("[DEBUG] Waiting on Data Connector %s to be deleted", d.Id())
 
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{postgres.DataConnectorStatuses.DEPROVISIONED.ToString()},
-		Target:       []string{postgres.DataConnectorStatuses.DELETED.ToString()},
+	waiter := &wait.Waiter[*postgres.DataConnector, postgres.DataConnectorStatus]{
+		Description:  fmt.Sprintf("data connector %s to be deleted", d.Id()),
+		Pending:      []postgres.DataConnectorStatus{postgres.DataConnectorStatuses.DEPROVISIONED},
+		Target:       []postgres.DataConnectorStatus{postgres.DataConnectorStatuses.DELETED},
 		Refresh:      DataConnectorDeleteStateRefreshFunc(client, d.Id()),
-		Timeout:      time.Duration(config.DataConnectorDeleteVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.DataConnectorDeleteVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for data connector %s to be deleted: %s", d.Id(), err.Error())
 	}
 
@@ -460,59 +470,32 @@ func resourceHerokuxDataConnectorDelete(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
-func DataConnectorDeleteStateRefreshFunc(client *api.Client, dcID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func DataConnectorDeleteStateRefreshFunc(client *api.Client, dcID string) wait.RefreshFunc[*postgres.DataConnector, postgres.DataConnectorStatus] {
+	return func(ctx context.Context) (*postgres.DataConnector, postgres.DataConnectorStatus, error) {
 		// Check the status of the data connector.
-		dc, response, getErr := client.Postgres.GetDataConnector(dcID)
+		dc, _, getErr := client.Postgres.GetDataConnector(ctx, dcID)
 		if getErr != nil {
-			if response.StatusCode == 404 {
-				// A 404 means the data connector has been successfully deleted
-				return postgres.DataConnector{}, postgres.DataConnectorStatuses.DELETED.ToString(), nil
+			if errors.Is(getErr, api.ErrNotFound) {
+				// A 404 means the data connector has been successfully deleted.
+				// Although the status is set to 'Deprovisioned' beforehand, this isn't enough to indicate
+				// the data connector is successfully deleted.
+				return nil, postgres.DataConnectorStatuses.DELETED, nil
 			}
-			return nil, postgres.DataConnectorStatuses.UNKNOWN.ToString(), getErr
-		}
-
-		// Although the status is set to 'Deprovisioned', this isn't enough to indicate the data connector
-		// is successfully deleted.
-		if dc.Status.ToString() == postgres.DataConnectorStatuses.DEPROVISIONED.ToString() {
-			log.Printf("[DEBUG] Still waiting for data connector %s to be deleted", dcID)
-			return dc, dc.Status.ToString(), nil
-		}
-
-		return dc, dc.Status.ToString(), nil
-	}
-}
-
-func DataConnectorCreateStateRefreshFunc(client *api.Client, dcID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		// Check the status of the data connector.
-		dc, _, getErr := client.Postgres.GetDataConnector(dcID)
-		if getErr != nil {
-			return nil, postgres.DataConnectorStatuses.UNKNOWN.ToString(), getErr
-		}
-
-		if dc.Status.ToString() == postgres.DataConnectorStatuses.CREATING.ToString() {
-			log.Printf("[DEBUG] Still waiting for data connector %s to be provisioned", dcID)
-			return dc, dc.Status.ToString(), nil
+			return nil, postgres.DataConnectorStatuses.UNKNOWN, getErr
 		}
 
-		return dc, dc.Status.ToString(), nil
+		return dc, dc.Status, nil
 	}
 }
 
-func DataConnectorStatusRefreshFunc(client *api.Client, dcID string, pendingState, targetState string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		// Check the status of the data connector.
-		dc, _, getErr := client.Postgres.GetDataConnector(dcID)
+// DataConnectorStatusRefreshFunc returns the status of a data connector.
+func DataConnectorStatusRefreshFunc(client *api.Client, dcID string) wait.RefreshFunc[*postgres.DataConnector, postgres.DataConnectorStatus] {
+	return func(ctx context.Context) (*postgres.DataConnector, postgres.DataConnectorStatus, error) {
+		dc, _, getErr := client.Postgres.GetDataConnector(ctx, dcID)
 		if getErr != nil {
-			return nil, postgres.DataConnectorStatuses.UNKNOWN.ToString(), getErr
-		}
-
-		if dc.Status.ToString() == pendingState {
-			log.Printf("[DEBUG] Still waiting for data connector %s status to change to %s", dcID, targetState)
-			return dc, dc.Status.ToString(), nil
+			return nil, postgres.DataConnectorStatuses.UNKNOWN, getErr
 		}
 
-		return dc, dc.Status.ToString(), nil
+		return dc, dc.Status, nil
 	}
 }
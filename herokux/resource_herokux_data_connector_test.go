@@ -3,7 +3,6 @@ package herokux
 import (
 	"fmt"
 	helper "github.com/davidji99/terraform-provider-herokux/helper/test"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
 )
@@ -26,7 +25,7 @@ CREATE TABLE users (
 func TestAccHerokuxDataConnector_Basic(t *testing.T) {
 	postgresID := testAccConfig.GetAddonIDorSkip(t)
 	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
-	name := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	name := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -60,7 +59,7 @@ func TestAccHerokuxDataConnector_Basic(t *testing.T) {
 func TestAccHerokuxDataConnector_Paused(t *testing.T) {
 	postgresID := testAccConfig.GetAddonIDorSkip(t)
 	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
-	name := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	name := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -106,7 +105,7 @@ func TestAccHerokuxDataConnector_Paused(t *testing.T) {
 func TestAccHerokuxDataConnector_WithSettings(t *testing.T) {
 	postgresID := testAccConfig.GetAddonIDorSkip(t)
 	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
-	name := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	name := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -3,6 +3,7 @@ package herokux
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/metrics"
@@ -105,7 +106,7 @@ func resourceHerokuxFormationAlertImport(ctx context.Context, d *schema.Resource
 	processType := importID[1]
 	alertName := importID[2]
 
-	alert, _, findErr := client.Metrics.FindMonitorByName(appID, processType,
+	alert, _, findErr := client.Metrics.FindMonitorByName(ctx, appID, processType,
 		metrics.FormationMonitorName(alertName))
 	if findErr != nil {
 		return nil, findErr
@@ -171,14 +172,14 @@ func resourceHerokuxFormationAlertCreate(ctx context.Context, d *schema.Resource
 	alertName := getName(d)
 
 	// Check for existing alert.
-	existingAlertCheckErr := checkForExistingMonitor(metricsAPI, appID, processType,
+	existingAlertCheckErr := checkForExistingMonitor(ctx, metricsAPI, appID, processType,
 		metrics.FormationMonitorActionTypes.Alert.ToString(), alertName)
 	if existingAlertCheckErr != nil {
 		return existingAlertCheckErr
 	}
 
 	// Get information about the formation as that's needed for the formation alert POST request.
-	formation, formationGetErr := platformAPI.FormationInfo(context.TODO(), appID, processType)
+	formation, formationGetErr := platformAPI.FormationInfo(ctx, appID, processType)
 	if formationGetErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -195,7 +196,7 @@ func resourceHerokuxFormationAlertCreate(ctx context.Context, d *schema.Resource
 
 	log.Printf("[DEBUG] Creating %s alert for app [%s] process type [%s]", opts.Name, appID, processType)
 
-	alert, _, createErr := metricsAPI.Metrics.CreateFormationAlert(appID, processType, opts)
+	alert, _, createErr := metricsAPI.Metrics.CreateFormationAlert(ctx, appID, processType, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -232,8 +233,14 @@ func resourceHerokuxFormationAlertRead(ctx context.Context, d *schema.ResourceDa
 	processType := resourceID[1]
 	alertId := resourceID[2]
 
-	alert, _, getErr := metricsAPI.Metrics.GetMonitor(appID, processType, alertId)
+	alert, _, getErr := metricsAPI.Metrics.GetMonitor(ctx, appID, processType, alertId)
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Formation alert %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to refresh state",
@@ -276,7 +283,7 @@ func resourceHerokuxFormationAlertUpdate(ctx context.Context, d *schema.Resource
 	alertID := resourceID[2]
 
 	// Get information about the formation as that's needed for the formation alert POST request.
-	formation, formationGetErr := platformAPI.FormationInfo(context.TODO(), appID, processType)
+	formation, formationGetErr := platformAPI.FormationInfo(ctx, appID, processType)
 	if formationGetErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -292,7 +299,7 @@ func resourceHerokuxFormationAlertUpdate(ctx context.Context, d *schema.Resource
 	opts.Quantity = formation.Quantity
 
 	log.Printf("[DEBUG] Updating %s alert for app [%s] process type [%s]", opts.Name, appID, processType)
-	isUpdated, resp, setErr := metricsAPI.Metrics.UpdateFormationAlert(appID, processType, alertID, opts)
+	isUpdated, resp, setErr := metricsAPI.Metrics.UpdateFormationAlert(ctx, appID, processType, alertID, opts)
 	if setErr != nil {
 		return diag.FromErr(setErr)
 	}
@@ -322,7 +329,7 @@ func resourceHerokuxFormationAlertDelete(ctx context.Context, d *schema.Resource
 	metricsAPI := config.API
 
 	// Get current alert information
-	monitor, _, getErr := metricsAPI.Metrics.GetMonitor(appID, processType, alertID)
+	monitor, _, getErr := metricsAPI.Metrics.GetMonitor(ctx, appID, processType, alertID)
 	if getErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -348,7 +355,7 @@ func resourceHerokuxFormationAlertDelete(ctx context.Context, d *schema.Resource
 
 	log.Printf("[DEBUG] Disabling alert for app %s, process_type %s, monitor %s", appID, processType, alertID)
 
-	isSet, resp, setErr := metricsAPI.Metrics.UpdateFormationAlert(appID, processType, alertID, opts)
+	isSet, resp, setErr := metricsAPI.Metrics.UpdateFormationAlert(ctx, appID, processType, alertID, opts)
 	if setErr != nil {
 		return diag.FromErr(setErr)
 	}
@@ -364,10 +371,10 @@ func resourceHerokuxFormationAlertDelete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
-func checkForExistingMonitor(client *api.Client, appID, processType, actionType, monitorName string) diag.Diagnostics {
+func checkForExistingMonitor(ctx context.Context, client *api.Client, appID, processType, actionType, monitorName string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	monitors, _, listErr := client.Metrics.ListMonitors(appID, processType)
+	monitors, _, listErr := client.Metrics.ListMonitors(ctx, appID, processType)
 	if listErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/metrics"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -113,7 +115,7 @@ func resourceHerokuxFormationAutoscalingImport(ctx context.Context, d *schema.Re
 	appID := importID[0]
 	processType := importID[1]
 
-	monitor, _, findErr := client.Metrics.FindMonitorByName(appID, processType, metrics.FormationMonitorNames.LatencyScale)
+	monitor, _, findErr := client.Metrics.FindMonitorByName(ctx, appID, processType, metrics.FormationMonitorNames.LatencyScale)
 	if findErr != nil {
 		return nil, findErr
 	}
@@ -137,7 +139,7 @@ func resourceHerokuxFormationAutoscalingCreate(ctx context.Context, d *schema.Re
 	processType := getProcessType(d)
 
 	// Check for existing autoscaling.
-	existingAlertCheckErr := checkForExistingMonitor(client, appID, processType,
+	existingAlertCheckErr := checkForExistingMonitor(ctx, client, appID, processType,
 		metrics.FormationMonitorActionTypes.Scale.ToString(), metrics.FormationMonitorNames.LatencyScale.ToString())
 	if existingAlertCheckErr != nil {
 		return existingAlertCheckErr
@@ -160,7 +162,7 @@ func resourceHerokuxFormationAutoscalingCreate(ctx context.Context, d *schema.Re
 
 	log.Printf("[DEBUG] Creating formation autoscaling for app %s", appID)
 
-	fm, _, createErr := client.Metrics.CreateFormationAutoscaling(appID, processType, opts)
+	fm, _, createErr := client.Metrics.CreateFormationAutoscaling(ctx, appID, processType, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -192,8 +194,14 @@ func resourceHerokuxFormationAutoscalingRead(ctx context.Context, d *schema.Reso
 	processType := resourceID[1]
 	monitorID := resourceID[2]
 
-	monitor, _, getErr := metricsAPI.Metrics.GetMonitor(appID, processType, monitorID)
+	monitor, _, getErr := metricsAPI.Metrics.GetMonitor(ctx, appID, processType, monitorID)
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Formation autoscaling %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return diag.FromErr(getErr)
 	}
 
@@ -210,7 +218,7 @@ func resourceHerokuxFormationAutoscalingRead(ctx context.Context, d *schema.Reso
 	d.Set("desired_p95_response_time", p95)
 
 	// Get formation information in order to retrieve the dyno size as it's not returned by the above call.
-	formation, formationGetErr := platformAPI.FormationInfo(context.TODO(), appID, processType)
+	formation, formationGetErr := platformAPI.FormationInfo(ctx, appID, processType)
 	if formationGetErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -263,7 +271,7 @@ func resourceHerokuxFormationAutoscalingUpdate(ctx context.Context, d *schema.Re
 
 	log.Printf("[DEBUG] Updating formation autoscaling for app %s, formation: %s, monitor %s", appID, processType, monitorID)
 
-	isSet, resp, setErr := client.Metrics.UpdateFormationAutoscaling(appID, processType, monitorID, opts)
+	isSet, resp, setErr := client.Metrics.UpdateFormationAutoscaling(ctx, appID, processType, monitorID, opts)
 	if setErr != nil {
 		return diag.FromErr(setErr)
 	}
@@ -298,7 +306,7 @@ func resourceHerokuxFormationAutoscalingDelete(ctx context.Context, d *schema.Re
 	platformAPI := config.PlatformAPI
 
 	// Get current monitor information
-	monitor, _, getErr := metricsAPI.Metrics.GetMonitor(appID, processType, monitorID)
+	monitor, _, getErr := metricsAPI.Metrics.GetMonitor(ctx, appID, processType, monitorID)
 	if getErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -309,7 +317,7 @@ func resourceHerokuxFormationAutoscalingDelete(ctx context.Context, d *schema.Re
 	}
 
 	// Get formation information in order to retrieve the dyno size as it's not returned by the above call.
-	formation, formationGetErr := platformAPI.FormationInfo(context.TODO(), appID, processType)
+	formation, formationGetErr := platformAPI.FormationInfo(ctx, appID, processType)
 	if formationGetErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -340,7 +348,7 @@ func resourceHerokuxFormationAutoscalingDelete(ctx context.Context, d *schema.Re
 
 	log.Printf("[DEBUG] Disabling formation autoscaling for app %s, process_type %s, monitor %s", appID, processType, monitorID)
 
-	isSet, resp, setErr := metricsAPI.Metrics.UpdateFormationAutoscaling(appID, processType, monitorID, opts)
+	isSet, resp, setErr := metricsAPI.Metrics.UpdateFormationAutoscaling(ctx, appID, processType, monitorID, opts)
 	if setErr != nil {
 		return diag.FromErr(setErr)
 	}
@@ -2,7 +2,6 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
 )
@@ -11,9 +10,9 @@ import (
 func TestAccHerokuxFormationAutoscaling_Basic(t *testing.T) {
 	appID := testAccConfig.GetAppIDorSkip(t)
 	processType := "web"
-	minQuantity := acctest.RandIntRange(1, 8)
+	minQuantity := testAccConfig.RandIntRange(t, 1, 8)
 	maxQuantity := minQuantity + 2
-	p95ResponseTime := acctest.RandIntRange(500, 1000)
+	p95ResponseTime := testAccConfig.RandIntRange(t, 500, 1000)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -2,15 +2,17 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/tfph"
 	"log"
 	"time"
 
 	"github.com/davidji99/simpleresty"
 	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -25,7 +27,7 @@ func resourceHerokuxKafkaConsumerGroup() *schema.Resource {
 			StateContext: resourceHerokuxKafkaConsumerGroupImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(20*time.Minute, 20*time.Minute, 20*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			"kafka_id": {
@@ -68,22 +70,23 @@ func resourceHerokuxKafkaConsumerGroupCreate(ctx context.Context, d *schema.Reso
 	}
 
 	log.Printf("[DEBUG] Creating Kafka consumer group %s", opts.Name)
-	_, _, createErr := client.Kafka.CreateConsumerGroup(kafkaID, opts)
+	_, _, createErr := client.Kafka.CreateConsumerGroup(ctx, kafkaID, opts)
 	if createErr != nil {
 		return diag.FromErr(createErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for Kafka consumer group %s to be ready", opts.Name)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{kafka.ConsumerGroupStatuses.PENDING.ToString()},
-		Target:  []string{kafka.ConsumerGroupStatuses.CREATED.ToString()},
+	waiter := &wait.Waiter[bool, kafka.ConsumerGroupStatus]{
+		Description: fmt.Sprintf("Kafka consumer group %s to be ready", opts.Name),
+		Pending:     []kafka.ConsumerGroupStatus{kafka.ConsumerGroupStatuses.PENDING},
+		Target:      []kafka.ConsumerGroupStatus{kafka.ConsumerGroupStatuses.CREATED},
 		Refresh: kafkaConsumerGroupStateRefreshFunc(kafkaID, opts.Name,
 			kafka.ConsumerGroupStatuses.CREATED, client.Kafka.WasConsumerGroupCreated),
-		Timeout:      time.Duration(config.KafkaCGCreateVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.KafkaCGCreateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for consumer group to be ready on %s: %s", opts.Name, err.Error())
 	}
 
@@ -101,8 +104,14 @@ func resourceHerokuxKafkaConsumerGroupRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(parseErr)
 	}
 
-	group, _, getErr := client.Kafka.GetConsumerGroupByName(result[0], result[1])
+	group, _, getErr := client.Kafka.GetConsumerGroupByName(ctx, result[0], result[1])
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Kafka consumer group %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return diag.FromErr(getErr)
 	}
 
@@ -128,22 +137,23 @@ func resourceHerokuxKafkaConsumerGroupDelete(ctx context.Context, d *schema.Reso
 	opts.Name = groupName
 
 	log.Printf("[DEBUG] Deleting consumer group %s from %s", groupName, kafkaID)
-	_, _, deleteErr := client.Kafka.DeleteConsumerGroup(kafkaID, opts)
+	_, _, deleteErr := client.Kafka.DeleteConsumerGroup(ctx, kafkaID, opts)
 	if deleteErr != nil {
 		return diag.FromErr(deleteErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for Kafka consumer group %s to be deleted from %s", groupName, kafkaID)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{kafka.ConsumerGroupStatuses.PENDING.ToString()},
-		Target:  []string{kafka.ConsumerGroupStatuses.DELETED.ToString()},
-		Refresh: kafkaConsumerGroupStateRefreshFunc(result[0], result[1],
+	waiter := &wait.Waiter[bool, kafka.ConsumerGroupStatus]{
+		Description: fmt.Sprintf("Kafka consumer group %s to be deleted from %s", groupName, kafkaID),
+		Pending:     []kafka.ConsumerGroupStatus{kafka.ConsumerGroupStatuses.PENDING},
+		Target:      []kafka.ConsumerGroupStatus{kafka.ConsumerGroupStatuses.DELETED},
+		Refresh: kafkaConsumerGroupStateRefreshFunc(kafkaID, groupName,
 			kafka.ConsumerGroupStatuses.DELETED, client.Kafka.WasConsumerGroupDeleted),
-		Timeout:      time.Duration(config.KafkaCGDeleteVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.KafkaCGDeleteVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for consumer group to be deleted on %s: %s", opts.Name, err.Error())
 	}
 
@@ -154,18 +164,18 @@ func resourceHerokuxKafkaConsumerGroupDelete(ctx context.Context, d *schema.Reso
 
 func kafkaConsumerGroupStateRefreshFunc(kafkaID,
 	groupName string, targetState kafka.ConsumerGroupStatus,
-	checker func(id, n string) (bool, *simpleresty.Response, error)) resource.StateRefreshFunc {
+	checker func(ctx context.Context, id, n string) (bool, *simpleresty.Response, error)) wait.RefreshFunc[bool, kafka.ConsumerGroupStatus] {
 
-	return func() (interface{}, string, error) {
-		result, _, err := checker(kafkaID, groupName)
+	return func(ctx context.Context) (bool, kafka.ConsumerGroupStatus, error) {
+		result, _, err := checker(ctx, kafkaID, groupName)
 		if err != nil {
-			return nil, kafka.ConsumerGroupStatuses.PENDING.ToString(), err
+			return false, kafka.ConsumerGroupStatuses.PENDING, err
 		}
 
 		if result {
-			return result, targetState.ToString(), nil
+			return result, targetState, nil
 		}
 
-		return result, kafka.ConsumerGroupStatuses.PENDING.ToString(), nil
+		return result, kafka.ConsumerGroupStatuses.PENDING, nil
 	}
 }
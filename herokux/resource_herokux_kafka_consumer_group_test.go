@@ -3,14 +3,13 @@ package herokux
 import (
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/helper/test"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
 )
 
 func TestAccHerokuxKafkaConsumerGroup_Basic(t *testing.T) {
 	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
-	groupName := fmt.Sprintf("tftest-%s", acctest.RandString(15))
+	groupName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 15))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -33,9 +32,9 @@ func TestAccE2EHerokuxKafkaConsumerGroup(t *testing.T) {
 	testAccConfig.GetRunE2ETestsOrSkip(t)
 
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	plan := "heroku-kafka:standard-0"
-	groupName := fmt.Sprintf("tftest-%s", acctest.RandString(15))
+	groupName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 15))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
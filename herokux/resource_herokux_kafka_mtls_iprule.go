@@ -2,12 +2,13 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/general"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -24,7 +25,7 @@ func resourceHerokuxKafkaMTLSIPRule() *schema.Resource {
 			StateContext: resourceHerokuxKafkaMTLSIPRuleImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(30*time.Minute, 30*time.Minute, 30*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			"kafka_id": {
@@ -67,7 +68,7 @@ func resourceHerokuxKafkaMTLSIPRuleImport(ctx context.Context, d *schema.Resourc
 	cidr := parsedImportID[1]
 
 	// Get all IP rules
-	ipRules, _, listErr := client.Kafka.ListMTLSIPRules(kafkaID)
+	ipRules, _, listErr := client.Kafka.ListMTLSIPRules(ctx, kafkaID)
 	if listErr != nil {
 		return nil, listErr
 	}
@@ -116,7 +117,7 @@ func resourceHerokuxKafkaMTLSIPRuleCreate(ctx context.Context, d *schema.Resourc
 	// Enable MTLS
 	log.Printf("[DEBUG] Creating MTLS IP rule on kafka %s", kafkaID)
 
-	ipRule, _, createErr := client.Kafka.CreateMTLSIPRule(kafkaID, opts)
+	ipRule, _, createErr := client.Kafka.CreateMTLSIPRule(ctx, kafkaID, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -128,15 +129,16 @@ func resourceHerokuxKafkaMTLSIPRuleCreate(ctx context.Context, d *schema.Resourc
 
 	log.Printf("[DEBUG] Waiting for MTLS IP rule %s on kafka %s to be authorized", ipRule.GetID(), kafkaID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{general.MTLSIPRuleStatuses.AUTHORIZING.ToString()},
-		Target:       []string{general.MTLSIPRuleStatuses.AUTHORIZED.ToString()},
+	waiter := &wait.Waiter[*general.MtlsIPRule, general.MTLSIPRuleStatus]{
+		Description:  fmt.Sprintf("MTLS IP rule %s on kafka %s to be authorized", ipRule.GetID(), kafkaID),
+		Pending:      []general.MTLSIPRuleStatus{general.MTLSIPRuleStatuses.AUTHORIZING},
+		Target:       []general.MTLSIPRuleStatus{general.MTLSIPRuleStatuses.AUTHORIZED},
 		Refresh:      KafkaMtlsIPRuleStateRefreshFunc(client, kafkaID, ipRule.GetID()),
-		Timeout:      time.Duration(config.MTLSIPRuleCreateVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.MTLSIPRuleCreateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for MTLS IP rule %s to be authorized on kafka %s: %s",
 			ipRule.GetID(), kafkaID, err.Error())
 	}
@@ -153,9 +155,9 @@ func resourceHerokuxKafkaMTLSIPRuleRead(ctx context.Context, d *schema.ResourceD
 	client := meta.(*Config).API
 	kafkaID := getKafkaID(d)
 
-	ipRule, response, getErr := client.Kafka.GetMTLSIPRule(kafkaID, d.Id())
+	ipRule, _, getErr := client.Kafka.GetMTLSIPRule(ctx, kafkaID, d.Id())
 	if getErr != nil {
-		if response.StatusCode == 404 {
+		if errors.Is(getErr, api.ErrNotFound) {
 			log.Printf("[WARN] Kafka MTLS IP rule %s not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -184,7 +186,7 @@ func resourceHerokuxKafkaMTLSIPRuleDelete(ctx context.Context, d *schema.Resourc
 	kafkaID := getKafkaID(d)
 
 	log.Printf("[DEBUG] Deleting Kafka MTLS IP rule %s", d.Id())
-	_, deleteErr := client.Kafka.DeleteMTLSIPRule(kafkaID, d.Id())
+	_, deleteErr := client.Kafka.DeleteMTLSIPRule(ctx, kafkaID, d.Id())
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -199,18 +201,13 @@ func resourceHerokuxKafkaMTLSIPRuleDelete(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
-func KafkaMtlsIPRuleStateRefreshFunc(client *api.Client, kafkaID, ipRuleID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		ipRule, _, getErr := client.Kafka.GetMTLSIPRule(kafkaID, ipRuleID)
+func KafkaMtlsIPRuleStateRefreshFunc(client *api.Client, kafkaID, ipRuleID string) wait.RefreshFunc[*general.MtlsIPRule, general.MTLSIPRuleStatus] {
+	return func(ctx context.Context) (*general.MtlsIPRule, general.MTLSIPRuleStatus, error) {
+		ipRule, _, getErr := client.Kafka.GetMTLSIPRule(ctx, kafkaID, ipRuleID)
 		if getErr != nil {
-			return nil, general.MTLSIPRuleStatuses.UNKNOWN.ToString(), getErr
+			return nil, general.MTLSIPRuleStatuses.UNKNOWN, getErr
 		}
 
-		if *ipRule.GetStatus() == general.MTLSIPRuleStatuses.AUTHORIZING {
-			log.Printf("[DEBUG] Still waiting for Kafka MTLS IP rule %s to be authorized", ipRuleID)
-			return ipRule, ipRule.GetStatus().ToString(), nil
-		}
-
-		return ipRule, ipRule.GetStatus().ToString(), nil
+		return ipRule, *ipRule.GetStatus(), nil
 	}
 }
@@ -2,6 +2,7 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/davidji99/tfph"
 	"log"
@@ -10,8 +11,9 @@ import (
 
 	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -22,12 +24,13 @@ func resourceHerokuxKafkaTopic() *schema.Resource {
 		ReadContext:   resourceHerokuxKafkaTopicRead,
 		UpdateContext: resourceHerokuxKafkaTopicUpdate,
 		DeleteContext: resourceHerokuxKafkaTopicDelete,
+		CustomizeDiff: resourceHerokuxKafkaTopicCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceHerokuxKafkaTopicImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(20*time.Minute, 20*time.Minute, 20*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			"kafka_id": {
@@ -59,14 +62,16 @@ func resourceHerokuxKafkaTopic() *schema.Resource {
 			"retention_time": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      "1d",
+				Computed:     true,
 				ValidateFunc: validateRetentionTime,
 			},
 
 			"compaction": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "Use cleanup_policy instead.",
+				ConflictsWith: []string{"cleanup_policy"},
 			},
 
 			"status": {
@@ -76,7 +81,14 @@ func resourceHerokuxKafkaTopic() *schema.Resource {
 
 			"cleanup_policy": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					kafka.TopicCleanupPolicies.DELETE.ToString(),
+					kafka.TopicCleanupPolicies.COMPACT.ToString(),
+					kafka.TopicCleanupPolicies.COMPACT_DELETE.ToString(),
+				}, false),
+				ConflictsWith: []string{"compaction"},
 			},
 		},
 	}
@@ -106,6 +118,36 @@ func validateRetentionTime(v interface{}, k string) (ws []string, errors []error
 	return
 }
 
+// configuredCleanupPolicy returns the cleanup_policy set in the configuration, or an empty string if not set.
+// The state value is not used as it is computed from the topic when compaction is set instead.
+func configuredCleanupPolicy(rawConfig cty.Value) kafka.TopicCleanupPolicy {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return ""
+	}
+
+	v := rawConfig.GetAttr("cleanup_policy")
+	if !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+
+	return kafka.TopicCleanupPolicy(v.AsString())
+}
+
+// configuredCompaction returns the compaction set in the configuration and whether it is set.
+// The state value is not used as it is computed from the topic when cleanup_policy is set instead.
+func configuredCompaction(rawConfig cty.Value) (bool, bool) {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return false, false
+	}
+
+	v := rawConfig.GetAttr("compaction")
+	if !v.IsKnown() || v.IsNull() {
+		return false, false
+	}
+
+	return v.True(), true
+}
+
 func resourceHerokuxKafkaTopicImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.SetId(d.Id())
 
@@ -168,24 +210,30 @@ func resourceHerokuxKafkaTopicCreate(ctx context.Context, d *schema.ResourceData
 		opts.Compaction = vs
 	}
 
+	if policy := configuredCleanupPolicy(d.GetRawConfig()); policy != "" {
+		log.Printf("[DEBUG] topic cleanup_policy is : %v", policy)
+		opts.SetCleanupPolicy(policy, opts.RetentionTimeMS)
+	}
+
 	log.Printf("[DEBUG] Creating Kafka topic %s", opts.Name)
 
-	_, _, createErr := client.Kafka.CreateTopic(kafkaID, opts)
+	_, _, createErr := client.Kafka.CreateTopic(ctx, kafkaID, opts)
 	if createErr != nil {
 		return diag.FromErr(createErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for Kafka topic %s to be ready", opts.Name)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{kafka.TopicStatuses.PENDING.ToString()},
-		Target:       []string{kafka.TopicStatuses.READY.ToString()},
+	waiter := &wait.Waiter[*kafka.Topic, kafka.TopicStatus]{
+		Description:  fmt.Sprintf("Kafka topic %s to be ready", opts.Name),
+		Pending:      []kafka.TopicStatus{kafka.TopicStatuses.PENDING},
+		Target:       []kafka.TopicStatus{kafka.TopicStatuses.READY},
 		Refresh:      topicCreationStateRefreshFunc(client, kafkaID, opts.Name, opts.Partitions),
-		Timeout:      time.Duration(config.KafkaTopicCreateVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.KafkaTopicCreateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for topic to be ready on %s: %s", opts.Name, err.Error())
 	}
 
@@ -197,23 +245,23 @@ func resourceHerokuxKafkaTopicCreate(ctx context.Context, d *schema.ResourceData
 // topicCreationStateRefreshFunc checks if the topic is ready. 'Ready' state is determined by two things:
 //  1. the topic is present when retrieving from all topics
 //  2. the number of partitions matches the specified count.
-func topicCreationStateRefreshFunc(client *api.Client, kafkaID, topicName string, partitionCount int) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		topic, response, getErr := client.Kafka.GetTopicByName(kafkaID, topicName)
+func topicCreationStateRefreshFunc(client *api.Client, kafkaID, topicName string, partitionCount int) wait.RefreshFunc[*kafka.Topic, kafka.TopicStatus] {
+	return func(ctx context.Context) (*kafka.Topic, kafka.TopicStatus, error) {
+		topic, _, getErr := client.Kafka.GetTopicByName(ctx, kafkaID, topicName)
 		if getErr != nil {
-			if response.StatusCode == 404 {
+			if errors.Is(getErr, api.ErrNotFound) {
 				// this means the topic hasn't been created just yet
-				return nil, kafka.TopicStatuses.PENDING.ToString(), nil
+				return nil, kafka.TopicStatuses.PENDING, nil
 			}
-			return nil, kafka.TopicStatuses.UNKNOWN.ToString(), getErr
+			return nil, kafka.TopicStatuses.UNKNOWN, getErr
 		}
 
 		if topic.GetPartitions() != partitionCount {
 			log.Printf("[DEBUG] topic created but partitions not provisioned. Count is %d", topic.GetPartitions())
-			return topic, kafka.TopicStatuses.PENDING.ToString(), nil
+			return topic, kafka.TopicStatuses.PENDING, nil
 		}
 
-		return topic, kafka.TopicStatuses.READY.ToString(), nil
+		return topic, kafka.TopicStatuses.READY, nil
 	}
 }
 
@@ -228,8 +276,14 @@ func resourceHerokuxKafkaTopicRead(ctx context.Context, d *schema.ResourceData,
 	kafkaID := result[0]
 	name := result[1]
 
-	topic, _, getErr := client.Kafka.GetTopicByName(kafkaID, name)
+	topic, _, getErr := client.Kafka.GetTopicByName(ctx, kafkaID, name)
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Kafka topic %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return diag.FromErr(getErr)
 	}
 
@@ -252,7 +306,7 @@ func resourceHerokuxKafkaTopicRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("retention_time", retentiontimeDuration)
 	d.Set("compaction", topic.GetCompaction())
 	d.Set("status", topic.GetStatus())
-	d.Set("cleanup_policy", topic.GetCleanupPolicy())
+	d.Set("cleanup_policy", topic.EffectiveCleanupPolicy().ToString())
 
 	return nil
 }
@@ -271,10 +325,9 @@ func resourceHerokuxKafkaTopicUpdate(ctx context.Context, d *schema.ResourceData
 		opts.Name = vs
 	}
 
-	if v, ok := d.GetOk("compaction"); ok {
-		vs := v.(bool)
-		log.Printf("[DEBUG] topic compaction is : %v", vs)
-		opts.Compaction = vs
+	if v, ok := configuredCompaction(d.GetRawConfig()); ok {
+		log.Printf("[DEBUG] topic compaction is : %v", v)
+		opts.Compaction = v
 	}
 
 	if v, ok := d.GetOk("retention_time"); ok {
@@ -284,10 +337,8 @@ func resourceHerokuxKafkaTopicUpdate(ctx context.Context, d *schema.ResourceData
 		// Convert the duration to milliseconds as that's what is supported by the API
 		// unless the attribute value is `disable`. If it is disable,
 		// then set the opts.RetentionTimeMS to nil.
-		var targetRetentionTime int
 		if duration == kafka.RetentionTimeDisableVal {
 			opts.RetentionTimeMS = nil
-			targetRetentionTime = 0
 			log.Printf("[DEBUG] topic new retention_time (int) is : %v", "nil")
 		} else {
 			ms, conErr := kafka.ConvertDurationToMilliseconds(duration)
@@ -295,20 +346,35 @@ func resourceHerokuxKafkaTopicUpdate(ctx context.Context, d *schema.ResourceData
 				return diag.FromErr(conErr)
 			}
 			opts.RetentionTimeMS = &ms
-			targetRetentionTime = ms
 			log.Printf("[DEBUG] topic new retention_time (int) is : %v", ms)
 		}
+	}
 
-		// The API generally requires retention_time being set in the PUT request body but if there's an update,
-		// the resource needs to poll to check that the new retention time is applied correctly.
-		if ok := d.HasChange("retention_time"); ok {
-			// Setting checkFunc so the resource knows what to check for
+	if policy := configuredCleanupPolicy(d.GetRawConfig()); policy != "" {
+		log.Printf("[DEBUG] topic cleanup_policy is : %v", policy)
+		opts.SetCleanupPolicy(policy, opts.RetentionTimeMS)
+
+		if d.HasChange("cleanup_policy") {
 			checkFuncs = append(checkFuncs, func(t *kafka.Topic) bool {
-				return t.GetRetentionTimeInMS() == targetRetentionTime
+				return t.EffectiveCleanupPolicy() == policy
 			})
 		}
 	}
 
+	// The API generally requires retention_time being set in the PUT request body but if there's an update,
+	// the resource needs to poll to check that the new retention time is applied correctly.
+	if d.HasChanges("retention_time", "cleanup_policy") {
+		targetRetentionTime := 0
+		if opts.RetentionTimeMS != nil {
+			targetRetentionTime = *opts.RetentionTimeMS
+		}
+
+		// Setting checkFunc so the resource knows what to check for
+		checkFuncs = append(checkFuncs, func(t *kafka.Topic) bool {
+			return t.GetRetentionTimeInMS() == targetRetentionTime
+		})
+	}
+
 	if ok := d.HasChange("replication_factor"); ok {
 		vs := d.Get("replication_factor").(int)
 		log.Printf("[DEBUG] topic new replication_factor is : %v", vs)
@@ -317,21 +383,22 @@ func resourceHerokuxKafkaTopicUpdate(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[DEBUG] updating topic %s with %v", opts.Name, opts)
 
-	_, _, updateErr := client.Kafka.UpdateTopic(kafkaID, opts)
+	_, _, updateErr := client.Kafka.UpdateTopic(ctx, kafkaID, opts)
 	if updateErr != nil {
 		return diag.FromErr(updateErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for Kafka topic %s to be updated", opts.Name)
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{kafka.TopicStatuses.UPDATING.ToString()},
-		Target:       []string{kafka.TopicStatuses.UPDATED.ToString()},
+	waiter := &wait.Waiter[*kafka.Topic, kafka.TopicStatus]{
+		Description:  fmt.Sprintf("Kafka topic %s to be updated", opts.Name),
+		Pending:      []kafka.TopicStatus{kafka.TopicStatuses.UPDATING},
+		Target:       []kafka.TopicStatus{kafka.TopicStatuses.UPDATED},
 		Refresh:      topicUpdateStateRefreshFunc(client, kafkaID, opts.Name, checkFuncs),
-		Timeout:      time.Duration(config.KafkaTopicCreateVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.KafkaTopicUpdateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for topic to be updated on %s: %s", opts.Name, err.Error())
 	}
 
@@ -342,22 +409,21 @@ func resourceHerokuxKafkaTopicUpdate(ctx context.Context, d *schema.ResourceData
 
 // topicUpdateStateRefreshFunc checks if certain topic fields were updated remotely
 // by executing custom functions passed in as function argument.
-func topicUpdateStateRefreshFunc(client *api.Client, kafkaID, topicName string, checkFuncs []func(t *kafka.Topic) bool) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		topic, _, getErr := client.Kafka.GetTopicByName(kafkaID, topicName)
+func topicUpdateStateRefreshFunc(client *api.Client, kafkaID, topicName string, checkFuncs []func(t *kafka.Topic) bool) wait.RefreshFunc[*kafka.Topic, kafka.TopicStatus] {
+	return func(ctx context.Context) (*kafka.Topic, kafka.TopicStatus, error) {
+		topic, _, getErr := client.Kafka.GetTopicByName(ctx, kafkaID, topicName)
 		if getErr != nil {
-			return nil, kafka.TopicStatuses.UNKNOWN.ToString(), getErr
+			return nil, kafka.TopicStatuses.UNKNOWN, getErr
 		}
 
 		// Loop through all the checkFuncs. Return UPDATING if any of the functions return false
 		for _, cf := range checkFuncs {
 			if !cf(topic) {
-				log.Printf("[DEBUG] topic not updated yet")
-				return topic, kafka.TopicStatuses.UPDATING.ToString(), nil
+				return topic, kafka.TopicStatuses.UPDATING, nil
 			}
 		}
 
-		return topic, kafka.TopicStatuses.UPDATED.ToString(), nil
+		return topic, kafka.TopicStatuses.UPDATED, nil
 	}
 }
 
@@ -374,7 +440,7 @@ func resourceHerokuxKafkaTopicDelete(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[DEBUG] Deleting Kafka topic %s", name)
 
-	_, _, deleteErr := client.Kafka.DeleteTopic(kafkaID, name)
+	_, _, deleteErr := client.Kafka.DeleteTopic(ctx, kafkaID, name)
 	if deleteErr != nil {
 		return diag.FromErr(deleteErr)
 	}
@@ -3,14 +3,13 @@ package herokux
 import (
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/helper/test"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
 )
 
 func TestAccHerokuxKafkaTopic_Basic(t *testing.T) {
 	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
-	topicName := fmt.Sprintf("tftest-%s", acctest.RandString(15))
+	topicName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 15))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -43,7 +42,7 @@ func TestAccHerokuxKafkaTopic_Basic(t *testing.T) {
 
 func TestAccHerokuxKafkaTopic_Simple(t *testing.T) {
 	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
-	topicName := fmt.Sprintf("tftest-%s", acctest.RandString(15))
+	topicName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 15))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -76,7 +75,7 @@ func TestAccHerokuxKafkaTopic_Simple(t *testing.T) {
 
 func TestAccHerokuxKafkaTopic_UpdatePlan(t *testing.T) {
 	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
-	topicName := fmt.Sprintf("tftest-%s", acctest.RandString(15))
+	topicName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 15))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -130,7 +129,7 @@ func TestAccHerokuxKafkaTopic_UpdatePlan(t *testing.T) {
 
 func TestAccHerokuxKafkaTopic_DisableRetention(t *testing.T) {
 	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
-	topicName := fmt.Sprintf("tftest-%s", acctest.RandString(15))
+	topicName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 15))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -182,13 +181,43 @@ func TestAccHerokuxKafkaTopic_DisableRetention(t *testing.T) {
 	})
 }
 
+func TestAccHerokuxKafkaTopic_CleanupPolicy(t *testing.T) {
+	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
+	topicName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 15))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuxKafkaTopic_cleanupPolicy(kafkaID, topicName, "compact", "disable"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"herokux_kafka_topic.foobar", "cleanup_policy", "compact"),
+					resource.TestCheckResourceAttr(
+						"herokux_kafka_topic.foobar", "retention_time", "disable"),
+				),
+			},
+			{
+				Config: testAccCheckHerokuxKafkaTopic_cleanupPolicy(kafkaID, topicName, "delete", "2d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"herokux_kafka_topic.foobar", "cleanup_policy", "delete"),
+					resource.TestCheckResourceAttr(
+						"herokux_kafka_topic.foobar", "retention_time", "2d"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccE2EHerokuxKafkaTopic(t *testing.T) {
 	testAccConfig.GetRunE2ETestsOrSkip(t)
 
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	plan := "heroku-kafka:standard-0"
-	topicName := fmt.Sprintf("tftest-%s", acctest.RandString(15))
+	topicName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 15))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -280,3 +309,16 @@ resource "herokux_kafka_topic" "foobar" {
 }
 `, test.HerokuAppAddonBlock(appName, orgName, addonPlan), topicName)
 }
+
+func testAccCheckHerokuxKafkaTopic_cleanupPolicy(kafkaID, name, policy, retentionTime string) string {
+	return fmt.Sprintf(`
+resource "herokux_kafka_topic" "foobar" {
+	kafka_id = "%s"
+	name = "%s"
+	partitions = 8
+	replication_factor = 3
+	retention_time = "%s"
+	cleanup_policy = "%s"
+}
+`, kafkaID, name, retentionTime, policy)
+}
@@ -0,0 +1,721 @@
+package herokux
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// kafkaTopicSpec is a topic declared in a topic block of herokux_kafka_topics.
+type kafkaTopicSpec struct {
+	Name              string
+	Partitions        int
+	ReplicationFactor int
+	RetentionTime     string
+	CleanupPolicy     kafka.TopicCleanupPolicy
+}
+
+func resourceHerokuxKafkaTopics() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuxKafkaTopicsCreate,
+		ReadContext:   resourceHerokuxKafkaTopicsRead,
+		UpdateContext: resourceHerokuxKafkaTopicsUpdate,
+		DeleteContext: resourceHerokuxKafkaTopicsDelete,
+		CustomizeDiff: resourceHerokuxKafkaTopicsCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuxKafkaTopicsImport,
+		},
+
+		Timeouts: verifiedResourceTimeouts(30*time.Minute, 30*time.Minute, 30*time.Minute),
+
+		Schema: map[string]*schema.Schema{
+			"kafka_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"topic": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"partitions": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"replication_factor": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							ValidateFunc: validation.IntAtLeast(3),
+						},
+
+						"retention_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "1d",
+							ValidateFunc: validateRetentionTime,
+						},
+
+						"cleanup_policy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  kafka.TopicCleanupPolicies.DELETE.ToString(),
+							ValidateFunc: validation.StringInSlice([]string{
+								kafka.TopicCleanupPolicies.DELETE.ToString(),
+								kafka.TopicCleanupPolicies.COMPACT.ToString(),
+								kafka.TopicCleanupPolicies.COMPACT_DELETE.ToString(),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"prune_unmanaged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+
+			"unmanaged_topics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// expandKafkaTopicSpecs returns the topics of a topic set, keyed by name.
+func expandKafkaTopicSpecs(v interface{}) map[string]*kafkaTopicSpec {
+	specs := make(map[string]*kafkaTopicSpec)
+
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return specs
+	}
+
+	for _, raw := range set.List() {
+		t := raw.(map[string]interface{})
+		specs[t["name"].(string)] = &kafkaTopicSpec{
+			Name:              t["name"].(string),
+			Partitions:        t["partitions"].(int),
+			ReplicationFactor: t["replication_factor"].(int),
+			RetentionTime:     t["retention_time"].(string),
+			CleanupPolicy:     kafka.TopicCleanupPolicy(t["cleanup_policy"].(string)),
+		}
+	}
+
+	return specs
+}
+
+// request returns the request to create the topic, or to update it if update is true.
+func (s *kafkaTopicSpec) request(update bool) (*kafka.TopicRequest, error) {
+	opts := &kafka.TopicRequest{
+		Name:              s.Name,
+		ReplicationFactor: s.ReplicationFactor,
+	}
+
+	// Partitions cannot be changed after creation.
+	if !update {
+		opts.Partitions = s.Partitions
+	}
+
+	var retentionTimeMS *int
+	if s.RetentionTime != kafka.RetentionTimeDisableVal {
+		ms, convErr := kafka.ConvertDurationToMilliseconds(s.RetentionTime)
+		if convErr != nil {
+			return nil, convErr
+		}
+		retentionTimeMS = &ms
+	}
+
+	opts.SetCleanupPolicy(s.CleanupPolicy, retentionTimeMS)
+
+	return opts, nil
+}
+
+// appliedTo reports whether the topic has the partitions, retention time and cleanup policy of the spec.
+func (s *kafkaTopicSpec) appliedTo(t *kafka.Topic) bool {
+	if t == nil || t.GetPartitions() != s.Partitions || t.EffectiveCleanupPolicy() != s.CleanupPolicy {
+		return false
+	}
+
+	retentionTime, convErr := kafkaTopicRetentionTime(t)
+	return convErr == nil && retentionTime == s.RetentionTime
+}
+
+// kafkaTopicRetentionTime returns the retention time of the topic as a duration, or "disable" if it has none.
+func kafkaTopicRetentionTime(t *kafka.Topic) (string, error) {
+	if t.GetRetentionTimeInMS() == 0 {
+		return kafka.RetentionTimeDisableVal, nil
+	}
+
+	return kafka.ConvertMillisecondsToDuration(t.GetRetentionTimeInMS())
+}
+
+func resourceHerokuxKafkaTopicsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("topic") {
+		return nil
+	}
+
+	specs := expandKafkaTopicSpecs(d.Get("topic"))
+	if n := d.Get("topic").(*schema.Set).Len(); n != len(specs) {
+		return fmt.Errorf("each topic must only be declared once, but %d topic blocks declare %d names", n, len(specs))
+	}
+
+	// Topics that become managed are no longer unmanaged, and all unmanaged topics are deleted when pruning.
+	oldUnmanaged := d.Get("unmanaged_topics").([]interface{})
+	newUnmanaged := make([]interface{}, 0, len(oldUnmanaged))
+	if !d.Get("prune_unmanaged").(bool) {
+		for _, name := range oldUnmanaged {
+			if specs[name.(string)] == nil {
+				newUnmanaged = append(newUnmanaged, name)
+			}
+		}
+	}
+
+	if len(newUnmanaged) != len(oldUnmanaged) {
+		if err := d.SetNew("unmanaged_topics", newUnmanaged); err != nil {
+			return err
+		}
+	}
+
+	if !d.HasChange("topic") {
+		return nil
+	}
+
+	o, _ := d.GetChange("topic")
+	oldSpecs := expandKafkaTopicSpecs(o)
+
+	for _, name := range sortedKafkaTopicNames(specs) {
+		spec := specs[name]
+
+		// Partitions cannot be changed, and replacing the topic would delete all of its messages.
+		if old := oldSpecs[name]; old != nil && old.Partitions != spec.Partitions {
+			return fmt.Errorf("topic %s: partitions cannot be changed from %d to %d after the topic is created. "+
+				"To delete the topic and its messages and create it again, remove it first and then declare it again",
+				name, old.Partitions, spec.Partitions)
+		}
+
+		if err := validateKafkaTopicCleanupPolicy(spec.CleanupPolicy, spec.RetentionTime); err != nil {
+			return fmt.Errorf("topic %s: %w", spec.Name, err)
+		}
+	}
+
+	// The cluster may not exist yet.
+	if !d.NewValueKnown("kafka_id") {
+		return nil
+	}
+
+	config := meta.(*Config)
+	kafkaID := d.Get("kafka_id").(string)
+
+	cluster, _, getErr := config.API.Kafka.Get(ctx, kafkaID)
+	if getErr != nil {
+		return fmt.Errorf("unable to retrieve kafka %s to validate the topics against its limits: %w", kafkaID, getErr)
+	}
+
+	// Only the declared topics that change are validated, as the others already exist.
+	var changed []*kafkaTopicSpec
+	added := false
+	for _, name := range sortedKafkaTopicNames(specs) {
+		spec, old := specs[name], oldSpecs[name]
+		if old == nil || *old != *spec {
+			changed = append(changed, spec)
+		}
+		if old == nil {
+			added = true
+		}
+	}
+
+	for _, spec := range changed {
+		if spec.CleanupPolicy == kafka.TopicCleanupPolicies.COMPACT_DELETE &&
+			!cluster.GetCapabilities().GetSupportsMixedCleanupPolicy() {
+			return fmt.Errorf("topic %s: kafka %s does not support the %q cleanup_policy", spec.Name, kafkaID, spec.CleanupPolicy)
+		}
+	}
+
+	limits := cluster.GetLimits()
+	if limits == nil {
+		log.Printf("[DEBUG] kafka %s has no limits, skipping topics validation", kafkaID)
+		return nil
+	}
+
+	for _, spec := range changed {
+		if (limits.MinimumReplication != nil && spec.ReplicationFactor < limits.GetMinimumReplication()) ||
+			(limits.MaximumReplication != nil && spec.ReplicationFactor > limits.GetMaximumReplication()) {
+			return fmt.Errorf("topic %s: replication_factor %d is outside the range allowed by kafka %s: %d to %d",
+				spec.Name, spec.ReplicationFactor, kafkaID, limits.GetMinimumReplication(), limits.GetMaximumReplication())
+		}
+
+		if err := validateKafkaTopicRetentionTime(spec.RetentionTime, kafkaID, limits); err != nil {
+			return fmt.Errorf("topic %s: %w", spec.Name, err)
+		}
+
+		if limits.MaxNumberOfPartitionsPerTopic != nil && spec.Partitions > limits.GetMaxNumberOfPartitionsPerTopic() {
+			return fmt.Errorf("topic %s: partitions %d exceeds the maximum number of partitions per topic allowed by kafka %s: %d",
+				spec.Name, spec.Partitions, kafkaID, limits.GetMaxNumberOfPartitionsPerTopic())
+		}
+	}
+
+	if !added || (limits.MaxNumberOfTotalPartitions == nil && limits.MaxNumberOfTopics == nil) {
+		return nil
+	}
+
+	existing, _, listErr := config.API.Kafka.ListTopics(ctx, kafkaID)
+	if listErr != nil {
+		return fmt.Errorf("unable to retrieve the topics of kafka %s to validate the topics against its limits: %w",
+			kafkaID, listErr)
+	}
+
+	// The previously declared topics are replaced by the declared topics and, when pruning,
+	// all other topics except the admin topics are deleted.
+	prune := d.Get("prune_unmanaged").(bool)
+	admin := make(map[string]bool, len(cluster.AdminTopicNames))
+	for _, name := range cluster.AdminTopicNames {
+		admin[name] = true
+	}
+
+	topics := make(map[string]int)
+	for _, t := range existing.Topics {
+		if oldSpecs[t.GetName()] == nil && (!prune || admin[t.GetName()]) {
+			topics[t.GetName()] = t.GetPartitions()
+		}
+	}
+
+	for name, spec := range specs {
+		topics[name] = spec.Partitions
+	}
+
+	return validateKafkaClusterTopics(topics, kafkaID, limits)
+}
+
+func resourceHerokuxKafkaTopicsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Config).API
+	kafkaID := d.Id()
+
+	topics, _, listErr := client.Kafka.ListTopics(ctx, kafkaID)
+	if listErr != nil {
+		return nil, listErr
+	}
+
+	admin, adminErr := kafkaAdminTopicNames(ctx, client, kafkaID)
+	if adminErr != nil {
+		return nil, adminErr
+	}
+
+	// All topics, except admin topics, are imported as managed topics.
+	managed := make([]interface{}, 0, len(topics.Topics))
+	for _, t := range topics.Topics {
+		if !admin[t.GetName()] {
+			managed = append(managed, map[string]interface{}{"name": t.GetName()})
+		}
+	}
+
+	d.Set("kafka_id", kafkaID)
+	d.Set("topic", managed)
+	d.Set("prune_unmanaged", false)
+	d.Set("parallelism", 5)
+
+	readErr := resourceHerokuxKafkaTopicsRead(ctx, d, meta)
+	if readErr.HasError() {
+		return nil, fmt.Errorf("unable to import resource")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceHerokuxKafkaTopicsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(getKafkaID(d))
+
+	return resourceHerokuxKafkaTopicsApply(ctx, d, meta)
+}
+
+func resourceHerokuxKafkaTopicsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readKafkaTopics(ctx, d, meta, nil)
+}
+
+// readKafkaTopics sets the declared topics, and the topics named retained, to their remote values
+// with a single request for all topics of the cluster. Declared topics that no longer exist are removed.
+func readKafkaTopics(ctx context.Context, d *schema.ResourceData, meta interface{}, retained []string) diag.Diagnostics {
+	client := meta.(*Config).API
+	kafkaID := d.Id()
+
+	managed := make(map[string]bool)
+	for name := range expandKafkaTopicSpecs(d.Get("topic")) {
+		managed[name] = true
+	}
+	for _, name := range retained {
+		managed[name] = true
+	}
+
+	topics, _, listErr := client.Kafka.ListTopics(ctx, kafkaID)
+	if listErr != nil {
+		if errors.Is(listErr, api.ErrNotFound) {
+			log.Printf("[WARN] Kafka %s not found, removing topics from state", kafkaID)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(listErr)
+	}
+
+	topicSet := make([]interface{}, 0, len(managed))
+	var candidates []string
+	for _, t := range topics.Topics {
+		if !managed[t.GetName()] {
+			candidates = append(candidates, t.GetName())
+			continue
+		}
+
+		retentionTime, convErr := kafkaTopicRetentionTime(t)
+		if convErr != nil {
+			return diag.FromErr(convErr)
+		}
+
+		topicSet = append(topicSet, map[string]interface{}{
+			"name":               t.GetName(),
+			"partitions":         t.GetPartitions(),
+			"replication_factor": t.GetReplicationFactor(),
+			"retention_time":     retentionTime,
+			"cleanup_policy":     t.EffectiveCleanupPolicy().ToString(),
+		})
+	}
+
+	// The cluster is only retrieved for its admin topic names if there are topics that are not declared.
+	unmanaged := make([]string, 0, len(candidates))
+	if len(candidates) > 0 {
+		admin, adminErr := kafkaAdminTopicNames(ctx, client, kafkaID)
+		if adminErr != nil {
+			return diag.FromErr(adminErr)
+		}
+
+		for _, name := range candidates {
+			if !admin[name] {
+				unmanaged = append(unmanaged, name)
+			}
+		}
+	}
+	sort.Strings(unmanaged)
+
+	d.Set("kafka_id", kafkaID)
+	d.Set("unmanaged_topics", unmanaged)
+
+	if err := d.Set("topic", topicSet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// kafkaAdminTopicNames returns the names of the topics managed by Heroku, which are never managed or pruned.
+func kafkaAdminTopicNames(ctx context.Context, client *api.Client, kafkaID string) (map[string]bool, error) {
+	cluster, _, getErr := client.Kafka.Get(ctx, kafkaID)
+	if getErr != nil {
+		return nil, fmt.Errorf("unable to retrieve the admin topics of kafka %s: %w", kafkaID, getErr)
+	}
+
+	admin := make(map[string]bool, len(cluster.AdminTopicNames))
+	for _, name := range cluster.AdminTopicNames {
+		admin[name] = true
+	}
+
+	return admin, nil
+}
+
+func resourceHerokuxKafkaTopicsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceHerokuxKafkaTopicsApply(ctx, d, meta)
+}
+
+// resourceHerokuxKafkaTopicsApply deletes, creates and updates topics so the cluster has the declared topics.
+// Topics are first deleted, then created and updated.
+// The requests of each step are sent in parallel, at most parallelism at a time.
+func resourceHerokuxKafkaTopicsApply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*Config).API
+	kafkaID := d.Id()
+	parallelism := d.Get("parallelism").(int)
+
+	o, n := d.GetChange("topic")
+	oldSpecs, newSpecs := expandKafkaTopicSpecs(o), expandKafkaTopicSpecs(n)
+
+	existing, _, listErr := client.Kafka.ListTopics(ctx, kafkaID)
+	if listErr != nil {
+		return diag.FromErr(listErr)
+	}
+
+	remote := make(map[string]*kafka.Topic, len(existing.Topics))
+	for _, t := range existing.Topics {
+		remote[t.GetName()] = t
+	}
+
+	var toDelete, toCreate, toUpdate []string
+	for name := range oldSpecs {
+		if newSpecs[name] == nil {
+			toDelete = append(toDelete, name)
+		}
+	}
+
+	// When pruning, the topics that are not declared are deleted, except the admin topics.
+	// They are found from the existing topics, as unmanaged_topics is not known on creation
+	// or when pruning is first enabled.
+	if d.Get("prune_unmanaged").(bool) {
+		var candidates []string
+		for name := range remote {
+			if oldSpecs[name] == nil && newSpecs[name] == nil {
+				candidates = append(candidates, name)
+			}
+		}
+
+		if len(candidates) > 0 {
+			admin, adminErr := kafkaAdminTopicNames(ctx, client, kafkaID)
+			if adminErr != nil {
+				return diag.FromErr(adminErr)
+			}
+
+			sort.Strings(candidates)
+			for _, name := range candidates {
+				if !admin[name] {
+					toDelete = append(toDelete, name)
+				}
+			}
+		}
+	}
+
+	for name, spec := range newSpecs {
+		old := oldSpecs[name]
+		switch {
+		case old != nil:
+			if *old != *spec {
+				toUpdate = append(toUpdate, name)
+			}
+		case remote[name] != nil:
+			// A topic that already exists is adopted if it can be updated to match.
+			if remote[name].GetPartitions() != spec.Partitions {
+				diags = append(diags, diag.Errorf("topic %s already exists on kafka %s with %d partitions, not %d",
+					name, kafkaID, remote[name].GetPartitions(), spec.Partitions)...)
+				continue
+			}
+			if !spec.appliedTo(remote[name]) || remote[name].GetReplicationFactor() != spec.ReplicationFactor {
+				toUpdate = append(toUpdate, name)
+			}
+		default:
+			toCreate = append(toCreate, name)
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Applying Kafka topics on %s: deleting %v, creating %v, updating %v",
+		kafkaID, toDelete, toCreate, toUpdate)
+
+	// If a topic cannot be deleted, it stays in state so the deletion is planned again.
+	deleteDiags, deleted := forEachKafkaTopic("delete", toDelete, parallelism, func(name string) error {
+		_, _, deleteErr := client.Kafka.DeleteTopic(ctx, kafkaID, name)
+		if errors.Is(deleteErr, api.ErrNotFound) {
+			return nil
+		}
+		return deleteErr
+	})
+	diags = append(diags, deleteDiags...)
+
+	var retained []string
+	for _, name := range toDelete {
+		if !deleted[name] {
+			retained = append(retained, name)
+		}
+	}
+
+	if diags.HasError() {
+		return append(diags, readKafkaTopics(ctx, d, meta, retained)...)
+	}
+
+	if len(toDelete) > 0 {
+		waitErr := waitForKafkaTopics(ctx, client, kafkaID, "topics to be deleted", func(topics map[string]*kafka.Topic) bool {
+			for _, name := range toDelete {
+				if topics[name] != nil {
+					return false
+				}
+			}
+			return true
+		})
+		if waitErr != nil {
+			return append(diags, diag.FromErr(waitErr)...)
+		}
+	}
+
+	createDiags, created := forEachKafkaTopic("create", toCreate, parallelism, func(name string) error {
+		opts, reqErr := newSpecs[name].request(false)
+		if reqErr != nil {
+			return reqErr
+		}
+		_, _, createErr := client.Kafka.CreateTopic(ctx, kafkaID, opts)
+		return createErr
+	})
+	diags = append(diags, createDiags...)
+
+	updateDiags, updated := forEachKafkaTopic("update", toUpdate, parallelism, func(name string) error {
+		opts, reqErr := newSpecs[name].request(true)
+		if reqErr != nil {
+			return reqErr
+		}
+		_, _, updateErr := client.Kafka.UpdateTopic(ctx, kafkaID, opts)
+		return updateErr
+	})
+	diags = append(diags, updateDiags...)
+
+	if len(created)+len(updated) > 0 {
+		waitErr := waitForKafkaTopics(ctx, client, kafkaID, "topics to be ready", func(topics map[string]*kafka.Topic) bool {
+			for name, spec := range newSpecs {
+				if (created[name] || updated[name]) && !spec.appliedTo(topics[name]) {
+					return false
+				}
+			}
+			return true
+		})
+		if waitErr != nil {
+			diags = append(diags, diag.FromErr(waitErr)...)
+		}
+	}
+
+	return append(diags, readKafkaTopics(ctx, d, meta, nil)...)
+}
+
+// forEachKafkaTopic calls fn for each topic name, at most parallelism at a time. It returns an error diagnostic
+// for each failed call, in the order of the names, and the names for which fn succeeded.
+func forEachKafkaTopic(action string, names []string, parallelism int, fn func(name string) error) (diag.Diagnostics, map[string]bool) {
+	sort.Strings(names)
+
+	errs := make([]error, len(names))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+
+	for i, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			log.Printf("[DEBUG] Sending request to %s Kafka topic %s", action, name)
+			errs[i] = fn(name)
+		}(i, name)
+	}
+
+	wg.Wait()
+
+	var diags diag.Diagnostics
+	succeeded := make(map[string]bool, len(names))
+	for i, name := range names {
+		if errs[i] != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to %s Kafka topic %s", action, name),
+				Detail:   errs[i].Error(),
+			})
+			continue
+		}
+		succeeded[name] = true
+	}
+
+	return diags, succeeded
+}
+
+// waitForKafkaTopics polls the topics of the cluster, with a single request for all topics,
+// until done reports they are in the expected state. It waits until the timeout of the operation elapses.
+func waitForKafkaTopics(ctx context.Context, client *api.Client, kafkaID, description string,
+	done func(topics map[string]*kafka.Topic) bool) error {
+	waiter := &wait.Waiter[map[string]*kafka.Topic, kafka.TopicStatus]{
+		Description: fmt.Sprintf("Kafka %s %s", kafkaID, description),
+		Pending:     []kafka.TopicStatus{kafka.TopicStatuses.PENDING},
+		Target:      []kafka.TopicStatus{kafka.TopicStatuses.READY},
+		Refresh: func(ctx context.Context) (map[string]*kafka.Topic, kafka.TopicStatus, error) {
+			topics, _, listErr := client.Kafka.ListTopics(ctx, kafkaID)
+			if listErr != nil {
+				return nil, kafka.TopicStatuses.UNKNOWN, listErr
+			}
+
+			byName := make(map[string]*kafka.Topic, len(topics.Topics))
+			for _, t := range topics.Topics {
+				byName[t.GetName()] = t
+			}
+
+			if !done(byName) {
+				return byName, kafka.TopicStatuses.PENDING, nil
+			}
+
+			return byName, kafka.TopicStatuses.READY, nil
+		},
+		PollInterval: StateRefreshPollInterval,
+	}
+
+	_, err := waiter.Wait(ctx)
+	return err
+}
+
+// sortedKafkaTopicNames returns the names of the topics in order.
+func sortedKafkaTopicNames(specs map[string]*kafkaTopicSpec) []string {
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func resourceHerokuxKafkaTopicsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).API
+	kafkaID := d.Id()
+
+	// Only the declared topics are deleted, even if unmanaged topics are pruned.
+	names := sortedKafkaTopicNames(expandKafkaTopicSpecs(d.Get("topic")))
+
+	log.Printf("[DEBUG] Deleting Kafka topics %v on %s", names, kafkaID)
+
+	diags, _ := forEachKafkaTopic("delete", names, d.Get("parallelism").(int), func(name string) error {
+		_, _, deleteErr := client.Kafka.DeleteTopic(ctx, kafkaID, name)
+		if errors.Is(deleteErr, api.ErrNotFound) {
+			return nil
+		}
+		return deleteErr
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,369 @@
+package herokux
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/kafka"
+	"github.com/davidji99/terraform-provider-herokux/api/mock"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAccHerokuxKafkaTopics_Basic(t *testing.T) {
+	kafkaID := testAccConfig.GetKafkaIDorSkip(t)
+	prefix := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuxKafkaTopics_basic(kafkaID, prefix, "1d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"herokux_kafka_topics.foobar", "kafka_id", kafkaID),
+					resource.TestCheckResourceAttr(
+						"herokux_kafka_topics.foobar", "topic.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"herokux_kafka_topics.foobar", "topic.*", map[string]string{
+							"name":           prefix + "-orders",
+							"partitions":     "8",
+							"retention_time": "1d",
+						}),
+				),
+			},
+			{
+				Config: testAccCheckHerokuxKafkaTopics_basic(kafkaID, prefix, "2d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"herokux_kafka_topics.foobar", "topic.*", map[string]string{
+							"name":           prefix + "-orders",
+							"retention_time": "2d",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuxKafkaTopics_basic(kafkaID, prefix, retentionTime string) string {
+	return fmt.Sprintf(`
+resource "herokux_kafka_topics" "foobar" {
+	kafka_id = "%[1]s"
+
+	topic {
+		name = "%[2]s-orders"
+		partitions = 8
+		retention_time = "%[3]s"
+	}
+
+	topic {
+		name = "%[2]s-customers"
+		partitions = 8
+		retention_time = "disable"
+		cleanup_policy = "compact"
+	}
+}
+`, kafkaID, prefix, retentionTime)
+}
+
+// testKafkaTopicsCluster is an in-memory cluster whose topic changes take effect immediately.
+type testKafkaTopicsCluster struct {
+	mu        sync.Mutex
+	topics    map[string]*kafka.Topic
+	calls     map[string][]string
+	lists     int
+	inFlight  int
+	maxFlight int
+}
+
+func newTestKafkaTopicsCluster(existing ...string) *testKafkaTopicsCluster {
+	c := &testKafkaTopicsCluster{topics: make(map[string]*kafka.Topic), calls: make(map[string][]string)}
+	for _, name := range existing {
+		c.set(&kafka.TopicRequest{Name: name, Partitions: 8, ReplicationFactor: 3})
+	}
+	return c
+}
+
+func (c *testKafkaTopicsCluster) set(opts *kafka.TopicRequest) {
+	name, partitions, rf := opts.Name, opts.Partitions, opts.ReplicationFactor
+	if t, ok := c.topics[name]; ok {
+		partitions = t.GetPartitions()
+	}
+
+	retention := 0
+	if opts.RetentionTimeMS != nil {
+		retention = *opts.RetentionTimeMS
+	}
+
+	c.topics[name] = &kafka.Topic{Name: &name, Partitions: &partitions, ReplicationFactor: &rf,
+		RetentionTimeInMS: &retention, CompactionEnabled: &opts.Compaction}
+}
+
+// call records a call and how many calls are in flight.
+func (c *testKafkaTopicsCluster) call(method, name string, fn func()) {
+	c.mu.Lock()
+	c.calls[method] = append(c.calls[method], name)
+	c.inFlight++
+	if c.inFlight > c.maxFlight {
+		c.maxFlight = c.inFlight
+	}
+	c.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inFlight--
+	fn()
+}
+
+func (c *testKafkaTopicsCluster) config() *Config {
+	return &Config{API: &api.Client{Kafka: &mock.KafkaService{
+		GetFunc: func(ctx context.Context, clusterID string) (*kafka.Cluster, *simpleresty.Response, error) {
+			return &kafka.Cluster{AdminTopicNames: []string{"__consumer_offsets"}}, nil, nil
+		},
+		ListTopicsFunc: func(ctx context.Context, clusterID string) (*kafka.Topics, *simpleresty.Response, error) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			c.lists++
+
+			topics := &kafka.Topics{}
+			for _, t := range c.topics {
+				topics.Topics = append(topics.Topics, t)
+			}
+			return topics, nil, nil
+		},
+		CreateTopicFunc: func(ctx context.Context, clusterID string, opts *kafka.TopicRequest) (*kafka.Response, *simpleresty.Response, error) {
+			c.call("create", opts.Name, func() { c.set(opts) })
+			return nil, nil, nil
+		},
+		UpdateTopicFunc: func(ctx context.Context, clusterID string, opts *kafka.TopicRequest) (*kafka.Response, *simpleresty.Response, error) {
+			c.call("update", opts.Name, func() { c.set(opts) })
+			return nil, nil, nil
+		},
+		DeleteTopicFunc: func(ctx context.Context, clusterID string, topicName string) (*kafka.Response, *simpleresty.Response, error) {
+			var err error
+			c.call("delete", topicName, func() {
+				if _, ok := c.topics[topicName]; !ok {
+					err = apierror.NewNotFound("topic %s not found", topicName)
+				}
+				delete(c.topics, topicName)
+			})
+			return nil, nil, err
+		},
+	}}}
+}
+
+func (c *testKafkaTopicsCluster) reset() {
+	c.calls = make(map[string][]string)
+	c.lists = 0
+	c.maxFlight = 0
+}
+
+func (c *testKafkaTopicsCluster) sortedCalls(method string) []string {
+	calls := append([]string{}, c.calls[method]...)
+	sort.Strings(calls)
+	return calls
+}
+
+func testKafkaTopicsApply(t *testing.T, config *Config, state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceState {
+	r := resourceHerokuxKafkaTopics()
+	raw["kafka_id"] = testKafkaID
+
+	diff, diffErr := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), config)
+	assert.Nil(t, diffErr)
+	if diff == nil {
+		return state
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, config)
+	assert.Empty(t, diags)
+
+	return newState
+}
+
+func testKafkaTopicsBlocks(names ...string) []interface{} {
+	blocks := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		blocks = append(blocks, map[string]interface{}{"name": name, "partitions": 8})
+	}
+	return blocks
+}
+
+func TestResourceHerokuxKafkaTopics_Create(t *testing.T) {
+	cluster := newTestKafkaTopicsCluster("__consumer_offsets", "legacy")
+	config := cluster.config()
+
+	state := testKafkaTopicsApply(t, config, nil, map[string]interface{}{
+		"parallelism": 2,
+		"topic":       testKafkaTopicsBlocks("a", "b", "c", "d", "e"),
+	})
+
+	assert.Equal(t, testKafkaID, state.ID)
+	assert.Equal(t, "5", state.Attributes["topic.#"])
+	assert.Equal(t, "1", state.Attributes["unmanaged_topics.#"])
+	assert.Equal(t, "legacy", state.Attributes["unmanaged_topics.0"])
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, cluster.sortedCalls("create"))
+	assert.Equal(t, 2, cluster.maxFlight)
+
+	// The existing topics are listed once to plan the changes, once to wait and once to read the result.
+	assert.Equal(t, 3, cluster.lists)
+}
+
+func TestResourceHerokuxKafkaTopics_Update(t *testing.T) {
+	cluster := newTestKafkaTopicsCluster("__consumer_offsets", "legacy")
+	config := cluster.config()
+
+	state := testKafkaTopicsApply(t, config, nil, map[string]interface{}{
+		"topic": testKafkaTopicsBlocks("a", "b", "c"),
+	})
+	cluster.reset()
+
+	topics := testKafkaTopicsBlocks("a", "b", "d")
+	topics[0].(map[string]interface{})["retention_time"] = "2d"
+
+	state = testKafkaTopicsApply(t, config, state, map[string]interface{}{"topic": topics})
+
+	assert.Equal(t, []string{"c"}, cluster.sortedCalls("delete"))
+	assert.Equal(t, []string{"d"}, cluster.sortedCalls("create"))
+	assert.Equal(t, []string{"a"}, cluster.sortedCalls("update"))
+	assert.Equal(t, 172800000, cluster.topics["a"].GetRetentionTimeInMS())
+	assert.Equal(t, "3", state.Attributes["topic.#"])
+	assert.Equal(t, "legacy", state.Attributes["unmanaged_topics.0"])
+}
+
+func TestResourceHerokuxKafkaTopics_PruneUnmanaged(t *testing.T) {
+	cluster := newTestKafkaTopicsCluster("__consumer_offsets", "legacy", "other")
+	config := cluster.config()
+
+	state := testKafkaTopicsApply(t, config, nil, map[string]interface{}{
+		"topic": testKafkaTopicsBlocks("a"),
+	})
+	assert.Equal(t, "2", state.Attributes["unmanaged_topics.#"])
+	cluster.reset()
+
+	// Declaring an unmanaged topic adopts it, and the other unmanaged topics are pruned.
+	state = testKafkaTopicsApply(t, config, state, map[string]interface{}{
+		"prune_unmanaged": true,
+		"topic":           testKafkaTopicsBlocks("a", "legacy"),
+	})
+
+	assert.Equal(t, []string{"other"}, cluster.sortedCalls("delete"))
+	assert.Empty(t, cluster.calls["create"])
+	assert.Equal(t, []string{"legacy"}, cluster.sortedCalls("update"))
+	assert.Contains(t, cluster.topics, "__consumer_offsets")
+	assert.Equal(t, "2", state.Attributes["topic.#"])
+	assert.Equal(t, "0", state.Attributes["unmanaged_topics.#"])
+}
+
+func TestResourceHerokuxKafkaTopics_PruneUnmanagedOnCreate(t *testing.T) {
+	cluster := newTestKafkaTopicsCluster("__consumer_offsets", "legacy")
+	config := cluster.config()
+
+	// The unmanaged topics are not known before the first apply, but are pruned by it.
+	state := testKafkaTopicsApply(t, config, nil, map[string]interface{}{
+		"prune_unmanaged": true,
+		"topic":           testKafkaTopicsBlocks("a"),
+	})
+
+	assert.Equal(t, []string{"legacy"}, cluster.sortedCalls("delete"))
+	assert.Equal(t, []string{"a"}, cluster.sortedCalls("create"))
+	assert.Contains(t, cluster.topics, "__consumer_offsets")
+	assert.NotContains(t, cluster.topics, "legacy")
+	assert.Equal(t, "0", state.Attributes["unmanaged_topics.#"])
+}
+
+func TestResourceHerokuxKafkaTopics_PruneUnmanagedEnabled(t *testing.T) {
+	cluster := newTestKafkaTopicsCluster("__consumer_offsets")
+	config := cluster.config()
+
+	state := testKafkaTopicsApply(t, config, nil, map[string]interface{}{
+		"topic": testKafkaTopicsBlocks("a"),
+	})
+
+	// A topic created after the last refresh is not in unmanaged_topics, but is pruned once pruning is enabled.
+	cluster.set(&kafka.TopicRequest{Name: "legacy", Partitions: 8, ReplicationFactor: 3})
+	cluster.reset()
+
+	state = testKafkaTopicsApply(t, config, state, map[string]interface{}{
+		"prune_unmanaged": true,
+		"topic":           testKafkaTopicsBlocks("a"),
+	})
+
+	assert.Equal(t, []string{"legacy"}, cluster.sortedCalls("delete"))
+	assert.Contains(t, cluster.topics, "__consumer_offsets")
+	assert.Equal(t, "0", state.Attributes["unmanaged_topics.#"])
+}
+
+func TestResourceHerokuxKafkaTopics_Delete(t *testing.T) {
+	cluster := newTestKafkaTopicsCluster("__consumer_offsets")
+	config := cluster.config()
+
+	state := testKafkaTopicsApply(t, config, nil, map[string]interface{}{
+		"prune_unmanaged": true,
+		"topic":           testKafkaTopicsBlocks("a", "b"),
+	})
+	cluster.set(&kafka.TopicRequest{Name: "legacy", Partitions: 8, ReplicationFactor: 3})
+	cluster.reset()
+
+	_, diags := resourceHerokuxKafkaTopics().Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, config)
+	assert.Empty(t, diags)
+
+	// Only the declared topics are deleted.
+	assert.Equal(t, []string{"a", "b"}, cluster.sortedCalls("delete"))
+	assert.Contains(t, cluster.topics, "legacy")
+}
+
+func TestResourceHerokuxKafkaTopicsCustomizeDiff(t *testing.T) {
+	config := newTestKafkaTopicsCluster().config()
+	r := resourceHerokuxKafkaTopics()
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"kafka_id": testKafkaID,
+		"topic": []interface{}{
+			map[string]interface{}{"name": "a", "partitions": 8},
+			map[string]interface{}{"name": "a", "partitions": 16},
+		},
+	}), config)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "each topic must only be declared once")
+	}
+
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"kafka_id": testKafkaID,
+		"topic": []interface{}{
+			map[string]interface{}{"name": "a", "partitions": 8, "cleanup_policy": "compact"},
+		},
+	}), config)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), `topic a: retention_time must be "disable" when cleanup_policy is "compact"`)
+	}
+
+	// Changing the partitions of a declared topic would delete and create it again, so it is rejected.
+	cluster := newTestKafkaTopicsCluster()
+	config = cluster.config()
+	state := testKafkaTopicsApply(t, config, nil, map[string]interface{}{
+		"topic": testKafkaTopicsBlocks("a", "b"),
+	})
+	cluster.reset()
+
+	topics := testKafkaTopicsBlocks("a")
+	topics = append(topics, map[string]interface{}{"name": "b", "partitions": 16})
+	_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"kafka_id": testKafkaID,
+		"topic":    topics,
+	}), config)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "topic b: partitions cannot be changed from 8 to 16 after the topic is created")
+	}
+	assert.Empty(t, cluster.calls["delete"])
+}
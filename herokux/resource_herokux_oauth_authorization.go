@@ -142,43 +142,45 @@ func resourceHerokuxOauthAuthorizationImport(ctx context.Context, d *schema.Reso
 
 func constructPlatformAPIClient(d *schema.ResourceData, meta interface{}) (*heroku.Service, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	client := meta.(*Config).PlatformAPI
 
-	if v, ok := d.GetOk("auth_api_key_name"); ok {
-		// Check if the associated env variable is set representing the API key of the user account the new token
-		// will be created in Heroku. If no variable is set, then use the default PlatformAPI client created using
-		// the token sourced from the HEROKU_API_KEY env variable.
-
-		vs := v.(string)
-
-		// Construct the env variable name
-		enVarName := fmt.Sprintf("HEROKUX_%s_API_KEY", strings.ToUpper(vs))
-		log.Printf("[DEBUG] env variable to fetch custom Heroku API key: %v", enVarName)
-		apiKey, isFound := os.LookupEnv(enVarName)
+	client, clientErr := platformAPIClient(meta, d.Get("auth_api_key_name").(string))
+	if clientErr != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  clientErr.Error(),
+			Detail:   "Please define the env variable with an API key authorized to create additional OAuth authorizations.",
+		})
+	}
 
-		// If env variable is not set, error out.
-		if !isFound {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("%s not found in the environment", enVarName),
-				Detail:   fmt.Sprintf("Please define %s env variable with an API key authorized to create additional OAuth authorizations.", enVarName),
-			})
-		}
+	return client, diags
+}
 
-		// Otherwise, initialize a PlatformAPI client with the new API key.
-		client = heroku.NewService(&http.Client{
-			Transport: &heroku.Transport{
-				Username:  "", // Email is not required
-				Password:  strings.TrimSpace(apiKey),
-				UserAgent: UserAgent,
-				Transport: heroku.RoundTripWithRetryBackoff{},
-			},
-		})
-	} else {
+// platformAPIClient returns the PlatformAPI client authenticated with the API key of the HEROKUX_<KEY NAME>_API_KEY
+// env variable, or the default PlatformAPI client if keyName is empty.
+func platformAPIClient(meta interface{}, keyName string) (*heroku.Service, error) {
+	if keyName == "" {
 		log.Printf("[DEBUG] auth_api_key_name not set. This resource will use the default env variable HEROKU_API_KEY for further actions.")
+		return meta.(*Config).PlatformAPI, nil
 	}
 
-	return client, diags
+	// Check if the associated env variable is set representing the API key of the user account the new token
+	// will be created in Heroku.
+	enVarName := fmt.Sprintf("HEROKUX_%s_API_KEY", strings.ToUpper(keyName))
+	log.Printf("[DEBUG] env variable to fetch custom Heroku API key: %v", enVarName)
+	apiKey, isFound := os.LookupEnv(enVarName)
+	if !isFound {
+		return nil, fmt.Errorf("%s not found in the environment", enVarName)
+	}
+
+	// Otherwise, initialize a PlatformAPI client with the new API key.
+	return heroku.NewService(&http.Client{
+		Transport: &heroku.Transport{
+			Username:  "", // Email is not required
+			Password:  strings.TrimSpace(apiKey),
+			UserAgent: UserAgent,
+			Transport: heroku.RoundTripWithRetryBackoff{},
+		},
+	}), nil
 }
 
 func resourceHerokuxOauthAuthorizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -221,7 +223,7 @@ func resourceHerokuxOauthAuthorizationCreate(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Creating new OAuth authorization")
 
-	newAuth, createErr := client.OAuthAuthorizationCreate(context.TODO(), opts)
+	newAuth, createErr := client.OAuthAuthorizationCreate(ctx, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -252,7 +254,7 @@ func resourceHerokuxOauthAuthorizationRead(ctx context.Context, d *schema.Resour
 		hasCustomTTL = true
 	}
 
-	t, getErr := client.OAuthAuthorizationInfo(context.TODO(), d.Id())
+	t, getErr := client.OAuthAuthorizationInfo(ctx, d.Id())
 	if getErr != nil {
 		// Handle when an existing oauth authorization has expired and is no longer available remotely.
 		// In this scenario, remove the resource from state so it can be recreated without a `terraform state rm`.
@@ -339,7 +341,7 @@ func resourceHerokuxOauthAuthorizationUpdate(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Updating OAuth authorization %s", d.Id())
 
-	_, updateErr := client.OAuthAuthorizationUpdate(context.TODO(), d.Id(), opts)
+	_, updateErr := client.OAuthAuthorizationUpdate(ctx, d.Id(), opts)
 	if updateErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -363,7 +365,7 @@ func resourceHerokuxOauthAuthorizationDelete(ctx context.Context, d *schema.Reso
 		return clientDiags
 	}
 
-	_, deleteErr := client.OAuthAuthorizationDelete(context.TODO(), d.Id())
+	_, deleteErr := client.OAuthAuthorizationDelete(ctx, d.Id())
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
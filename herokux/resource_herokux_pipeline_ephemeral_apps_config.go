@@ -2,6 +2,7 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/platform"
@@ -55,7 +56,7 @@ func resourceHerokuxPipelineEphemeralAppsConfig() *schema.Resource {
 	}
 }
 
-func updatePipelineEphemeralAppsPermission(d *schema.ResourceData, meta interface{}) (*platform.Pipeline, error) {
+func updatePipelineEphemeralAppsPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) (*platform.Pipeline, error) {
 	client := meta.(*Config).API
 	opts := &platform.PipelineEphemeralAppsConfigUpdateOpts{}
 
@@ -68,7 +69,7 @@ func updatePipelineEphemeralAppsPermission(d *schema.ResourceData, meta interfac
 
 	log.Printf("[DEBUG] Setting ephemeral apps permissions for pipeline %s", pipelineID)
 
-	p, _, setErr := client.Platform.UpdatePipelineEphemeralAppsConfig(pipelineID, opts)
+	p, _, setErr := client.Platform.UpdatePipelineEphemeralAppsConfig(ctx, pipelineID, opts)
 	if setErr != nil {
 		return nil, setErr
 	}
@@ -81,7 +82,7 @@ func updatePipelineEphemeralAppsPermission(d *schema.ResourceData, meta interfac
 func resourceHerokuxPipelineEphemeralAppsConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	p, setErr := updatePipelineEphemeralAppsPermission(d, meta)
+	p, setErr := updatePipelineEphemeralAppsPermission(ctx, d, meta)
 	if setErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -99,7 +100,7 @@ func resourceHerokuxPipelineEphemeralAppsConfigCreate(ctx context.Context, d *sc
 func resourceHerokuxPipelineEphemeralAppsConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	_, setErr := updatePipelineEphemeralAppsPermission(d, meta)
+	_, setErr := updatePipelineEphemeralAppsPermission(ctx, d, meta)
 	if setErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -116,8 +117,14 @@ func resourceHerokuxPipelineEphemeralAppsConfigRead(ctx context.Context, d *sche
 	var diags diag.Diagnostics
 	client := meta.(*Config).API
 
-	p, _, readErr := client.Platform.GetPipelineEphemeralAppsConfig(d.Id())
+	p, _, readErr := client.Platform.GetPipelineEphemeralAppsConfig(ctx, d.Id())
 	if readErr != nil {
+		if errors.Is(readErr, api.ErrNotFound) {
+			log.Printf("[WARN] Pipeline ephemeral apps config %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("Unable to retrieve pipeline permissions for %s", d.Id()),
@@ -145,7 +152,7 @@ func resourceHerokuxPipelineEphemeralAppsConfigDelete(ctx context.Context, d *sc
 	log.Printf("[DEBUG] Unsetting ephemeral apps permissions for pipeline %s", d.Id())
 
 	// Delete the resource by disabling the permission(s).
-	_, _, deleteErr := client.Platform.UpdatePipelineEphemeralAppsConfig(d.Id(), opts)
+	_, _, deleteErr := client.Platform.UpdatePipelineEphemeralAppsConfig(ctx, d.Id(), opts)
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/kolkrabbi"
 	"github.com/google/go-github/v45/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -95,7 +97,7 @@ func resourceHerokuxPipelineGithubIntegrationCreate(ctx context.Context, d *sche
 		repo := orgRepo[1]
 
 		// Retrieve Github token from the Heroku integration
-		ghIntegrationData, _, accountErr := herokuClient.Kolkrabbi.GetAccountInfo()
+		ghIntegrationData, _, accountErr := herokuClient.Kolkrabbi.GetAccountInfo(ctx)
 		if accountErr != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -130,7 +132,7 @@ func resourceHerokuxPipelineGithubIntegrationCreate(ctx context.Context, d *sche
 
 	log.Printf("[DEBUG] Creating integration with Heroku pipeline %s and Github repository %v", pipelineID, opts.Repository)
 
-	integationData, _, createErr := herokuClient.Kolkrabbi.CreatePipelineGithubIntegration(pipelineID, opts)
+	integationData, _, createErr := herokuClient.Kolkrabbi.CreatePipelineGithubIntegration(ctx, pipelineID, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -153,8 +155,14 @@ func resourceHerokuxPipelineGithubIntegrationRead(ctx context.Context, d *schema
 	var diags diag.Diagnostics
 	client := meta.(*Config).API
 
-	iData, _, readErr := client.Kolkrabbi.GetPipelineGithubIntegration(d.Id())
+	iData, _, readErr := client.Kolkrabbi.GetPipelineGithubIntegration(ctx, d.Id())
 	if readErr != nil {
+		if errors.Is(readErr, api.ErrNotFound) {
+			log.Printf("[WARN] Pipeline Github integration %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("Unable to retrieve Github integration for pipeline %s", d.Id()),
@@ -177,7 +185,7 @@ func resourceHerokuxPipelineGithubIntegrationDelete(ctx context.Context, d *sche
 	var diags diag.Diagnostics
 	client := meta.(*Config).API
 
-	_, deleteErr := client.Kolkrabbi.DeletePipelineGithubIntegration(d.Id())
+	_, deleteErr := client.Kolkrabbi.DeletePipelineGithubIntegration(ctx, d.Id())
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
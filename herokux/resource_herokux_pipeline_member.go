@@ -63,7 +63,7 @@ func resourceHerokuxPipelineMemberImport(ctx context.Context, d *schema.Resource
 	pipelineID := compositeID[0]
 	email := compositeID[1]
 
-	membership, _, readErr := client.Platform.FindPipelineMembersByEmail(pipelineID, email)
+	membership, _, readErr := client.Platform.FindPipelineMembersByEmail(ctx, pipelineID, email)
 	if readErr != nil {
 		return nil, readErr
 	}
@@ -88,7 +88,7 @@ func resourceHerokuxPipelineMemberCreate(ctx context.Context, d *schema.Resource
 
 	log.Printf("[DEBUG] Adding %s to pipeline %s", opts.Email, opts.PipelineID)
 
-	membership, _, addErr := client.Platform.AddPipelineMember(opts)
+	membership, _, addErr := client.Platform.AddPipelineMember(ctx, opts)
 	if addErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -115,7 +115,7 @@ func resourceHerokuxPipelineMemberUpdate(ctx context.Context, d *schema.Resource
 
 		log.Printf("[DEBUG] Updating permissions for membership %s", d.Id())
 
-		_, _, updatErr := client.Platform.UpdatePipelineMemberPermissions(d.Id(), permissions)
+		_, _, updatErr := client.Platform.UpdatePipelineMemberPermissions(ctx, d.Id(), permissions)
 		if updatErr != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -138,7 +138,7 @@ func resourceHerokuxPipelineMemberRead(ctx context.Context, d *schema.ResourceDa
 	email := getEmail(d)
 	pipelineID := getPipelineID(d)
 
-	membership, _, readErr := client.Platform.FindPipelineMembersByEmail(pipelineID, email)
+	membership, _, readErr := client.Platform.FindPipelineMembersByEmail(ctx, pipelineID, email)
 	if readErr != nil {
 		_, notFound := readErr.(platform.PermissionNotFoundError)
 		if notFound {
@@ -169,7 +169,7 @@ func resourceHerokuxPipelineMemberDelete(ctx context.Context, d *schema.Resource
 
 	log.Printf("[DEBUG] Removing membership %s from pipeline", d.Id())
 
-	_, deleteErr := client.Platform.RemovePipelineMember(d.Id())
+	_, deleteErr := client.Platform.RemovePipelineMember(ctx, d.Id())
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	heroku "github.com/davidji99/heroku-go/v5"
 	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/davidji99/terraform-provider-herokux/api/postgres"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -208,7 +208,7 @@ func resourceHerokuxPostgresCreate(ctx context.Context, d *schema.ResourceData,
 
 	// Now proceed to create the database leader.
 	log.Printf("[DEBUG] Creating database leader...")
-	leaderDB, leaderCreateErr := platformAPI.AddOnCreate(context.TODO(), leaderAppID, leaderOpts)
+	leaderDB, leaderCreateErr := platformAPI.AddOnCreate(ctx, leaderAppID, leaderOpts)
 	if leaderCreateErr != nil {
 		return diag.FromErr(leaderCreateErr)
 	}
@@ -217,7 +217,8 @@ func resourceHerokuxPostgresCreate(ctx context.Context, d *schema.ResourceData,
 
 	// Wait for the database leader to be provisioned
 	log.Printf("[INFO] Waiting for database leader ID (%s) to be provisioned", leaderDB.ID)
-	leaderStateConf := &resource.StateChangeConf{
+	leaderWaiter := &wait.Waiter[*heroku.AddOn, string]{
+		Description:  fmt.Sprintf("database leader (%s) to be provisioned", leaderDB.ID),
 		Pending:      []string{"provisioning"},
 		Target:       []string{"provisioned"},
 		Refresh:      AddOnStateRefreshFunc(platformAPI, leaderDB.ID),
@@ -225,7 +226,7 @@ func resourceHerokuxPostgresCreate(ctx context.Context, d *schema.ResourceData,
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := leaderStateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := leaderWaiter.Wait(ctx); err != nil {
 		return diag.Errorf("Error waiting for database leader (%s) to be provisioned: %s", leaderDB.ID, err)
 	}
 
@@ -235,14 +236,16 @@ func resourceHerokuxPostgresCreate(ctx context.Context, d *schema.ResourceData,
 	if createFollower {
 		// First, make sure leader database is ready to receive a follower
 		log.Printf("[INFO] Waiting for database leader ID (%s) to be able to receive followers", leaderDB.ID)
-		followStateConf := &resource.StateChangeConf{
-			Pending: []string{"Unavailable", "Temporarily Unavailable"},
-			Target:  []string{"Available"},
-			Refresh: FollowStateRefreshFunc(api, leaderDB.ID),
-			Timeout: 20 * time.Minute,
+		followWaiter := &wait.Waiter[*postgres.Database, string]{
+			Description:  fmt.Sprintf("database leader (%s) to be ready for followers", leaderDB.ID),
+			Pending:      []string{"Unavailable", "Temporarily Unavailable"},
+			Target:       []string{"Available"},
+			Refresh:      FollowStateRefreshFunc(api, leaderDB.ID),
+			Timeout:      20 * time.Minute,
+			PollInterval: StateRefreshPollInterval,
 		}
 
-		if _, err := followStateConf.WaitForStateContext(ctx); err != nil {
+		if _, err := followWaiter.Wait(ctx); err != nil {
 			return diag.Errorf("Error waiting for database leader (%s) to be ready for followers: %s", leaderDB.ID, err)
 		}
 
@@ -253,7 +256,7 @@ func resourceHerokuxPostgresCreate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		log.Printf("[DEBUG] Creating database follower...")
-		followerDB, followerCreateErr := platformAPI.AddOnCreate(context.TODO(), followerAppID, followerOpts)
+		followerDB, followerCreateErr := platformAPI.AddOnCreate(ctx, followerAppID, followerOpts)
 		if followerCreateErr != nil {
 			return diag.FromErr(followerCreateErr)
 		}
@@ -262,7 +265,8 @@ func resourceHerokuxPostgresCreate(ctx context.Context, d *schema.ResourceData,
 
 		// Wait for the database leader to be provisioned
 		log.Printf("[INFO] Waiting for database follower ID (%s) to be provisioned", followerDB.ID)
-		followerStateConf := &resource.StateChangeConf{
+		followerWaiter := &wait.Waiter[*heroku.AddOn, string]{
+			Description:  fmt.Sprintf("database follower (%s) to be provisioned", followerDB.ID),
 			Pending:      []string{"provisioning"},
 			Target:       []string{"provisioned"},
 			Refresh:      AddOnStateRefreshFunc(platformAPI, followerDB.ID),
@@ -270,7 +274,7 @@ func resourceHerokuxPostgresCreate(ctx context.Context, d *schema.ResourceData,
 			PollInterval: StateRefreshPollInterval,
 		}
 
-		if _, err := followerStateConf.WaitForStateContext(ctx); err != nil {
+		if _, err := followerWaiter.Wait(ctx); err != nil {
 			return diag.Errorf("Error waiting for database follower (%s) to be provisioned: %s", followerDB.ID, err)
 		}
 
@@ -302,7 +306,7 @@ func resourceHerokuxPostgresRead(ctx context.Context, d *schema.ResourceData, me
 
 	// Set leader database info in state
 	leaderDatabaseID = strings.Split(resourceIDList[0], "|")[1]
-	leaderDB, getLErr := platformAPI.AddOnInfo(context.TODO(), leaderDatabaseID)
+	leaderDB, getLErr := platformAPI.AddOnInfo(ctx, leaderDatabaseID)
 	if getLErr != nil {
 		return diag.FromErr(getLErr)
 	}
@@ -319,7 +323,7 @@ func resourceHerokuxPostgresRead(ctx context.Context, d *schema.ResourceData, me
 
 	if len(resourceIDList) >= 2 {
 		followerDatabaseID = strings.Split(resourceIDList[1], "|")[1]
-		followerDB, getFErr := platformAPI.AddOnInfo(context.TODO(), followerDatabaseID)
+		followerDB, getFErr := platformAPI.AddOnInfo(ctx, followerDatabaseID)
 		if getFErr != nil {
 			return diag.FromErr(getFErr)
 		}
@@ -369,7 +373,7 @@ func resourceHerokuxPostgresDelete(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[INFO] Deleting database ID (%s) on app ID (%s)", dbID, appID)
 
 		// Destroy the app
-		_, deleteErr := platformAPI.AddOnDelete(context.TODO(), appID, dbID)
+		_, deleteErr := platformAPI.AddOnDelete(ctx, appID, dbID)
 		if deleteErr != nil {
 			return diag.FromErr(deleteErr)
 		}
@@ -407,25 +411,23 @@ func validateCustomAddonName(v interface{}, k string) (ws []string, errors []err
 	return ws, errors
 }
 
-// AddOnStateRefreshFunc returns a resource.StateRefreshFunc that is used to
+// AddOnStateRefreshFunc returns a wait.RefreshFunc that is used to
 // watch an AddOn.
-func AddOnStateRefreshFunc(platformAPI *heroku.Service, addOnID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		addon, getErr := platformAPI.AddOnInfo(context.TODO(), addOnID)
+func AddOnStateRefreshFunc(platformAPI *heroku.Service, addOnID string) wait.RefreshFunc[*heroku.AddOn, string] {
+	return func(ctx context.Context) (*heroku.AddOn, string, error) {
+		addon, getErr := platformAPI.AddOnInfo(ctx, addOnID)
 		if getErr != nil {
 			return nil, "", getErr
 		}
 
-		// The type conversion here can be dropped when the vendored version of
-		// heroku-go is updated.
 		return addon, addon.State, nil
 	}
 }
 
 // FollowStateRefreshFunc checks if a DB is ready to be followed
-func FollowStateRefreshFunc(api *api.Client, dbID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		db, _, getErr := api.Postgres.GetDB(dbID)
+func FollowStateRefreshFunc(api *api.Client, dbID string) wait.RefreshFunc[*postgres.Database, string] {
+	return func(ctx context.Context) (*postgres.Database, string, error) {
+		db, _, getErr := api.Postgres.GetDB(ctx, dbID)
 		if getErr != nil {
 			return nil, "", getErr
 		}
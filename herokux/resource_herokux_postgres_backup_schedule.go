@@ -75,7 +75,7 @@ func resourceHerokuxPostgresBackupScheduleImport(ctx context.Context, d *schema.
 	client := meta.(*Config).API
 	postgresID := d.Id()
 
-	schedule, _, listErr := client.Postgres.ListBackupSchedules(postgresID)
+	schedule, _, listErr := client.Postgres.ListBackupSchedules(ctx, postgresID)
 	if listErr != nil {
 		return nil, listErr
 	}
@@ -89,7 +89,7 @@ func resourceHerokuxPostgresBackupScheduleImport(ctx context.Context, d *schema.
 
 	d.SetId(schedule[0].GetID())
 
-	retrieveErr := retrieveSetBackupSchedule(d, meta, postgresID, schedule[0].GetID())
+	retrieveErr := retrieveSetBackupSchedule(ctx, d, meta, postgresID, schedule[0].GetID())
 	if retrieveErr.HasError() {
 		return nil, fmt.Errorf("unable to import backup schedule: %v", retrieveErr[0].Detail)
 	}
@@ -118,7 +118,7 @@ func resourceHerokuxPostgresBackupScheduleCreate(ctx context.Context, d *schema.
 
 	log.Printf("[DEBUG] Creating postgres backup schedule on %s", postgresID)
 
-	bs, _, createErr := client.Postgres.CreateBackupSchedule(postgresID, opts)
+	bs, _, createErr := client.Postgres.CreateBackupSchedule(ctx, postgresID, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -138,14 +138,14 @@ func resourceHerokuxPostgresBackupScheduleCreate(ctx context.Context, d *schema.
 func resourceHerokuxPostgresBackupScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	postgresID := getPostgresID(d)
 
-	return retrieveSetBackupSchedule(d, meta, postgresID, d.Id())
+	return retrieveSetBackupSchedule(ctx, d, meta, postgresID, d.Id())
 }
 
-func retrieveSetBackupSchedule(d *schema.ResourceData, meta interface{}, postgresID, scheduleID string) diag.Diagnostics {
+func retrieveSetBackupSchedule(ctx context.Context, d *schema.ResourceData, meta interface{}, postgresID, scheduleID string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := meta.(*Config).API
 
-	schedules, _, readErr := client.Postgres.ListBackupSchedules(postgresID)
+	schedules, _, readErr := client.Postgres.ListBackupSchedules(ctx, postgresID)
 	if readErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -204,7 +204,7 @@ func resourceHerokuxPostgresBackupScheduleDelete(ctx context.Context, d *schema.
 	client := meta.(*Config).API
 	postgresID := getPostgresID(d)
 
-	_, deleteErr := client.Postgres.DeleteBackupSchedule(postgresID, d.Id())
+	_, deleteErr := client.Postgres.DeleteBackupSchedule(ctx, postgresID, d.Id())
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
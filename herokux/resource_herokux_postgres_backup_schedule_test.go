@@ -3,7 +3,6 @@ package herokux
 import (
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/helper/test"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
 )
@@ -113,7 +112,7 @@ func TestAccE2EHerokuxPostgresBackupSchedule(t *testing.T) {
 	testAccConfig.GetRunE2ETestsOrSkip(t)
 
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	plan := "heroku-postgresql:premium-0"
 
 	resource.Test(t, resource.TestCase{
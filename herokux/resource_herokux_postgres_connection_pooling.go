@@ -8,9 +8,9 @@ import (
 	"time"
 
 	heroku "github.com/davidji99/heroku-go/v5"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/davidji99/terraform-provider-herokux/api/postgres"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -69,7 +69,7 @@ func validateConnectionPoolingName(v interface{}, k string) (ws []string, errors
 func resourceHerokuxPostgresConnectionPoolingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).PlatformAPI
 
-	attachment, listErr := client.AddOnAttachmentInfo(context.TODO(), d.Id())
+	attachment, listErr := client.AddOnAttachmentInfo(ctx, d.Id())
 	if listErr != nil {
 		return nil, listErr
 	}
@@ -97,7 +97,7 @@ func resourceHerokuxPostgresConnectionPoolingCreate(ctx context.Context, d *sche
 	opts.Name = getName(d)
 
 	// Check if connection pooling is available. Error out if not.
-	db, _, dbInfoErr := api.Postgres.GetDB(postgresID)
+	db, _, dbInfoErr := api.Postgres.GetDB(ctx, postgresID)
 	if dbInfoErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -118,7 +118,7 @@ func resourceHerokuxPostgresConnectionPoolingCreate(ctx context.Context, d *sche
 
 	log.Printf("[DEBUG] Enabling postgres connection pooling on postgres %s", postgresID)
 
-	attachment, _, createErr := api.Postgres.CreateConnectionPooling(postgresID, opts)
+	attachment, _, createErr := api.Postgres.CreateConnectionPooling(ctx, postgresID, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -130,7 +130,7 @@ func resourceHerokuxPostgresConnectionPoolingCreate(ctx context.Context, d *sche
 
 	log.Printf("[DEBUG] Waiting for app %s to be restarted after setting config var", appID)
 
-	releases, listErr := platformAPI.ReleaseList(context.TODO(), appID,
+	releases, listErr := platformAPI.ReleaseList(ctx, appID,
 		&heroku.ListRange{Descending: true, Field: "version", Max: 1},
 	)
 	if listErr != nil {
@@ -151,7 +151,8 @@ func resourceHerokuxPostgresConnectionPoolingCreate(ctx context.Context, d *sche
 		return diags
 	}
 
-	stateConf := &resource.StateChangeConf{
+	waiter := &wait.Waiter[*heroku.Release, string]{
+		Description:  fmt.Sprintf("app %s to be restarted", appID),
 		Pending:      []string{"pending"},
 		Target:       []string{"succeeded"},
 		Refresh:      releaseStateRefreshFunc(platformAPI, appID, releases[0].ID),
@@ -159,7 +160,7 @@ func resourceHerokuxPostgresConnectionPoolingCreate(ctx context.Context, d *sche
 		PollInterval: 5 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for app %s to be restarted after enabling connection pooling on database %s: %s", appID, postgresID, err.Error())
 	}
 
@@ -174,7 +175,7 @@ func resourceHerokuxPostgresConnectionPoolingRead(ctx context.Context, d *schema
 	var diags diag.Diagnostics
 	client := meta.(*Config).PlatformAPI
 
-	attachment, getErr := client.AddOnAttachmentInfo(context.TODO(), d.Id())
+	attachment, getErr := client.AddOnAttachmentInfo(ctx, d.Id())
 	if getErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -199,7 +200,7 @@ func resourceHerokuxPostgresConnectionPoolingDelete(ctx context.Context, d *sche
 
 	log.Printf("[DEBUG] Deleting postgres connection pooling %s", d.Id())
 
-	_, deleteErr := client.AddOnAttachmentDelete(context.TODO(), d.Id())
+	_, deleteErr := client.AddOnAttachmentDelete(ctx, d.Id())
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -216,16 +217,14 @@ func resourceHerokuxPostgresConnectionPoolingDelete(ctx context.Context, d *sche
 	return nil
 }
 
-func releaseStateRefreshFunc(client *heroku.Service, appID, releaseID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		release, err := client.ReleaseInfo(context.TODO(), appID, releaseID)
+func releaseStateRefreshFunc(client *heroku.Service, appID, releaseID string) wait.RefreshFunc[*heroku.Release, string] {
+	return func(ctx context.Context) (*heroku.Release, string, error) {
+		release, err := client.ReleaseInfo(ctx, appID, releaseID)
 
 		if err != nil {
 			return nil, "", err
 		}
 
-		// The type conversion here can be dropped when the vendored version of
-		// heroku-go is updated.
-		return (*heroku.Release)(release), release.Status, nil
+		return release, release.Status, nil
 	}
 }
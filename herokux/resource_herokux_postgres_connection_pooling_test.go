@@ -3,7 +3,6 @@ package herokux
 import (
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/helper/test"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"regexp"
 	"strings"
@@ -11,8 +10,8 @@ import (
 )
 
 func TestAccHerokuxPostgresConnectionPooling_Basic(t *testing.T) {
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
-	name := strings.ToUpper(fmt.Sprintf("tftest_%s", acctest.RandString(10)))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
+	name := strings.ToUpper(fmt.Sprintf("tftest_%s", testAccConfig.RandString(t, 10)))
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
 
 	resource.Test(t, resource.TestCase{
@@ -38,7 +37,7 @@ func TestAccHerokuxPostgresConnectionPooling_Basic(t *testing.T) {
 }
 
 func TestAccHerokuxPostgresConnectionPooling_NoName(t *testing.T) {
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
 
 	resource.Test(t, resource.TestCase{
@@ -64,7 +63,7 @@ func TestAccHerokuxPostgresConnectionPooling_NoName(t *testing.T) {
 }
 
 func TestAccHerokuxPostgresConnectionPooling_Invalid(t *testing.T) {
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	name := "0test-name"
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
 
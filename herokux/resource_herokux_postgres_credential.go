@@ -2,6 +2,7 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,10 +10,10 @@ import (
 	"time"
 
 	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/davidji99/terraform-provider-herokux/api/postgres"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -30,7 +31,7 @@ func resourceHerokuxPostgresCredential() *schema.Resource {
 			StateContext: resourceHerokuxPostgresCredentialImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(60*time.Minute, 60*time.Minute, 20*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			"postgres_id": {
@@ -150,14 +151,14 @@ func resourceHerokuxPostgresCredentialCreate(ctx context.Context, d *schema.Reso
 		log.Printf("[DEBUG] credential postgres_id is : %v", postgresID)
 	}
 
-	checkErr := checkDBForkFollowStatus(client, config, postgresID)
+	checkErr := checkDBForkFollowStatus(ctx, client, config, postgresID)
 	if checkErr != nil {
 		return checkErr
 	}
 
 	log.Printf("[DEBUG] Creating postgres credential %s on postgres %s", name, postgresID)
 
-	_, _, createErr := client.Postgres.CreateCredential(postgresID, name)
+	_, _, createErr := client.Postgres.CreateCredential(ctx, postgresID, name)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -169,16 +170,17 @@ func resourceHerokuxPostgresCredentialCreate(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Waiting for postgres credential %s on postgres %s to be active", name, postgresID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{postgres.CredentialStates.WAITFORPROVISIONING.ToString(),
-			postgres.CredentialStates.PROVISIONING.ToString()},
-		Target:       []string{postgres.CredentialStates.ACTIVE.ToString()},
-		Refresh:      postgresCredentialCreationStateRefreshFunc(client, postgresID, name),
-		Timeout:      time.Duration(config.PostgresCredentialCreateVerifyTimeout) * time.Minute,
+	waiter := &wait.Waiter[*postgres.Credential, postgres.CredentialState]{
+		Description: fmt.Sprintf("postgres credential %s on postgres %s to be active", name, postgresID),
+		Pending: []postgres.CredentialState{postgres.CredentialStates.WAITFORPROVISIONING,
+			postgres.CredentialStates.PROVISIONING},
+		Target:       []postgres.CredentialState{postgres.CredentialStates.ACTIVE},
+		Refresh:      postgresCredentialStateRefreshFunc(client, postgresID, name),
+		Timeout:      verifyTimeout(config.PostgresCredentialCreateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for postgres credential %s to be active on %s: %s", name, postgresID, err.Error())
 	}
 
@@ -201,8 +203,14 @@ func resourceHerokuxPostgresCredentialRead(ctx context.Context, d *schema.Resour
 	postgresID := result[0]
 	credName := result[1]
 
-	cred, _, getErr := client.Postgres.GetCredential(postgresID, credName)
+	cred, _, getErr := client.Postgres.GetCredential(ctx, postgresID, credName)
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Postgres credential %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return diag.FromErr(getErr)
 	}
 
@@ -244,22 +252,23 @@ func resourceHerokuxPostgresCredentialDelete(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Deleting postgres credential %s", credName)
 
-	_, _, deleteErr := client.Postgres.DeleteCredential(postgresID, credName)
+	_, _, deleteErr := client.Postgres.DeleteCredential(ctx, postgresID, credName)
 	if deleteErr != nil {
 		return diag.FromErr(deleteErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for postgres credential %s to be deleted", credName)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{postgres.CredentialStates.REVOKING.ToString()},
-		Target:       []string{postgres.CredentialStates.DELETED.ToString()},
-		Refresh:      postgresCredentialDeletionStateRefreshFunc(client, postgresID, credName),
-		Timeout:      time.Duration(config.PostgresCredentialDeleteVerifyTimeout) * time.Minute,
+	waiter := &wait.Waiter[*postgres.Credential, postgres.CredentialState]{
+		Description:  fmt.Sprintf("postgres credential %s on postgres %s to be deleted", credName, postgresID),
+		Pending:      []postgres.CredentialState{postgres.CredentialStates.REVOKING},
+		Target:       []postgres.CredentialState{postgres.CredentialStates.DELETED},
+		Refresh:      postgresCredentialStateRefreshFunc(client, postgresID, credName),
+		Timeout:      verifyTimeout(config.PostgresCredentialDeleteVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for postgres credential %s to be deleted on %s: %s", credName, postgresID, err.Error())
 	}
 
@@ -268,49 +277,30 @@ func resourceHerokuxPostgresCredentialDelete(ctx context.Context, d *schema.Reso
 	return nil
 }
 
-func postgresCredentialCreationStateRefreshFunc(client *api.Client, postgresID, credName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		cred, _, getErr := client.Postgres.GetCredential(postgresID, credName)
-		if getErr != nil {
-			return nil, postgres.CredentialStates.UNKNOWN.ToString(), getErr
-		}
-
-		if cred.State == postgres.CredentialStates.WAITFORPROVISIONING {
-			log.Printf("[DEBUG] postgres credential %s not yet active", cred.GetName())
-			return cred, cred.State.ToString(), nil
-		}
-
-		return cred, cred.State.ToString(), nil
-	}
-}
-
-func postgresCredentialDeletionStateRefreshFunc(client *api.Client, postgresID, credName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		cred, response, getErr := client.Postgres.GetCredential(postgresID, credName)
+// postgresCredentialStateRefreshFunc returns the state of a postgres credential. A credential that is not found
+// has been deleted.
+func postgresCredentialStateRefreshFunc(client *api.Client, postgresID, credName string) wait.RefreshFunc[*postgres.Credential, postgres.CredentialState] {
+	return func(ctx context.Context) (*postgres.Credential, postgres.CredentialState, error) {
+		cred, _, getErr := client.Postgres.GetCredential(ctx, postgresID, credName)
 		if getErr != nil {
-			if response.StatusCode == 404 {
-				return postgres.Credential{}, postgres.CredentialStates.DELETED.ToString(), nil
+			if errors.Is(getErr, api.ErrNotFound) {
+				return nil, postgres.CredentialStates.DELETED, nil
 			}
-			return nil, postgres.CredentialStates.UNKNOWN.ToString(), getErr
-		}
-
-		if cred.State == postgres.CredentialStates.REVOKING {
-			log.Printf("[DEBUG] postgres credential %s not yet deleted", cred.GetName())
-			return cred, cred.State.ToString(), nil
+			return nil, postgres.CredentialStates.UNKNOWN, getErr
 		}
 
-		return cred, postgres.CredentialStates.UNKNOWN.ToString(), fmt.Errorf("credential not properly deleted")
+		return cred, cred.State, nil
 	}
 }
 
 // Check the state of the postgres DB to make sure it is in a state to accept credential creation requests.
 // BUT, only do this verification if the postgres plans type is either premium-#, private-#, or shield-#.
-func checkDBForkFollowStatus(client *api.Client, config *Config, postgresID string) diag.Diagnostics {
+func checkDBForkFollowStatus(ctx context.Context, client *api.Client, config *Config, postgresID string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	log.Printf("[DEBUG] Checking if Postgres %s is available for credential creation", postgresID)
 
-	db, _, getErr := client.Postgres.GetDB(postgresID)
+	db, _, getErr := client.Postgres.GetDB(ctx, postgresID)
 	if getErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -345,22 +335,22 @@ func checkDBForkFollowStatus(client *api.Client, config *Config, postgresID stri
 		postgresID, shouldVerifyDBCredAvail)
 
 	if shouldVerifyDBCredAvail {
-		forkFollowStatusChecker := func() (interface{}, string, error) {
-			db, _, getErr := client.Postgres.GetDB(postgresID)
+		forkFollowStatusChecker := func(ctx context.Context) (*postgres.Database, postgres.DatabaseInfoStatus, error) {
+			db, _, getErr := client.Postgres.GetDB(ctx, postgresID)
 			if getErr != nil {
-				return nil, "Unknown", getErr
+				return nil, "", getErr
 			}
 
 			forkFollowInfo, forkFollowInfoErr := db.RetrieveSpecificInfo(postgres.DatabaseInfoNames.FORKFOLLOW.ToString())
 			if forkFollowInfoErr != nil {
-				return nil, "Unknown", fmt.Errorf("can't get Fork/Follow info for Postgres %s to determine if it's available for credential creation",
+				return nil, "", fmt.Errorf("can't get Fork/Follow info for Postgres %s to determine if it's available for credential creation",
 					postgresID)
 			}
 			forkFollowStatus := forkFollowInfo.Values[0].(string)
 
 			haStatusInfo, haStatusInfoErr := db.RetrieveSpecificInfo(postgres.DatabaseInfoNames.HASTATUS.ToString())
 			if haStatusInfoErr != nil {
-				return nil, "Unknown", fmt.Errorf("can't get Fork/Follow info for Postgres %s to determine if it's available for credential creation",
+				return nil, "", fmt.Errorf("can't get Fork/Follow info for Postgres %s to determine if it's available for credential creation",
 					postgresID)
 			}
 			haStatus := haStatusInfo.Values[0].(string)
@@ -368,24 +358,25 @@ func checkDBForkFollowStatus(client *api.Client, config *Config, postgresID stri
 			if forkFollowStatus == postgres.DatabaseInfoStatuses.AVAILABLE.ToString() && haStatus == postgres.DatabaseInfoStatuses.AVAILABLE.ToString() {
 				log.Printf("[DEBUG] Postgres %s can now create credentials as Fork/Follow & HA statuses are now '%s' and '%s'",
 					postgresID, forkFollowStatus, haStatus)
-				return db, postgres.DatabaseInfoStatuses.AVAILABLE.ToString(), nil
+				return db, postgres.DatabaseInfoStatuses.AVAILABLE, nil
 			}
 
 			log.Printf("[DEBUG] Postgres %s Fork/Follow status is still '%s'", postgresID, forkFollowStatus)
 			log.Printf("[DEBUG] Postgres %s HA status is still '%s'", postgresID, haStatus)
 
-			return db, postgres.DatabaseInfoStatuses.TEMP_UNAVAILABLE.ToString(), nil
+			return db, postgres.DatabaseInfoStatuses.TEMP_UNAVAILABLE, nil
 		}
 
-		stateConf := &resource.StateChangeConf{
-			Pending:      []string{postgres.DatabaseInfoStatuses.TEMP_UNAVAILABLE.ToString()},
-			Target:       []string{postgres.DatabaseInfoStatuses.AVAILABLE.ToString()},
+		waiter := &wait.Waiter[*postgres.Database, postgres.DatabaseInfoStatus]{
+			Description:  fmt.Sprintf("Postgres %s to be available for credential creation", postgresID),
+			Pending:      []postgres.DatabaseInfoStatus{postgres.DatabaseInfoStatuses.TEMP_UNAVAILABLE},
+			Target:       []postgres.DatabaseInfoStatus{postgres.DatabaseInfoStatuses.AVAILABLE},
 			Refresh:      forkFollowStatusChecker,
-			Timeout:      time.Duration(config.PostgresCredentialPreCreateVerifyTimeout) * time.Minute,
+			Timeout:      verifyTimeout(config.PostgresCredentialPreCreateVerifyTimeout),
 			PollInterval: StateRefreshPollInterval,
 		}
 
-		if _, err := stateConf.WaitForStateContext(context.TODO()); err != nil {
+		if _, err := waiter.Wait(ctx); err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  fmt.Sprintf("unable to create credential on postgres %s", postgresID),
@@ -3,14 +3,13 @@ package herokux
 import (
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/helper/test"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
 )
 
 func TestAccHerokuxPostgresCredential_Basic(t *testing.T) {
 	postgresID := testAccConfig.GetAddonIDorSkip(t)
-	name := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	name := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -45,9 +44,9 @@ func TestAccE2EHerokuxPostgresCredential_PremiumPG(t *testing.T) {
 	testAccConfig.GetRunE2ETestsOrSkip(t)
 
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	plan := "heroku-postgresql:premium-0"
-	credName := fmt.Sprintf("pgcredtest-%s", acctest.RandString(10))
+	credName := fmt.Sprintf("pgcredtest-%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/postgres"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -112,7 +114,7 @@ func resourceHerokuxPostgresDataLinkCreate(ctx context.Context, d *schema.Resour
 
 	log.Printf("[DEBUG] Creating Data Link between %s & %s", localDB, opts.Remote)
 
-	link, _, createErr := client.Postgres.CreateDataLink(localDB, opts)
+	link, _, createErr := client.Postgres.CreateDataLink(ctx, localDB, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -148,9 +150,9 @@ func resourceHerokuxPostgresDataLinkRead(ctx context.Context, d *schema.Resource
 	localDbID := result[0]
 	linkName := result[1]
 
-	link, response, getErr := client.Postgres.FindDataLinkByName(localDbID, linkName)
+	link, _, getErr := client.Postgres.FindDataLinkByName(ctx, localDbID, linkName)
 	if getErr != nil {
-		if response.StatusCode == 404 {
+		if errors.Is(getErr, api.ErrNotFound) {
 			log.Printf("[DEBUG] Data Link %s on %s not found. Removing from state", linkName, localDbID)
 			d.SetId("")
 
@@ -193,7 +195,7 @@ func resourceHerokuxPostgresDataLinkDelete(ctx context.Context, d *schema.Resour
 
 	log.Printf("[DEBUG] Deleting Data Link %s on %s", linkName, localDbID)
 
-	_, deleteErr := client.Postgres.DeleteDataLink(localDbID, linkName)
+	_, deleteErr := client.Postgres.DeleteDataLink(ctx, localDbID, linkName)
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
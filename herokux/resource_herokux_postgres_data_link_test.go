@@ -2,7 +2,6 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"strings"
 	"testing"
@@ -11,7 +10,7 @@ import (
 func TestAccHerokuxPostgresDataLink_Basic(t *testing.T) {
 	localID := testAccConfig.GetAddonIDorSkip(t)
 	remoteName := testAccConfig.GetDBNameorSkip(t)
-	name := fmt.Sprintf("tftest_%s", acctest.RandString(10))
+	name := fmt.Sprintf("tftest_%s", testAccConfig.RandString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
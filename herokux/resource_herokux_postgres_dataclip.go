@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/data"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -87,7 +89,7 @@ func resourceHerokuxPostgresDataclip() *schema.Resource {
 func resourceHerokuxPostgresDataclipImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).API
 
-	dataclip, _, getErr := client.Data.GetPostgresDataclip(d.Id())
+	dataclip, _, getErr := client.Data.GetPostgresDataclip(ctx, d.Id())
 	if getErr != nil {
 		return nil, getErr
 	}
@@ -121,7 +123,7 @@ func resourceHerokuxPostgresDataclipCreate(ctx context.Context, d *schema.Resour
 
 	log.Printf("[DEBUG] Creating postgres dataclip %s", opts.Title)
 
-	dataclip, _, createErr := client.Data.CreatePostgresDataclip(opts)
+	dataclip, _, createErr := client.Data.CreatePostgresDataclip(ctx, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -142,7 +144,7 @@ func resourceHerokuxPostgresDataclipCreate(ctx context.Context, d *schema.Resour
 	if sharingToggle {
 		log.Printf("[DEBUG] Enabling sharing on postgres dataclip %s", d.Id())
 
-		_, _, enableErr := client.Data.TogglePostgresDataclipSharing(d.Get("slug").(string), true)
+		_, _, enableErr := client.Data.TogglePostgresDataclipSharing(ctx, d.Get("slug").(string), true)
 		if enableErr != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -162,8 +164,14 @@ func resourceHerokuxPostgresDataclipRead(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	client := meta.(*Config).API
 
-	dataclip, _, getErr := client.Data.GetPostgresDataclip(d.Get("slug").(string))
+	dataclip, _, getErr := client.Data.GetPostgresDataclip(ctx, d.Get("slug").(string))
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Postgres dataclip %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("unable to get dataclip %s", d.Id()),
@@ -188,7 +196,7 @@ func resourceHerokuxPostgresDataclipUpdate(ctx context.Context, d *schema.Resour
 
 	log.Printf("[DEBUG] Updating postgres dataclip %s", d.Id())
 
-	_, _, updateErr := client.Data.UpdatePostgresDataclip(opts)
+	_, _, updateErr := client.Data.UpdatePostgresDataclip(ctx, opts)
 	if updateErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -203,7 +211,7 @@ func resourceHerokuxPostgresDataclipUpdate(ctx context.Context, d *schema.Resour
 	if d.HasChange("enable_shareable_links") {
 		log.Printf("[DEBUG] Updating sharing on postgres dataclip %s", d.Id())
 
-		_, _, toggleSharingErr := client.Data.TogglePostgresDataclipSharing(d.Get("slug").(string),
+		_, _, toggleSharingErr := client.Data.TogglePostgresDataclipSharing(ctx, d.Get("slug").(string),
 			d.Get("enable_shareable_links").(bool))
 		if toggleSharingErr != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -226,7 +234,7 @@ func resourceHerokuxPostgresDataclipDelete(ctx context.Context, d *schema.Resour
 
 	log.Printf("[DEBUG] Deleting postgres dataclip %s", d.Id())
 
-	_, _, deleteErr := client.Data.DeletePostgresDataclip(d.Id())
+	_, _, deleteErr := client.Data.DeletePostgresDataclip(ctx, d.Id())
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
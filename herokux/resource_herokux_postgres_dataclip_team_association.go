@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/data"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -64,7 +66,7 @@ func resourceHerokuxPostgresDataclipTeamAssociationImport(ctx context.Context, d
 	slug := result[0]
 	teamName := result[1]
 
-	dataclip, _, getErr := client.Data.GetPostgresDataclip(slug)
+	dataclip, _, getErr := client.Data.GetPostgresDataclip(ctx, slug)
 	if getErr != nil {
 		return nil, getErr
 	}
@@ -100,7 +102,7 @@ func resourceHerokuxPostgresDataclipTeamAssociationCreate(ctx context.Context, d
 
 	log.Printf("[DEBUG] Sharing postgres dataclip %s with team %s", dataclipID, teamID)
 
-	teamShare, _, shareErr := client.Data.SharePostgresDataclipWithTeam(dataclipID, teamID)
+	teamShare, _, shareErr := client.Data.SharePostgresDataclipWithTeam(ctx, dataclipID, teamID)
 	if shareErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -125,8 +127,14 @@ func resourceHerokuxPostgresDataclipTeamAssociationRead(ctx context.Context, d *
 
 	// While the team share has its own UUID, there's no known GraphQL query to retrieve just the team share resource.
 	// So, we'll have to get it via the dataclip query.
-	dataclip, _, getErr := client.Data.GetPostgresDataclip(dataclipSlug)
+	dataclip, _, getErr := client.Data.GetPostgresDataclip(ctx, dataclipSlug)
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Postgres dataclip team association %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("unable to retrieve postgres dataclip %s", dataclipSlug),
@@ -169,7 +177,7 @@ func resourceHerokuxPostgresDataclipTeamAssociationDelete(ctx context.Context, d
 
 	log.Printf("[DEBUG] Removing team %s from postgres dataclip %s", teamName, slug)
 
-	_, _, unshareErr := client.Data.UnsharePostgresDataclipWithTeam(d.Get("dataclip_id").(string), d.Id())
+	_, _, unshareErr := client.Data.UnsharePostgresDataclipWithTeam(ctx, d.Get("dataclip_id").(string), d.Id())
 	if unshareErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
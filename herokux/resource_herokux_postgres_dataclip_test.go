@@ -2,14 +2,13 @@ package herokux
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
 )
 
 func TestAccHerokuxPostgresDataclip_Basic(t *testing.T) {
 	attachmentID := testAccConfig.GetAttachmentIDorSkip(t)
-	title := fmt.Sprintf("tftest_%s", acctest.RandString(10))
+	title := fmt.Sprintf("tftest_%s", testAccConfig.RandString(t, 10))
 	sql := "select * from pg_catalog"
 
 	resource.Test(t, resource.TestCase{
@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/data"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -63,7 +65,7 @@ func resourceHerokuxPostgresDataclipUserAssociationImport(ctx context.Context, d
 	slug := result[0]
 	email := result[1]
 
-	dataclip, _, getErr := client.Data.GetPostgresDataclip(slug)
+	dataclip, _, getErr := client.Data.GetPostgresDataclip(ctx, slug)
 	if getErr != nil {
 		return nil, getErr
 	}
@@ -99,7 +101,7 @@ func resourceHerokuxPostgresDataclipUserAssociationCreate(ctx context.Context, d
 
 	log.Printf("[DEBUG] Sharing postgres dataclip %s with %s", dataclipID, email)
 
-	userShare, _, shareErr := client.Data.SharePostgresDataclipWithUser(dataclipID, email)
+	userShare, _, shareErr := client.Data.SharePostgresDataclipWithUser(ctx, dataclipID, email)
 	if shareErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -124,8 +126,14 @@ func resourceHerokuxPostgresDataclipUserAssociationRead(ctx context.Context, d *
 
 	// While the user share has its own UUID, there's no known GraphQL query to retrieve just the user share resource.
 	// So, we'll have to get it via the dataclip query.
-	dataclip, _, getErr := client.Data.GetPostgresDataclip(dataclipSlug)
+	dataclip, _, getErr := client.Data.GetPostgresDataclip(ctx, dataclipSlug)
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Postgres dataclip user association %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("unable to retrieve postgres dataclip %s", dataclipSlug),
@@ -167,7 +175,7 @@ func resourceHerokuxPostgresDataclipUserAssociationDelete(ctx context.Context, d
 	log.Printf("[DEBUG] Removing user %s from postgres dataclip %s", d.Get("email").(string),
 		d.Get("dataclip_slug").(string))
 
-	_, _, unshareErr := client.Data.UnsharePostgresDataclipWithUser(d.Get("dataclip_id").(string), d.Id())
+	_, _, unshareErr := client.Data.UnsharePostgresDataclipWithUser(ctx, d.Get("dataclip_id").(string), d.Id())
 	if unshareErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"log"
 	"regexp"
 
@@ -86,7 +88,7 @@ func setMaintenanceWindow(ctx context.Context, d *schema.ResourceData, meta inte
 
 	log.Printf("[DEBUG] Setting postgres maintenance window on %s", postgresID)
 
-	_, _, setErr := client.Postgres.SetMaintenanceWindow(postgresID, window)
+	_, _, setErr := client.Postgres.SetMaintenanceWindow(ctx, postgresID, window)
 	if setErr != nil {
 		return diag.FromErr(setErr)
 	}
@@ -97,8 +99,14 @@ func setMaintenanceWindow(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceHerokuxPostgresMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).API
 
-	w, _, getErr := client.Postgres.GetMaintenanceWindow(d.Id())
+	w, _, getErr := client.Postgres.GetMaintenanceWindow(ctx, d.Id())
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Postgres maintenance window %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return diag.FromErr(getErr)
 	}
 
@@ -2,14 +2,15 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/davidji99/terraform-provider-herokux/api/postgres"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,7 +24,7 @@ func resourceHerokuxPostgresMTLS() *schema.Resource {
 			StateContext: resourceHerokuxPostgresMTLSImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(20*time.Minute, 20*time.Minute, 20*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			// While it is preferable to use the UUID, the response returns the name so we need to use the name.
@@ -81,21 +82,14 @@ func resourceHerokuxPostgresMTLSProvision(ctx context.Context, d *schema.Resourc
 
 	// Enable MTLS
 	log.Printf("[DEBUG] Enabling MTLS on database %s", dbName)
-	newMTLS, _, enableErr := client.Postgres.ProvisionMTLS(dbName)
+	newMTLS, _, enableErr := client.Postgres.ProvisionMTLS(ctx, dbName)
 	if enableErr != nil {
 		return diag.FromErr(enableErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for MTLS configuration on %s to be operational", dbName)
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{postgres.MTLSConfigStatuses.PROVISIONING.ToString(), postgres.MTLSConfigStatuses.SERVERERROR.ToString()},
-		Target:       []string{postgres.MTLSConfigStatuses.OPERATIONAL.ToString()},
-		Refresh:      MTLSSCreationStateRefreshFunc(client, dbName),
-		Timeout:      time.Duration(config.MTLSProvisionVerifyTimeout) * time.Minute,
-		PollInterval: StateRefreshPollInterval,
-	}
-
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	waiter := mtlsProvisionWaiter(client, dbName, verifyTimeout(config.MTLSProvisionVerifyTimeout))
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for MTLS to be operational on %s: %s", dbName, err.Error())
 	}
 
@@ -110,7 +104,7 @@ func resourceHerokuxPostgresMTLSProvision(ctx context.Context, d *schema.Resourc
 
 	// When MTLS is provisioned, a certificate is automatically created. Fetch the initial certificate ID
 	// and store it under `initial_certificate_id` attribute.
-	certs, _, listErr := client.Postgres.ListMTLSCerts(dbName)
+	certs, _, listErr := client.Postgres.ListMTLSCerts(ctx, dbName)
 	if listErr != nil {
 		return diag.FromErr(listErr)
 	}
@@ -130,9 +124,9 @@ func resourceHerokuxPostgresMTLSProvision(ctx context.Context, d *schema.Resourc
 func resourceHerokuxPostgresMTLSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).API
 
-	mtls, response, getErr := client.Postgres.GetMTLS(d.Id())
+	mtls, _, getErr := client.Postgres.GetMTLS(ctx, d.Id())
 	if getErr != nil {
-		if response.StatusCode == 404 {
+		if errors.Is(getErr, api.ErrNotFound) {
 			log.Printf("[WARN] MTLS configuration for %s not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -154,21 +148,22 @@ func resourceHerokuxPostgresMTLSDeprovision(ctx context.Context, d *schema.Resou
 	client := config.API
 
 	log.Printf("[DEBUG] Disabling MTLS on database %s", d.Id())
-	_, _, deleteErr := client.Postgres.DeprovisionMTLS(d.Id())
+	_, _, deleteErr := client.Postgres.DeprovisionMTLS(ctx, d.Id())
 	if deleteErr != nil {
 		return diag.FromErr(deleteErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for MTLS configuration on %s to be deprovisioned", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{postgres.MTLSConfigStatuses.DEPROVISIONING.ToString()},
-		Target:       []string{postgres.MTLSConfigStatuses.DEPROVISIONED.ToString()},
+	waiter := &wait.Waiter[*postgres.MTLS, postgres.MTLSConfigStatus]{
+		Description:  fmt.Sprintf("MTLS configuration on %s to be deprovisioned", d.Id()),
+		Pending:      []postgres.MTLSConfigStatus{postgres.MTLSConfigStatuses.DEPROVISIONING},
+		Target:       []postgres.MTLSConfigStatus{postgres.MTLSConfigStatuses.DEPROVISIONED},
 		Refresh:      MTLSDeletionStateRefreshFunc(client, d.Id()),
-		Timeout:      time.Duration(config.MTLSDeprovisionVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.MTLSDeprovisionVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for MTLS to be deprovisioned on %s: %s", d.Id(), err.Error())
 	}
 
@@ -177,47 +172,47 @@ func resourceHerokuxPostgresMTLSDeprovision(ctx context.Context, d *schema.Resou
 	return nil
 }
 
-func MTLSSCreationStateRefreshFunc(client *api.Client, dbName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		mtlsConfig, response, getErr := client.Postgres.GetMTLS(dbName)
-
-		// Handle scenario where GetMTLS sometimes returns a 500. Return and try again.
-		if response != nil {
-			if response.StatusCode == 500 {
-				return mtlsConfig, postgres.MTLSConfigStatuses.SERVERERROR.ToString(), nil
-			}
-		}
+// mtlsProvisionWaiter waits for the MTLS configuration on dbName to be operational. GetMTLS sometimes returns a 500
+// while MTLS is being provisioned, so server errors are tolerated until the timeout rather than a fixed number of times.
+func mtlsProvisionWaiter(client *api.Client, dbName string, timeout time.Duration) *wait.Waiter[*postgres.MTLS, postgres.MTLSConfigStatus] {
+	return &wait.Waiter[*postgres.MTLS, postgres.MTLSConfigStatus]{
+		Description:        fmt.Sprintf("MTLS configuration on %s to be operational", dbName),
+		Pending:            []postgres.MTLSConfigStatus{postgres.MTLSConfigStatuses.PROVISIONING},
+		Target:             []postgres.MTLSConfigStatus{postgres.MTLSConfigStatuses.OPERATIONAL},
+		Refresh:            MTLSSCreationStateRefreshFunc(client, dbName),
+		Timeout:            timeout,
+		PollInterval:       StateRefreshPollInterval,
+		IsTransient:        wait.IsTransientError,
+		MaxTransientErrors: wait.UnlimitedTransientErrors,
+	}
+}
 
+// MTLSSCreationStateRefreshFunc returns the status of a MTLS configuration. GetMTLS sometimes returns a 500
+// while MTLS is being provisioned, which mtlsProvisionWaiter treats as a transient error and tries again.
+func MTLSSCreationStateRefreshFunc(client *api.Client, dbName string) wait.RefreshFunc[*postgres.MTLS, postgres.MTLSConfigStatus] {
+	return func(ctx context.Context) (*postgres.MTLS, postgres.MTLSConfigStatus, error) {
+		mtlsConfig, _, getErr := client.Postgres.GetMTLS(ctx, dbName)
 		if getErr != nil {
-			return nil, postgres.MTLSConfigStatuses.UNKNOWN.ToString(), getErr
-		}
-
-		if *mtlsConfig.GetStatus() == postgres.MTLSConfigStatuses.PROVISIONING {
-			log.Printf("[DEBUG] Still waiting for MTLS configuration on %s to be provisioned", dbName)
-			return mtlsConfig, mtlsConfig.GetStatus().ToString(), nil
+			return nil, postgres.MTLSConfigStatuses.UNKNOWN, getErr
 		}
 
-		return mtlsConfig, mtlsConfig.GetStatus().ToString(), nil
+		return mtlsConfig, *mtlsConfig.GetStatus(), nil
 	}
 }
 
-func MTLSDeletionStateRefreshFunc(client *api.Client, dbName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		mtlsConfig, response, getErr := client.Postgres.GetMTLS(dbName)
+func MTLSDeletionStateRefreshFunc(client *api.Client, dbName string) wait.RefreshFunc[*postgres.MTLS, postgres.MTLSConfigStatus] {
+	return func(ctx context.Context) (*postgres.MTLS, postgres.MTLSConfigStatus, error) {
+		mtlsConfig, _, getErr := client.Postgres.GetMTLS(ctx, dbName)
 		if getErr != nil {
-			if response.StatusCode == 404 {
+			if errors.Is(getErr, api.ErrNotFound) {
 				// 404 means the MTLS configuration was deleted
-				return postgres.MTLS{}, postgres.MTLSConfigStatuses.DEPROVISIONED.ToString(), nil
+				return nil, postgres.MTLSConfigStatuses.DEPROVISIONED, nil
 			}
 			// For all other statuses, return the error.
-			return nil, postgres.MTLSConfigStatuses.UNKNOWN.ToString(), getErr
-		}
-
-		if *mtlsConfig.GetStatus() == postgres.MTLSConfigStatuses.DEPROVISIONING {
-			log.Printf("[DEBUG] Still waiting for MTLS configuration on %s to be deprovisioned", dbName)
-			return mtlsConfig, mtlsConfig.GetStatus().ToString(), nil
+			return nil, postgres.MTLSConfigStatuses.UNKNOWN, getErr
 		}
 
-		return nil, "", nil
+		// The MTLS configuration is still being deprovisioned until it is no longer found.
+		return mtlsConfig, postgres.MTLSConfigStatuses.DEPROVISIONING, nil
 	}
 }
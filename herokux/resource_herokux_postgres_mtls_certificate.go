@@ -2,15 +2,16 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/davidji99/terraform-provider-herokux/api/postgres"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -28,7 +29,7 @@ func resourceHerokuxPostgresMTLSCertificate() *schema.Resource {
 			StateContext: resourceHerokuxPostgresMTLSCertificateImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(20*time.Minute, 20*time.Minute, 20*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			"database_name": {
@@ -83,7 +84,7 @@ func resourceHerokuxPostgresMTLSCertificateImport(ctx context.Context, d *schema
 	dbName := parsedImportID[0]
 	certID := parsedImportID[1]
 
-	cert, _, getErr := client.Postgres.GetMTLSCert(dbName, certID)
+	cert, _, getErr := client.Postgres.GetMTLSCert(ctx, dbName, certID)
 	if getErr != nil {
 		return nil, getErr
 	}
@@ -109,21 +110,22 @@ func resourceHerokuxPostgresMTLSCertificateCreate(ctx context.Context, d *schema
 
 	// Create MTLS certificate
 	log.Printf("[DEBUG] Creating MTLS certificate on database %s", dbName)
-	cert, _, createErr := client.Postgres.CreateMTLSCert(dbName)
+	cert, _, createErr := client.Postgres.CreateMTLSCert(ctx, dbName)
 	if createErr != nil {
 		return diag.FromErr(createErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for MTLS certificate for %s to be ready", dbName)
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{postgres.MTLSCertStatuses.PENDING.ToString()},
-		Target:       []string{postgres.MTLSCertStatuses.READY.ToString()},
+	waiter := &wait.Waiter[*postgres.MTLSCert, postgres.MTLSCertStatus]{
+		Description:  fmt.Sprintf("MTLS certificate on %s to be ready", dbName),
+		Pending:      []postgres.MTLSCertStatus{postgres.MTLSCertStatuses.PENDING},
+		Target:       []postgres.MTLSCertStatus{postgres.MTLSCertStatuses.READY},
 		Refresh:      MTLSSCertStateRefreshFunc(client, dbName, cert.GetID()),
-		Timeout:      time.Duration(config.MTLSCertificateCreateVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.MTLSCertificateCreateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for MTLS certificate to be ready on %s: %s", dbName, err.Error())
 	}
 
@@ -140,9 +142,9 @@ func resourceHerokuxPostgresMTLSCertificateRead(ctx context.Context, d *schema.R
 		return diag.FromErr(parseErr)
 	}
 
-	cert, response, getErr := client.Postgres.GetMTLSCert(ids[0], ids[1])
+	cert, _, getErr := client.Postgres.GetMTLSCert(ctx, ids[0], ids[1])
 	if getErr != nil {
-		if response.StatusCode == 404 {
+		if errors.Is(getErr, api.ErrNotFound) {
 			log.Printf("[WARN] MTLS certificate for %s not found, removing from state", ids[0])
 			d.SetId("")
 			return nil
@@ -174,21 +176,22 @@ func resourceHerokuxPostgresMTLSCertificateDelete(ctx context.Context, d *schema
 	certID := ids[1]
 
 	log.Printf("[DEBUG] Deleting MTLS certificate on database %s", d.Id())
-	_, _, deleteErr := client.Postgres.DeleteMTLSCert(dbName, certID)
+	_, _, deleteErr := client.Postgres.DeleteMTLSCert(ctx, dbName, certID)
 	if deleteErr != nil {
 		return diag.FromErr(deleteErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for MTLS certificate on %s to be deleted", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{postgres.MTLSCertStatuses.DISABLING.ToString()},
-		Target:       []string{postgres.MTLSCertStatuses.DISABLED.ToString()},
+	waiter := &wait.Waiter[*postgres.MTLSCert, postgres.MTLSCertStatus]{
+		Description:  fmt.Sprintf("MTLS certificate on %s to be deleted", d.Id()),
+		Pending:      []postgres.MTLSCertStatus{postgres.MTLSCertStatuses.DISABLING},
+		Target:       []postgres.MTLSCertStatus{postgres.MTLSCertStatuses.DISABLED},
 		Refresh:      MTLSCertificateDeletionStateRefreshFunc(client, dbName, certID),
-		Timeout:      time.Duration(config.MTLSCertificateDeleteVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.MTLSCertificateDeleteVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for MTLS certificate to be deleted on %s: %s", d.Id(), err.Error())
 	}
 
@@ -197,39 +200,34 @@ func resourceHerokuxPostgresMTLSCertificateDelete(ctx context.Context, d *schema
 	return nil
 }
 
-func MTLSSCertStateRefreshFunc(client *api.Client, dbName, certID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		cert, _, getErr := client.Postgres.GetMTLSCert(dbName, certID)
+func MTLSSCertStateRefreshFunc(client *api.Client, dbName, certID string) wait.RefreshFunc[*postgres.MTLSCert, postgres.MTLSCertStatus] {
+	return func(ctx context.Context) (*postgres.MTLSCert, postgres.MTLSCertStatus, error) {
+		cert, _, getErr := client.Postgres.GetMTLSCert(ctx, dbName, certID)
 		if getErr != nil {
-			return nil, postgres.MTLSCertStatuses.UNKNOWN.ToString(), getErr
+			return nil, postgres.MTLSCertStatuses.UNKNOWN, getErr
 		}
 
-		if *cert.GetStatus() == postgres.MTLSCertStatuses.PENDING {
-			log.Printf("[DEBUG] Still waiting for MTLS certificate on %s to be ready", dbName)
-			return cert, cert.GetStatus().ToString(), nil
-		}
-
-		return cert, cert.GetStatus().ToString(), nil
+		return cert, *cert.GetStatus(), nil
 	}
 }
 
-func MTLSCertificateDeletionStateRefreshFunc(client *api.Client, dbName, certID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		cert, response, getErr := client.Postgres.GetMTLSCert(dbName, certID)
+func MTLSCertificateDeletionStateRefreshFunc(client *api.Client, dbName, certID string) wait.RefreshFunc[*postgres.MTLSCert, postgres.MTLSCertStatus] {
+	return func(ctx context.Context) (*postgres.MTLSCert, postgres.MTLSCertStatus, error) {
+		cert, _, getErr := client.Postgres.GetMTLSCert(ctx, dbName, certID)
 		if getErr != nil {
-			if response.StatusCode == 404 {
+			if errors.Is(getErr, api.ErrNotFound) {
 				// 404 means the MTLS certificate was deleted
-				return postgres.MTLSCert{}, postgres.MTLSCertStatuses.DISABLED.ToString(), nil
+				return nil, postgres.MTLSCertStatuses.DISABLED, nil
 			}
 			// For all other statuses, return the error.
-			return nil, postgres.MTLSCertStatuses.UNKNOWN.ToString(), getErr
+			return nil, postgres.MTLSCertStatuses.UNKNOWN, getErr
 		}
 
-		if *cert.GetStatus() == postgres.MTLSCertStatuses.DISABLING {
-			log.Printf("[DEBUG] Still waiting for MTLS certificate on %s to be deleted", dbName)
-			return cert, cert.GetStatus().ToString(), nil
+		// The MTLS certificate is still being disabled until it is no longer found or reports as disabled.
+		if *cert.GetStatus() == postgres.MTLSCertStatuses.DISABLED {
+			return cert, postgres.MTLSCertStatuses.DISABLED, nil
 		}
 
-		return nil, "", nil
+		return cert, postgres.MTLSCertStatuses.DISABLING, nil
 	}
 }
@@ -2,13 +2,13 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/general"
-	"github.com/davidji99/terraform-provider-herokux/api/postgres"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -25,7 +25,7 @@ func resourceHerokuxPostgresMTLSIPRule() *schema.Resource {
 			StateContext: resourceHerokuxPostgresMTLSIPRuleImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(30*time.Minute, 30*time.Minute, 30*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			"database_name": {
@@ -72,7 +72,7 @@ func resourceHerokuxPostgresMTLSIPRuleImport(ctx context.Context, d *schema.Reso
 	cidr := parsedImportID[1]
 
 	// Get all IP rules
-	ipRules, _, listErr := client.Postgres.ListMTLSIPRules(dbName)
+	ipRules, _, listErr := client.Postgres.ListMTLSIPRules(ctx, dbName)
 	if listErr != nil {
 		return nil, listErr
 	}
@@ -122,21 +122,22 @@ func resourceHerokuxPostgresMTLSIPRuleCreate(ctx context.Context, d *schema.Reso
 
 	// Enable MTLS
 	log.Printf("[DEBUG] Creating MTLS IP rule on database %s", dbName)
-	ipRule, _, createErr := client.Postgres.CreateMTLSIPRule(dbName, opts)
+	ipRule, _, createErr := client.Postgres.CreateMTLSIPRule(ctx, dbName, opts)
 	if createErr != nil {
 		return diag.FromErr(createErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for MTLS IP rule on %s to be authorized", dbName)
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{general.MTLSIPRuleStatuses.AUTHORIZING.ToString()},
-		Target:       []string{general.MTLSIPRuleStatuses.AUTHORIZED.ToString()},
+	waiter := &wait.Waiter[*general.MtlsIPRule, general.MTLSIPRuleStatus]{
+		Description:  fmt.Sprintf("MTLS IP rule on %s to be authorized", dbName),
+		Pending:      []general.MTLSIPRuleStatus{general.MTLSIPRuleStatuses.AUTHORIZING},
+		Target:       []general.MTLSIPRuleStatus{general.MTLSIPRuleStatuses.AUTHORIZED},
 		Refresh:      MTLSSIPRuleStateRefreshFunc(client, dbName, ipRule.GetID()),
-		Timeout:      time.Duration(config.MTLSIPRuleCreateVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.MTLSIPRuleCreateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for MTLS IP rule to be authorized on %s: %s", dbName, err.Error())
 	}
 
@@ -153,9 +154,9 @@ func resourceHerokuxPostgresMTLSIPRuleRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(parseErr)
 	}
 
-	ipRule, response, getErr := client.Postgres.GetMTLSIPRule(ids[0], ids[1])
+	ipRule, _, getErr := client.Postgres.GetMTLSIPRule(ctx, ids[0], ids[1])
 	if getErr != nil {
-		if response.StatusCode == 404 {
+		if errors.Is(getErr, api.ErrNotFound) {
 			log.Printf("[WARN] MTLS IP rule for %s not found, removing from state", ids[0])
 			d.SetId("")
 			return nil
@@ -182,7 +183,7 @@ func resourceHerokuxPostgresMTLSIPRuleDelete(ctx context.Context, d *schema.Reso
 	}
 
 	log.Printf("[DEBUG] Deleting MTLS IP rule on database %s", d.Id())
-	_, deleteErr := client.Postgres.DeleteMTLSIPRule(ids[0], ids[1])
+	_, deleteErr := client.Postgres.DeleteMTLSIPRule(ctx, ids[0], ids[1])
 	if deleteErr != nil {
 		return diag.FromErr(deleteErr)
 	}
@@ -192,18 +193,13 @@ func resourceHerokuxPostgresMTLSIPRuleDelete(ctx context.Context, d *schema.Reso
 	return nil
 }
 
-func MTLSSIPRuleStateRefreshFunc(client *api.Client, dbName, ipRuleID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		ipRule, _, getErr := client.Postgres.GetMTLSIPRule(dbName, ipRuleID)
+func MTLSSIPRuleStateRefreshFunc(client *api.Client, dbName, ipRuleID string) wait.RefreshFunc[*general.MtlsIPRule, general.MTLSIPRuleStatus] {
+	return func(ctx context.Context) (*general.MtlsIPRule, general.MTLSIPRuleStatus, error) {
+		ipRule, _, getErr := client.Postgres.GetMTLSIPRule(ctx, dbName, ipRuleID)
 		if getErr != nil {
-			return nil, postgres.MTLSConfigStatuses.UNKNOWN.ToString(), getErr
+			return nil, general.MTLSIPRuleStatuses.UNKNOWN, getErr
 		}
 
-		if *ipRule.GetStatus() == general.MTLSIPRuleStatuses.AUTHORIZING {
-			log.Printf("[DEBUG] Still waiting for MTLS IP rule on %s to be authorized", dbName)
-			return ipRule, ipRule.GetStatus().ToString(), nil
-		}
-
-		return ipRule, ipRule.GetStatus().ToString(), nil
+		return ipRule, *ipRule.GetStatus(), nil
 	}
 }
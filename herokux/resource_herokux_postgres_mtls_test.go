@@ -1,11 +1,47 @@
 package herokux
 
 import (
+	"context"
 	"fmt"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/mock"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
+	"github.com/davidji99/terraform-provider-herokux/api/postgres"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"net/http"
 	"testing"
+	"time"
 )
 
+func TestMTLSProvisionWaiter_ToleratesServerErrors(t *testing.T) {
+	serverErrors := 3 * wait.DefaultMaxTransientErrors
+	calls := 0
+
+	client := &api.Client{Postgres: &mock.PostgresService{
+		GetMTLSFunc: func(ctx context.Context, nameOrID string) (*postgres.MTLS, *simpleresty.Response, error) {
+			calls++
+			if calls <= serverErrors {
+				return nil, nil, &apierror.Error{StatusCode: http.StatusInternalServerError}
+			}
+
+			status := postgres.MTLSConfigStatuses.OPERATIONAL
+			return &postgres.MTLS{Status: &status}, nil, nil
+		},
+	}}
+
+	waiter := mtlsProvisionWaiter(client, "my-database", time.Minute)
+	waiter.PollInterval, waiter.MaxPollInterval = time.Millisecond, time.Millisecond
+	waiter.Logf = func(string, ...interface{}) {}
+
+	mtlsConfig, err := waiter.Wait(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, postgres.MTLSConfigStatuses.OPERATIONAL, *mtlsConfig.GetStatus())
+	assert.Equal(t, serverErrors+1, calls)
+}
+
 func TestAccHerokuxPostgresMTLS_Basic(t *testing.T) {
 	dbName := testAccConfig.GetDBNameorSkip(t)
 
@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"log"
 	"time"
 
@@ -106,7 +108,7 @@ func resourceHerokuxPostgresSettingsCreate(ctx context.Context, d *schema.Resour
 
 	log.Printf("[DEBUG] Updating postgres settings on %s with %v", postgresID, opts)
 
-	_, _, updateErr := client.Postgres.UpdateSettings(postgresID, opts)
+	_, _, updateErr := client.Postgres.UpdateSettings(ctx, postgresID, opts)
 	if updateErr != nil {
 		return diag.FromErr(updateErr)
 	}
@@ -123,8 +125,14 @@ func resourceHerokuxPostgresSettingsCreate(ctx context.Context, d *schema.Resour
 func resourceHerokuxPostgresSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).API
 
-	s, _, getErr := client.Postgres.GetSettings(d.Id())
+	s, _, getErr := client.Postgres.GetSettings(ctx, d.Id())
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Postgres settings %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return diag.FromErr(getErr)
 	}
 
@@ -167,7 +175,7 @@ func resourceHerokuxPostgresSettingsUpdate(ctx context.Context, d *schema.Resour
 
 	log.Printf("[DEBUG] Updating postgres settings on %s with %v", postgresID, opts)
 
-	_, _, updateErr := client.Postgres.UpdateSettings(postgresID, opts)
+	_, _, updateErr := client.Postgres.UpdateSettings(ctx, postgresID, opts)
 	if updateErr != nil {
 		return diag.FromErr(updateErr)
 	}
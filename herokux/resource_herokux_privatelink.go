@@ -2,12 +2,13 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/data"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/davidji99/terraform-provider-herokux/api/postgres"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -25,7 +26,7 @@ func resourceHerokuxPrivatelink() *schema.Resource {
 			StateContext: resourceHerokuxPrivatelinkImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(30*time.Minute, 20*time.Minute, 30*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			"addon_id": {
@@ -88,22 +89,23 @@ func resourceHerokuxPrivatelinkCreate(ctx context.Context, d *schema.ResourceDat
 
 	log.Printf("[DEBUG] Creating Privatelink on addon %s", addonID)
 
-	pl, _, createErr := client.Postgres.CreatePrivatelink(addonID, opts)
+	pl, _, createErr := client.Postgres.CreatePrivatelink(ctx, addonID, opts)
 	if createErr != nil {
 		return diag.FromErr(createErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for privatelink on %s to be provisioned", addonID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{data.PrivatelinkStatuses.PROVISIONING.ToString()},
-		Target:       []string{data.PrivatelinkStatuses.OPERATIONAL.ToString()},
+	waiter := &wait.Waiter[*postgres.Privatelink, data.PrivatelinkStatus]{
+		Description:  fmt.Sprintf("privatelink on %s to be provisioned", addonID),
+		Pending:      []data.PrivatelinkStatus{data.PrivatelinkStatuses.PROVISIONING},
+		Target:       []data.PrivatelinkStatus{data.PrivatelinkStatuses.OPERATIONAL},
 		Refresh:      PrivatelinkCreateStateRefreshFunc(client, addonID),
-		Timeout:      time.Duration(config.PrivatelinkCreateVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.PrivatelinkCreateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for privatelink to be provisioned on %s: %s", addonID, err.Error())
 	}
 
@@ -115,8 +117,14 @@ func resourceHerokuxPrivatelinkCreate(ctx context.Context, d *schema.ResourceDat
 func resourceHerokuxPrivatelinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).API
 
-	pl, _, readErr := client.Postgres.GetPrivatelink(d.Id())
+	pl, _, readErr := client.Postgres.GetPrivatelink(ctx, d.Id())
 	if readErr != nil {
+		if errors.Is(readErr, api.ErrNotFound) {
+			log.Printf("[WARN] Privatelink %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return diag.FromErr(readErr)
 	}
 
@@ -172,7 +180,7 @@ func resourceHerokuxPrivatelinkUpdate(ctx context.Context, d *schema.ResourceDat
 	if len(toRemove) > 0 {
 		log.Printf("[DEBUG] Removing the following account ids %v from %s", toRemove, d.Id())
 
-		_, _, removeErr := client.Postgres.RemovePrivatelinkAllowedAccounts(d.Id(),
+		_, _, removeErr := client.Postgres.RemovePrivatelinkAllowedAccounts(ctx, d.Id(),
 			&postgres.PrivatelinkRequest{AllowedAccounts: toRemove})
 
 		if removeErr != nil {
@@ -184,7 +192,7 @@ func resourceHerokuxPrivatelinkUpdate(ctx context.Context, d *schema.ResourceDat
 	if len(toAdd) > 0 {
 		log.Printf("[DEBUG] Adding the following account ids %v to %s", toAdd, d.Id())
 
-		_, _, addErr := client.Postgres.AddPrivatelinkAllowedAccounts(d.Id(),
+		_, _, addErr := client.Postgres.AddPrivatelinkAllowedAccounts(ctx, d.Id(),
 			&postgres.PrivatelinkRequest{AllowedAccounts: toAdd})
 
 		if addErr != nil {
@@ -195,15 +203,16 @@ func resourceHerokuxPrivatelinkUpdate(ctx context.Context, d *schema.ResourceDat
 		// The resource only needs to wait for newly added account ids to become `Active`.
 		log.Printf("[DEBUG] Waiting for added account IDS on %s to become active", d.Id())
 
-		stateConf := &resource.StateChangeConf{
-			Pending:      []string{data.PrivatelinkAllowedAccountStatuses.PROVISIONING.ToString()},
-			Target:       []string{data.PrivatelinkAllowedAccountStatuses.ACTIVE.ToString()},
+		waiter := &wait.Waiter[*postgres.Privatelink, data.PrivatelinkAllowedAccountStatus]{
+			Description:  fmt.Sprintf("privatelink allowed accounts on %s to become active", d.Id()),
+			Pending:      []data.PrivatelinkAllowedAccountStatus{data.PrivatelinkAllowedAccountStatuses.PROVISIONING},
+			Target:       []data.PrivatelinkAllowedAccountStatus{data.PrivatelinkAllowedAccountStatuses.ACTIVE},
 			Refresh:      PrivatelinkUpdateStateRefreshFunc(client, d.Id()),
-			Timeout:      time.Duration(config.PrivatelinkAllowedAccountsAddVerifyTimeout) * time.Minute,
+			Timeout:      verifyTimeout(config.PrivatelinkAllowedAccountsAddVerifyTimeout),
 			PollInterval: StateRefreshPollInterval,
 		}
 
-		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if _, err := waiter.Wait(ctx); err != nil {
 			return diag.Errorf("error waiting for account ids to become active on %s: %s", d.Id(), err.Error())
 		}
 	}
@@ -225,21 +234,22 @@ func resourceHerokuxPrivatelinkDelete(ctx context.Context, d *schema.ResourceDat
 	client := config.API
 
 	log.Printf("[DEBUG] Deleting privatelink on addon %s", d.Id())
-	_, _, deleteErr := client.Postgres.DeletePrivatelink(d.Id())
+	_, _, deleteErr := client.Postgres.DeletePrivatelink(ctx, d.Id())
 	if deleteErr != nil {
 		return diag.FromErr(deleteErr)
 	}
 
 	log.Printf("[DEBUG] Waiting for privatelink on %s to be deprovisioned", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{data.PrivatelinkStatuses.DEPROVISIONING.ToString()},
-		Target:       []string{data.PrivatelinkStatuses.DEPROVISIONED.ToString()},
+	waiter := &wait.Waiter[*postgres.Privatelink, data.PrivatelinkStatus]{
+		Description:  fmt.Sprintf("privatelink on %s to be deprovisioned", d.Id()),
+		Pending:      []data.PrivatelinkStatus{data.PrivatelinkStatuses.DEPROVISIONING},
+		Target:       []data.PrivatelinkStatus{data.PrivatelinkStatuses.DEPROVISIONED},
 		Refresh:      PrivatelinkDeleteStateRefreshFunc(client, d.Id()),
-		Timeout:      time.Duration(config.PrivatelinkDeleteVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.PrivatelinkDeleteVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for privatelink to be deprovisioned on %s: %s", d.Id(), err.Error())
 	}
 
@@ -248,65 +258,59 @@ func resourceHerokuxPrivatelinkDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
-func PrivatelinkUpdateStateRefreshFunc(client *api.Client, addonID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		pl, _, getErr := client.Postgres.GetPrivatelink(addonID)
+func PrivatelinkUpdateStateRefreshFunc(client *api.Client, addonID string) wait.RefreshFunc[*postgres.Privatelink, data.PrivatelinkAllowedAccountStatus] {
+	return func(ctx context.Context) (*postgres.Privatelink, data.PrivatelinkAllowedAccountStatus, error) {
+		pl, _, getErr := client.Postgres.GetPrivatelink(ctx, addonID)
 		if getErr != nil {
-			return nil, data.PrivatelinkAllowedAccountStatuses.UNKNOWN.ToString(), getErr
+			return nil, data.PrivatelinkAllowedAccountStatuses.UNKNOWN, getErr
 		}
 
 		if !checkAccountsAreActive(pl) {
-			log.Printf("[DEBUG] Still waiting for privatelink allowed accounts on %s to become active", addonID)
-			return pl, data.PrivatelinkAllowedAccountStatuses.PROVISIONING.ToString(), nil
+			return pl, data.PrivatelinkAllowedAccountStatuses.PROVISIONING, nil
 		}
 
-		return pl, data.PrivatelinkAllowedAccountStatuses.ACTIVE.ToString(), nil
+		return pl, data.PrivatelinkAllowedAccountStatuses.ACTIVE, nil
 	}
 }
 
-func PrivatelinkDeleteStateRefreshFunc(client *api.Client, addonID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		pl, response, getErr := client.Postgres.GetPrivatelink(addonID)
+func PrivatelinkDeleteStateRefreshFunc(client *api.Client, addonID string) wait.RefreshFunc[*postgres.Privatelink, data.PrivatelinkStatus] {
+	return func(ctx context.Context) (*postgres.Privatelink, data.PrivatelinkStatus, error) {
+		pl, _, getErr := client.Postgres.GetPrivatelink(ctx, addonID)
 		if getErr != nil {
-			if response.StatusCode == 404 {
+			if errors.Is(getErr, api.ErrNotFound) {
 				// 404 means the privatelink was deleted
-				return pl, data.PrivatelinkStatuses.DEPROVISIONED.ToString(), nil
+				return nil, data.PrivatelinkStatuses.DEPROVISIONED, nil
 			}
 			// For all other statuses, return the error.
-			return nil, postgres.MTLSCertStatuses.UNKNOWN.ToString(), getErr
-		}
-
-		if pl.Status.ToString() == data.PrivatelinkStatuses.DEPROVISIONED.ToString() && response.StatusCode == 200 {
-			// When a privatelink is deleted, the GET request still returns a 200 with a privatelink status of 'deprovisioned'.
-			// This doesn't mean the privatelink was deleted/deprovisioned fully, so this resource will indicate a status
-			// of deprovisioning until the GET request returns a 404.
-			log.Printf("[DEBUG] Still waiting for privatelink on %s to be deleted", pl.GetAddon().GetUUID())
-			return pl, data.PrivatelinkStatuses.DEPROVISIONING.ToString(), nil
+			return nil, data.PrivatelinkStatuses.UNKNOWN, getErr
 		}
 
-		return nil, "", nil
+		// When a privatelink is deleted, the GET request still returns a 200 with a privatelink status of 'deprovisioned'.
+		// This doesn't mean the privatelink was deleted/deprovisioned fully, so this resource will indicate a status
+		// of deprovisioning until the GET request returns a 404.
+		return pl, data.PrivatelinkStatuses.DEPROVISIONING, nil
 	}
 }
 
-func PrivatelinkCreateStateRefreshFunc(client *api.Client, addonID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func PrivatelinkCreateStateRefreshFunc(client *api.Client, addonID string) wait.RefreshFunc[*postgres.Privatelink, data.PrivatelinkStatus] {
+	return func(ctx context.Context) (*postgres.Privatelink, data.PrivatelinkStatus, error) {
 		// Check the status of both the privatelink and all allowed accounts.
-		pl, _, getErr := client.Postgres.GetPrivatelink(addonID)
+		pl, _, getErr := client.Postgres.GetPrivatelink(ctx, addonID)
 		if getErr != nil {
-			return nil, data.PrivatelinkStatuses.UNKNOWN.ToString(), getErr
+			return nil, data.PrivatelinkStatuses.UNKNOWN, getErr
 		}
 
-		if pl.Status.ToString() == data.PrivatelinkStatuses.PROVISIONING.ToString() {
-			log.Printf("[DEBUG] Still waiting for privatelink on %s to be provisioned", addonID)
-			return pl, pl.Status.ToString(), nil
+		if pl.Status == data.PrivatelinkStatuses.PROVISIONING {
+			return pl, pl.Status, nil
 		}
 
+		// The privatelink is still considered provisioning until all allowed accounts are active.
 		if !checkAccountsAreActive(pl) {
 			log.Printf("[DEBUG] Still waiting for privatelink allowed accounts on %s to become active", addonID)
-			return pl, data.PrivatelinkAllowedAccountStatuses.PROVISIONING.ToString(), nil
+			return pl, data.PrivatelinkStatuses.PROVISIONING, nil
 		}
 
-		return pl, data.PrivatelinkStatuses.OPERATIONAL.ToString(), nil
+		return pl, data.PrivatelinkStatuses.OPERATIONAL, nil
 	}
 }
 
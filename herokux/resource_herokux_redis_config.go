@@ -2,6 +2,8 @@ package herokux
 
 import (
 	"context"
+	"errors"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"log"
 
 	"github.com/davidji99/terraform-provider-herokux/api/redis"
@@ -65,7 +67,7 @@ func resourceHerokuxRedisConfig() *schema.Resource {
 func resourceHerokuxRedisConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).API
 
-	c, _, getErr := client.Redis.GetConfig(d.Id())
+	c, _, getErr := client.Redis.GetConfig(ctx, d.Id())
 	if getErr != nil {
 		return nil, getErr
 	}
@@ -109,7 +111,7 @@ func resourceHerokuxRedisConfigUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	log.Printf("[DEBUG] Updating redis configuration(s) for %s", redisID)
-	_, _, updateErr := client.Redis.UpdateConfig(redisID, opts)
+	_, _, updateErr := client.Redis.UpdateConfig(ctx, redisID, opts)
 	if updateErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -132,8 +134,14 @@ func resourceHerokuxRedisConfigRead(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	client := meta.(*Config).API
 
-	c, _, getErr := client.Redis.GetConfig(d.Id())
+	c, _, getErr := client.Redis.GetConfig(ctx, d.Id())
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Redis config %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to retrieve redis configurations",
@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"log"
 	"regexp"
 
@@ -86,7 +88,7 @@ func setRedisMaintenanceWindow(ctx context.Context, d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] Setting redis maintenance window on %s", redisID)
 
-	_, _, setErr := client.Redis.SetMaintenanceWindow(redisID, window)
+	_, _, setErr := client.Redis.SetMaintenanceWindow(ctx, redisID, window)
 	if setErr != nil {
 		return diag.FromErr(setErr)
 	}
@@ -97,8 +99,14 @@ func setRedisMaintenanceWindow(ctx context.Context, d *schema.ResourceData, meta
 func resourceHerokuxRedisMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).API
 
-	w, _, getErr := client.Redis.GetMaintenanceWindow(d.Id())
+	w, _, getErr := client.Redis.GetMaintenanceWindow(ctx, d.Id())
 	if getErr != nil {
+		if errors.Is(getErr, api.ErrNotFound) {
+			log.Printf("[WARN] Redis maintenance window %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return diag.FromErr(getErr)
 	}
 
@@ -2,7 +2,9 @@ package herokux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/davidji99/terraform-provider-herokux/api"
 	"github.com/davidji99/terraform-provider-herokux/api/scheduler"
 	"github.com/davidji99/tfph"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -82,7 +84,7 @@ func resourceHerokuxSchedulerJobImport(ctx context.Context, d *schema.ResourceDa
 	appID := parsedImportID[0]
 	jobID := parsedImportID[1]
 
-	job, _, findErr := client.Scheduler.FindByID(appID, jobID)
+	job, _, findErr := client.Scheduler.FindByID(ctx, appID, jobID)
 	if findErr != nil {
 		return nil, findErr
 	}
@@ -152,7 +154,7 @@ func resourceHerokuxSchedulerJobCreate(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[DEBUG] Creating scheduler job for app : %v", appID)
 
-	job, _, createErr := client.Scheduler.Create(appID, opts)
+	job, _, createErr := client.Scheduler.Create(ctx, appID, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -175,8 +177,14 @@ func resourceHerokuxSchedulerJobRead(ctx context.Context, d *schema.ResourceData
 	client := meta.(*Config).API
 	appID := getAppID(d)
 
-	job, _, findErr := client.Scheduler.FindByID(appID, d.Id())
+	job, _, findErr := client.Scheduler.FindByID(ctx, appID, d.Id())
 	if findErr != nil {
+		if errors.Is(findErr, api.ErrNotFound) {
+			log.Printf("[WARN] Scheduler job %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("Unable to retrieve schedule job %s app %s", appID, d.Id()),
@@ -246,7 +254,7 @@ func resourceHerokuxSchedulerJobUpdate(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[DEBUG] Updating scheduler job for app : %v", appID)
 
-	_, _, updateErr := client.Scheduler.Update(appID, d.Id(), opts)
+	_, _, updateErr := client.Scheduler.Update(ctx, appID, d.Id(), opts)
 	if updateErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -269,7 +277,7 @@ func resourceHerokuxSchedulerJobDelete(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[DEBUG] Deleting scheduler job %s for app : %v", appID, d.Id())
 
-	_, deleteErr := client.Scheduler.Delete(appID, d.Id())
+	_, deleteErr := client.Scheduler.Delete(ctx, appID, d.Id())
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -1,10 +1,18 @@
 package herokux
 
 import (
+	"context"
 	"fmt"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/mock"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	"github.com/davidji99/terraform-provider-herokux/api/scheduler"
 	"github.com/davidji99/terraform-provider-herokux/helper/test"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"net/http"
 	"regexp"
 	"testing"
 )
@@ -13,7 +21,7 @@ func TestAccHerokuxSchedulerJob_Basic_EveryTenMin(t *testing.T) {
 	testAccConfig.GetRunE2ETestsOrSkip(t)
 
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	plan := "scheduler:standard"
 	frequency := "every_ten_minutes"
 
@@ -44,7 +52,7 @@ func TestAccHerokuxSchedulerJob_Basic_EveryHour(t *testing.T) {
 	testAccConfig.GetRunE2ETestsOrSkip(t)
 
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	plan := "scheduler:standard"
 	frequency := "every_hour_at_30"
 
@@ -75,7 +83,7 @@ func TestAccHerokuxSchedulerJob_Basic_EveryDay(t *testing.T) {
 	testAccConfig.GetRunE2ETestsOrSkip(t)
 
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	plan := "scheduler:standard"
 	frequency := "every_day_at_17:30"
 
@@ -105,7 +113,7 @@ func TestAccHerokuxSchedulerJob_Modification(t *testing.T) {
 	testAccConfig.GetRunE2ETestsOrSkip(t)
 
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	plan := "scheduler:standard"
 	frequency := "every_day_at_4:30"
 
@@ -148,7 +156,7 @@ func TestAccHerokuxSchedulerJob_Basic_InvalidFrequency(t *testing.T) {
 	testAccConfig.GetRunE2ETestsOrSkip(t)
 
 	orgName := testAccConfig.GetAnyOrganizationOrSkip(t)
-	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	appName := fmt.Sprintf("tftest-%s", testAccConfig.RandString(t, 10))
 	plan := "scheduler:standard"
 	frequency := "every1_second_at_14"
 
@@ -179,3 +187,87 @@ resource "herokux_scheduler_job" "foobar" {
 }
 `, test.HerokuAppAddonBlock(appName, orgName, addonPlan), command, dynoSize, frequency)
 }
+
+func TestResourceHerokuxSchedulerJobRead(t *testing.T) {
+	appID := "8bc4ae51-ff2b-4a8b-b0b5-1c5e63ca4e4a"
+	every, at, unsupportedEvery := 60, 30, 5
+	dynoSize, command := "Standard-1X", "rake cleanup"
+
+	testCases := []struct {
+		name            string
+		findByID        func(ctx context.Context, appID, jobID string) (*scheduler.Job, *simpleresty.Response, error)
+		expectError     bool
+		expectID        string
+		expectCommand   string
+		expectFrequency string
+	}{
+		{
+			name: "found",
+			findByID: func(ctx context.Context, appID, jobID string) (*scheduler.Job, *simpleresty.Response, error) {
+				return &scheduler.Job{
+					ID: &jobID,
+					Attributes: &scheduler.JobAttributes{
+						Every:    &every,
+						At:       &at,
+						DynoSize: &dynoSize,
+						Command:  &command,
+					},
+				}, nil, nil
+			},
+			expectID:        "job-id",
+			expectCommand:   "rake cleanup",
+			expectFrequency: "every_hour_at_30",
+		},
+		{
+			name: "not found",
+			findByID: func(ctx context.Context, appID, jobID string) (*scheduler.Job, *simpleresty.Response, error) {
+				return nil, nil, &apierror.Error{StatusCode: http.StatusNotFound}
+			},
+			expectID: "",
+		},
+		{
+			name: "server error",
+			findByID: func(ctx context.Context, appID, jobID string) (*scheduler.Job, *simpleresty.Response, error) {
+				return nil, nil, &apierror.Error{StatusCode: http.StatusInternalServerError}
+			},
+			expectError: true,
+			expectID:    "job-id",
+		},
+		{
+			name: "unsupported frequency",
+			findByID: func(ctx context.Context, appID, jobID string) (*scheduler.Job, *simpleresty.Response, error) {
+				return &scheduler.Job{
+					ID:         &jobID,
+					Attributes: &scheduler.JobAttributes{Every: &unsupportedEvery},
+				}, nil, nil
+			},
+			expectError: true,
+			expectID:    "job-id",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceHerokuxSchedulerJob().Schema, map[string]interface{}{
+				"app_id": appID,
+			})
+			d.SetId("job-id")
+
+			meta := &Config{API: &api.Client{Scheduler: &mock.SchedulerService{
+				FindByIDFunc: func(ctx context.Context, gotAppID, jobID string) (*scheduler.Job, *simpleresty.Response, error) {
+					assert.Equal(t, appID, gotAppID)
+					return tc.findByID(ctx, gotAppID, jobID)
+				},
+			}}}
+
+			diags := resourceHerokuxSchedulerJobRead(context.Background(), d, meta)
+			assert.Equal(t, tc.expectError, diags.HasError())
+			assert.Equal(t, tc.expectID, d.Id())
+
+			if tc.expectCommand != "" {
+				assert.Equal(t, tc.expectCommand, d.Get("command"))
+				assert.Equal(t, tc.expectFrequency, d.Get("frequency"))
+			}
+		})
+	}
+}
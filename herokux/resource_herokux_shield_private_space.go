@@ -5,8 +5,8 @@ import (
 	"fmt"
 	heroku "github.com/davidji99/heroku-go/v5"
 	"github.com/davidji99/terraform-provider-herokux/api/data"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/wait"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -28,7 +28,7 @@ func resourceHerokuxShieldPrivateSpace() *schema.Resource {
 			StateContext: resourceHerokuxShieldPrivateSpaceImport,
 		},
 
-		Timeouts: resourceTimeouts(),
+		Timeouts: verifiedResourceTimeouts(30*time.Minute, 30*time.Minute, 30*time.Minute),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -107,7 +107,7 @@ func resourceHerokuxShieldPrivateSpaceImport(ctx context.Context, d *schema.Reso
 	config := meta.(*Config)
 	client := config.PlatformAPI
 
-	space, getErr := client.SpaceInfo(context.TODO(), d.Id())
+	space, getErr := client.SpaceInfo(ctx, d.Id())
 	if getErr != nil {
 		return nil, getErr
 	}
@@ -174,7 +174,7 @@ func resourceHerokuxShieldPrivateSpaceCreate(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Creating new Shield Private Space")
 
-	space, createErr := client.SpaceCreate(context.TODO(), opts)
+	space, createErr := client.SpaceCreate(ctx, opts)
 	if createErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -187,15 +187,16 @@ func resourceHerokuxShieldPrivateSpaceCreate(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Waiting for shield private space %s to be allocated", space.ID)
 
-	stateConf := &resource.StateChangeConf{
+	waiter := &wait.Waiter[*heroku.Space, string]{
+		Description:  fmt.Sprintf("shield private space %s to be allocated", space.ID),
 		Pending:      []string{"allocating"},
-		Target:       []string{"Operational"},
+		Target:       []string{data.PrivatelinkStatuses.OPERATIONAL.ToString()},
 		Refresh:      ShieldPrivateSpaceStateRefreshFunc(client, space.ID),
-		Timeout:      time.Duration(config.PrivateSpaceCreateVerifyTimeout) * time.Minute,
+		Timeout:      verifyTimeout(config.PrivateSpaceCreateVerifyTimeout),
 		PollInterval: StateRefreshPollInterval,
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return diag.Errorf("error waiting for shield private space %s to be allocated: %s", space.ID, err.Error())
 	}
 
@@ -206,20 +207,18 @@ func resourceHerokuxShieldPrivateSpaceCreate(ctx context.Context, d *schema.Reso
 	return resourceHerokuxShieldPrivateSpaceRead(ctx, d, meta)
 }
 
-func ShieldPrivateSpaceStateRefreshFunc(client *heroku.Service, spaceID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		// Check the status of both the privatelink and all allowed accounts.
-		space, getErr := client.SpaceInfo(context.TODO(), spaceID)
+func ShieldPrivateSpaceStateRefreshFunc(client *heroku.Service, spaceID string) wait.RefreshFunc[*heroku.Space, string] {
+	return func(ctx context.Context) (*heroku.Space, string, error) {
+		space, getErr := client.SpaceInfo(ctx, spaceID)
 		if getErr != nil {
 			return nil, "", getErr
 		}
 
 		if space.State == "allocating" {
-			log.Printf("[DEBUG] shield private space %s still allocating", spaceID)
 			return space, space.State, nil
 		}
 
-		return space.State, data.PrivatelinkStatuses.OPERATIONAL.ToString(), nil
+		return space, data.PrivatelinkStatuses.OPERATIONAL.ToString(), nil
 	}
 }
 
@@ -229,7 +228,7 @@ func resourceHerokuxShieldPrivateSpaceRead(ctx context.Context, d *schema.Resour
 	platformAPI := config.PlatformAPI
 	api := config.API
 
-	space, readErr := platformAPI.SpaceInfo(context.TODO(), d.Id())
+	space, readErr := platformAPI.SpaceInfo(ctx, d.Id())
 	if readErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -249,7 +248,7 @@ func resourceHerokuxShieldPrivateSpaceRead(ctx context.Context, d *schema.Resour
 		return diags
 	}
 
-	spaceNat, natReadErr := platformAPI.SpaceNATInfo(context.TODO(), d.Id())
+	spaceNat, natReadErr := platformAPI.SpaceNATInfo(ctx, d.Id())
 	if natReadErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -259,7 +258,7 @@ func resourceHerokuxShieldPrivateSpaceRead(ctx context.Context, d *schema.Resour
 		return diags
 	}
 
-	spaceLogDrain, _, drainGetErr := api.Platform.GetSpaceLogDrain(d.Id())
+	spaceLogDrain, _, drainGetErr := api.Platform.GetSpaceLogDrain(ctx, d.Id())
 	if drainGetErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -299,7 +298,7 @@ func resourceHerokuxShieldPrivateSpaceUpdate(ctx context.Context, d *schema.Reso
 		opts := heroku.SpaceUpdateOpts{Name: &vs}
 
 		log.Printf("[DEBUG] Updating Shield Private Space: %s", d.Id())
-		_, updateErr := platformAPI.SpaceUpdate(context.TODO(), d.Id(), opts)
+		_, updateErr := platformAPI.SpaceUpdate(ctx, d.Id(), opts)
 		if updateErr != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -317,7 +316,7 @@ func resourceHerokuxShieldPrivateSpaceUpdate(ctx context.Context, d *schema.Reso
 		log.Printf("[DEBUG] updated shield private space log_drain: %s", url)
 
 		log.Printf("[DEBUG] Updating Shield Private Space %s log drain", d.Id())
-		_, _, updateErr := api.Platform.SetSpaceLogDrain(d.Id(), url)
+		_, _, updateErr := api.Platform.SetSpaceLogDrain(ctx, d.Id(), url)
 		if updateErr != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -338,7 +337,7 @@ func resourceHerokuxShieldPrivateSpaceDelete(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Deleting Shield Private Space: %s", d.Id())
 
-	_, deleteErr := client.SpaceDelete(context.TODO(), d.Id())
+	_, deleteErr := client.SpaceDelete(ctx, d.Id())
 	if deleteErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,27 @@
+package herokux
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewProviderServer returns the plugin protocol version 5 server of the provider, which combines the SDKv2
+// provider with the provider built on terraform-plugin-framework.
+func NewProviderServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	return newProviderServer(ctx, New())
+}
+
+func newProviderServer(ctx context.Context, p *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	muxServer, muxErr := tf5muxserver.NewMuxServer(ctx,
+		p.GRPCProvider,
+		providerserver.NewProtocol5(newFrameworkProvider(p)),
+	)
+	if muxErr != nil {
+		return nil, muxErr
+	}
+
+	return muxServer.ProviderServer, nil
+}
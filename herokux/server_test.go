@@ -0,0 +1,408 @@
+package herokux
+
+import (
+	"context"
+	"encoding/json"
+	heroku "github.com/davidji99/heroku-go/v5"
+	"github.com/davidji99/simpleresty"
+	"github.com/davidji99/terraform-provider-herokux/api"
+	"github.com/davidji99/terraform-provider-herokux/api/mock"
+	"github.com/davidji99/terraform-provider-herokux/api/pkg/apierror"
+	"github.com/davidji99/terraform-provider-herokux/api/postgres"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestProviderServer returns the combined server of the provider.
+func newTestProviderServer(t *testing.T) tfprotov5.ProviderServer {
+	serverFunc, err := NewProviderServer(context.Background())
+	assert.Nil(t, err)
+
+	return serverFunc()
+}
+
+// testProviderConfig returns a provider configuration with an API key.
+func testProviderConfig(t *testing.T, server tfprotov5.ProviderServer) *tfprotov5.DynamicValue {
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	assert.Nil(t, err)
+
+	return testConfig(t, resp.Provider, map[string]tftypes.Value{"api_key": tftypes.NewValue(tftypes.String, "token")})
+}
+
+// testEphemeralResourceConfig returns the configuration of an ephemeral resource with values.
+func testEphemeralResourceConfig(t *testing.T, server tfprotov5.ProviderServer, typeName string,
+	values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	assert.Nil(t, err)
+
+	return testConfig(t, resp.EphemeralResourceSchemas[typeName], values)
+}
+
+// testConfig returns a configuration of s with values. Other attributes are null and blocks are empty.
+func testConfig(t *testing.T, s *tfprotov5.Schema, values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	typ := s.ValueType().(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attrType, nil)
+	}
+	for _, block := range s.Block.BlockTypes {
+		attributes[block.TypeName] = tftypes.NewValue(typ.AttributeTypes[block.TypeName], []tftypes.Value{})
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+
+	config, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, attributes))
+	assert.Nil(t, err)
+
+	return &config
+}
+
+// newConfiguredTestProviderServer returns the combined server of the provider configured with meta.
+func newConfiguredTestProviderServer(t *testing.T, meta *Config) tfprotov5.ProviderServer {
+	p := New()
+
+	serverFunc, err := newProviderServer(context.Background(), p)
+	assert.Nil(t, err)
+	server := serverFunc()
+
+	resp, err := server.ConfigureProvider(context.Background(),
+		&tfprotov5.ConfigureProviderRequest{Config: testProviderConfig(t, server)})
+	assert.Nil(t, err)
+	for _, d := range resp.Diagnostics {
+		assert.NotEqual(t, tfprotov5.DiagnosticSeverityError, d.Severity, d.Summary)
+	}
+
+	// Replace the API clients configured by the SDKv2 provider.
+	p.SetMeta(meta)
+
+	return server
+}
+
+func TestProviderServer_GetMetadata(t *testing.T) {
+	resp, err := newTestProviderServer(t).GetMetadata(context.Background(), &tfprotov5.GetMetadataRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, resp.Diagnostics)
+	assert.ElementsMatch(t, []tfprotov5.FunctionMetadata{{Name: "kafka_duration_to_ms"}, {Name: "postgres_plan_name"}},
+		resp.Functions)
+	assert.Contains(t, resp.Resources, tfprotov5.ResourceMetadata{TypeName: "herokux_kafka_topic"})
+	assert.ElementsMatch(t, []tfprotov5.EphemeralResourceMetadata{
+		{TypeName: "herokux_connect_odata_credential"},
+		{TypeName: "herokux_oauth_authorization"},
+		{TypeName: "herokux_postgres_credential"},
+	}, resp.EphemeralResources)
+}
+
+func TestProviderServer_GetProviderSchema(t *testing.T) {
+	resp, err := newTestProviderServer(t).GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, resp.Diagnostics)
+	assert.Contains(t, resp.Functions, "kafka_duration_to_ms")
+	assert.Contains(t, resp.ResourceSchemas, "herokux_kafka_topic")
+	assert.Contains(t, resp.EphemeralResourceSchemas, "herokux_oauth_authorization")
+
+	for _, attr := range resp.ResourceSchemas["herokux_app_webhook"].Block.Attributes {
+		assert.Equal(t, attr.Name == "secret_wo", attr.WriteOnly, attr.Name)
+	}
+}
+
+func TestProviderServer_ConfigureProvider(t *testing.T) {
+	server := newTestProviderServer(t)
+
+	config := testProviderConfig(t, server)
+
+	// The deprecated URL attributes default to production, so only warnings are expected.
+	validateResp, err := server.PrepareProviderConfig(context.Background(), &tfprotov5.PrepareProviderConfigRequest{Config: config})
+	assert.Nil(t, err)
+	for _, d := range validateResp.Diagnostics {
+		assert.Equal(t, tfprotov5.DiagnosticSeverityWarning, d.Severity, d.Summary)
+	}
+
+	configureResp, err := server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{Config: config})
+	assert.Nil(t, err)
+	assert.Empty(t, configureResp.Diagnostics)
+}
+
+func TestProviderServer_CallFunction(t *testing.T) {
+	testCases := []struct {
+		name     string
+		function string
+		args     []tftypes.Value
+		expected tftypes.Value
+		err      string
+	}{
+		{
+			name:     "duration in days",
+			function: "kafka_duration_to_ms",
+			args:     []tftypes.Value{tftypes.NewValue(tftypes.String, "1d")},
+			expected: tftypes.NewValue(tftypes.Number, big.NewFloat(86400000)),
+		},
+		{
+			name:     "duration with long unit",
+			function: "kafka_duration_to_ms",
+			args:     []tftypes.Value{tftypes.NewValue(tftypes.String, "2 weeks")},
+			expected: tftypes.NewValue(tftypes.Number, big.NewFloat(1209600000)),
+		},
+		{
+			name:     "invalid duration",
+			function: "kafka_duration_to_ms",
+			args:     []tftypes.Value{tftypes.NewValue(tftypes.String, "1y")},
+			err:      "unsupported duration 1y",
+		},
+		{
+			name:     "plan without prefix",
+			function: "postgres_plan_name",
+			args:     []tftypes.Value{tftypes.NewValue(tftypes.String, " Standard-0 ")},
+			expected: tftypes.NewValue(tftypes.String, "heroku-postgresql:standard-0"),
+		},
+		{
+			name:     "plan with prefix",
+			function: "postgres_plan_name",
+			args:     []tftypes.Value{tftypes.NewValue(tftypes.String, "heroku-postgresql:premium-2")},
+			expected: tftypes.NewValue(tftypes.String, "heroku-postgresql:premium-2"),
+		},
+		{
+			name:     "empty plan",
+			function: "postgres_plan_name",
+			args:     []tftypes.Value{tftypes.NewValue(tftypes.String, "heroku-postgresql:")},
+			err:      "plan must not be empty",
+		},
+		{
+			name:     "wrong number of arguments",
+			function: "postgres_plan_name",
+			err:      "Expected function arguments: 1\nGiven function arguments: 0",
+		},
+		{
+			name:     "unknown function",
+			function: "redis_plan_name",
+			err:      "Missing function: redis_plan_name",
+		},
+	}
+
+	server := newTestProviderServer(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := make([]*tfprotov5.DynamicValue, 0, len(tc.args))
+			for _, arg := range tc.args {
+				dv, err := tfprotov5.NewDynamicValue(arg.Type(), arg)
+				assert.Nil(t, err)
+				args = append(args, &dv)
+			}
+
+			resp, err := server.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{
+				Name:      tc.function,
+				Arguments: args,
+			})
+			assert.Nil(t, err)
+
+			if tc.err != "" {
+				if assert.NotNil(t, resp.Error) {
+					assert.Contains(t, resp.Error.Text, tc.err)
+				}
+				return
+			}
+
+			assert.Nil(t, resp.Error)
+			result, unmarshalErr := resp.Result.Unmarshal(tc.expected.Type())
+			assert.Nil(t, unmarshalErr)
+			assert.True(t, tc.expected.Equal(result), "expected %s, got %s", tc.expected, result)
+		})
+	}
+}
+
+func TestProviderServer_OpenEphemeralResource(t *testing.T) {
+	server := newConfiguredTestProviderServer(t, &Config{API: &api.Client{Postgres: &mock.PostgresService{
+		GetCredentialFunc: func(ctx context.Context, nameOrID, credentialName string) (*postgres.Credential, *simpleresty.Response, error) {
+			if credentialName != "analyst" {
+				return nil, nil, &apierror.Error{StatusCode: http.StatusNotFound, Message: "Credential not found"}
+			}
+
+			var cred *postgres.Credential
+			err := json.Unmarshal([]byte(`{"uuid": "cred-uuid", "name": "analyst", "state": "active", "port": 5432,
+				"credentials": [{"user": "analyst-rotating", "password": "secret", "state": "active"}]}`), &cred)
+			return cred, nil, err
+		},
+	}}})
+
+	open := func(name string) *tfprotov5.OpenEphemeralResourceResponse {
+		resp, err := server.OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{
+			TypeName: "herokux_postgres_credential",
+			Config: testEphemeralResourceConfig(t, server, "herokux_postgres_credential", map[string]tftypes.Value{
+				"postgres_id": tftypes.NewValue(tftypes.String, "db"),
+				"name":        tftypes.NewValue(tftypes.String, name),
+			}),
+		})
+		assert.Nil(t, err)
+		return resp
+	}
+
+	t.Run("found", func(t *testing.T) {
+		resp := open("analyst")
+		assert.Empty(t, resp.Diagnostics)
+
+		schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+		assert.Nil(t, err)
+
+		result, err := resp.Result.Unmarshal(schemaResp.EphemeralResourceSchemas["herokux_postgres_credential"].ValueType())
+		assert.Nil(t, err)
+
+		var attributes map[string]tftypes.Value
+		assert.Nil(t, result.As(&attributes))
+		assert.True(t, tftypes.NewValue(tftypes.String, "cred-uuid").Equal(attributes["uuid"]))
+		assert.True(t, tftypes.NewValue(tftypes.Number, big.NewFloat(5432)).Equal(attributes["port"]))
+
+		var secrets []tftypes.Value
+		assert.Nil(t, attributes["secrets"].As(&secrets))
+		if assert.Len(t, secrets, 1) {
+			var secret map[string]tftypes.Value
+			assert.Nil(t, secrets[0].As(&secret))
+			assert.True(t, tftypes.NewValue(tftypes.String, "secret").Equal(secret["password"]))
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		resp := open("missing")
+		if assert.Len(t, resp.Diagnostics, 1) {
+			assert.Equal(t, "Unable to retrieve credential missing", resp.Diagnostics[0].Summary)
+		}
+	})
+}
+
+func TestProviderServer_ValidateEphemeralResourceConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		typeName string
+		values   map[string]tftypes.Value
+		err      string
+	}{
+		{
+			name:     "valid credential name",
+			typeName: "herokux_postgres_credential",
+			values: map[string]tftypes.Value{
+				"postgres_id": tftypes.NewValue(tftypes.String, "db"),
+				"name":        tftypes.NewValue(tftypes.String, "analyst"),
+			},
+		},
+		{
+			name:     "invalid credential name",
+			typeName: "herokux_postgres_credential",
+			values: map[string]tftypes.Value{
+				"postgres_id": tftypes.NewValue(tftypes.String, "db"),
+				"name":        tftypes.NewValue(tftypes.String, "analyst!"),
+			},
+			err: "is restricted to alphanumeric characters",
+		},
+		{
+			name:     "invalid connect ID",
+			typeName: "herokux_connect_odata_credential",
+			values: map[string]tftypes.Value{
+				"app_id":     tftypes.NewValue(tftypes.String, "8f3e1d5c-2c4b-4c0e-9a57-6f3d0f2b1a11"),
+				"connect_id": tftypes.NewValue(tftypes.String, "connect"),
+				"odata_id":   tftypes.NewValue(tftypes.String, "odata"),
+			},
+			err: "must be a UUID",
+		},
+		{
+			name:     "invalid scope",
+			typeName: "herokux_oauth_authorization",
+			values: map[string]tftypes.Value{
+				"scope": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String},
+					[]tftypes.Value{tftypes.NewValue(tftypes.String, "admin")}),
+			},
+			err: `value must be one of: ["global" "read" "write" "read-protected" "write-protected" "identity"]`,
+		},
+		{
+			name:     "invalid time to live",
+			typeName: "herokux_oauth_authorization",
+			values: map[string]tftypes.Value{
+				"scope": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String},
+					[]tftypes.Value{tftypes.NewValue(tftypes.String, "global")}),
+				"time_to_live": tftypes.NewValue(tftypes.Number, big.NewFloat(0)),
+			},
+			err: "must be at least 1",
+		},
+	}
+
+	server := newTestProviderServer(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := server.ValidateEphemeralResourceConfig(context.Background(),
+				&tfprotov5.ValidateEphemeralResourceConfigRequest{
+					TypeName: tc.typeName,
+					Config:   testEphemeralResourceConfig(t, server, tc.typeName, tc.values),
+				})
+			assert.Nil(t, err)
+
+			if tc.err == "" {
+				assert.Empty(t, resp.Diagnostics)
+				return
+			}
+
+			if assert.Len(t, resp.Diagnostics, 1) {
+				assert.Contains(t, resp.Diagnostics[0].Detail, tc.err)
+			}
+		})
+	}
+}
+
+func TestProviderServer_OauthAuthorization_OpenClose(t *testing.T) {
+	var deleted string
+	platform := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodPost:
+			w.Write([]byte(`{"id": "auth-id", "access_token": {"id": "token-id", "token": "secret", "expires_in": 3600}}`))
+		case http.MethodDelete:
+			deleted = r.URL.Path
+			w.Write([]byte(`{"id": "auth-id"}`))
+		}
+	}))
+	defer platform.Close()
+
+	platformAPI := heroku.NewService(platform.Client())
+	platformAPI.URL = platform.URL
+	server := newConfiguredTestProviderServer(t, &Config{PlatformAPI: platformAPI})
+
+	openResp, err := server.OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "herokux_oauth_authorization",
+		Config: testEphemeralResourceConfig(t, server, "herokux_oauth_authorization", map[string]tftypes.Value{
+			"scope": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String},
+				[]tftypes.Value{tftypes.NewValue(tftypes.String, "global")}),
+			"time_to_live": tftypes.NewValue(tftypes.Number, big.NewFloat(3600)),
+		}),
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, openResp.Diagnostics)
+
+	closeResp, err := server.CloseEphemeralResource(context.Background(), &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "herokux_oauth_authorization",
+		Private:  openResp.Private,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, closeResp.Diagnostics)
+	assert.Equal(t, "/oauth/authorizations/auth-id", deleted)
+}
+
+func TestProviderServer_OpenEphemeralResource_NotConfigured(t *testing.T) {
+	server := newTestProviderServer(t)
+
+	resp, err := server.OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "herokux_connect_odata_credential",
+		Config: testEphemeralResourceConfig(t, server, "herokux_connect_odata_credential", map[string]tftypes.Value{
+			"app_id":     tftypes.NewValue(tftypes.String, "8f3e1d5c-2c4b-4c0e-9a57-6f3d0f2b1a11"),
+			"connect_id": tftypes.NewValue(tftypes.String, "0b4c7d2e-5f6a-4b8c-9d0e-1f2a3b4c5d6e"),
+			"odata_id":   tftypes.NewValue(tftypes.String, "odata"),
+		}),
+	})
+	assert.Nil(t, err)
+	if assert.Len(t, resp.Diagnostics, 1) {
+		assert.Equal(t, "Provider is not configured", resp.Diagnostics[0].Summary)
+	}
+}
@@ -7,36 +7,49 @@ import (
 	"time"
 )
 
+const (
+	resourceCreateTimeout = 90 * time.Minute
+	resourceReadTimeout   = 10 * time.Minute
+	resourceUpdateTimeout = 60 * time.Minute
+	resourceDeleteTimeout = 30 * time.Minute
+)
+
 // resourceTimeouts returns predefined timeouts applicable for resources, not data sources.
 //
-// These timeouts have default values and can be overridden via their equivalent environment variable.
+// These timeouts have default values that can be overridden via their equivalent environment variable
+// or a resource's timeouts block.
 func resourceTimeouts() *schema.ResourceTimeout {
-	resourceCreateTimeout := 90
-	resourceReadTimeout := 10
-	resourceUpdateTimeout := 60
-	resourceDeleteTimeout := 30
-
-	if v, ok := os.LookupEnv("HEROKUX_RESOURCE_GLOBAL_CREATE_TIMEOUT"); ok {
-		resourceCreateTimeout, _ = strconv.Atoi(v)
-	}
+	return verifiedResourceTimeouts(resourceCreateTimeout, resourceUpdateTimeout, resourceDeleteTimeout)
+}
 
-	if v, ok := os.LookupEnv("HEROKUX_RESOURCE_GLOBAL_READ_TIMEOUT"); ok {
-		resourceReadTimeout, _ = strconv.Atoi(v)
+// verifiedResourceTimeouts returns the timeouts of a resource that waits for a create, update or delete
+// to take effect remotely, such as a Kafka topic becoming ready. Each timeout covers both the request and the wait.
+//
+// The defaults can be overridden via their equivalent environment variable or the resource's timeouts block.
+func verifiedResourceTimeouts(create, update, delete time.Duration) *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create:  schema.DefaultTimeout(envTimeout("HEROKUX_RESOURCE_GLOBAL_CREATE_TIMEOUT", create)),
+		Read:    schema.DefaultTimeout(envTimeout("HEROKUX_RESOURCE_GLOBAL_READ_TIMEOUT", resourceReadTimeout)),
+		Update:  schema.DefaultTimeout(envTimeout("HEROKUX_RESOURCE_GLOBAL_UPDATE_TIMEOUT", update)),
+		Delete:  schema.DefaultTimeout(envTimeout("HEROKUX_RESOURCE_GLOBAL_DELETE_TIMEOUT", delete)),
+		Default: schema.DefaultTimeout(45 * time.Minute),
 	}
+}
 
-	if v, ok := os.LookupEnv("HEROKUX_RESOURCE_GLOBAL_UPDATE_TIMEOUT"); ok {
-		resourceUpdateTimeout, _ = strconv.Atoi(v)
+// envTimeout returns the number of minutes set in the environment variable, or the default if not set.
+func envTimeout(key string, defaultTimeout time.Duration) time.Duration {
+	if v, ok := os.LookupEnv(key); ok {
+		if minutes, err := strconv.Atoi(v); err == nil {
+			return time.Duration(minutes) * time.Minute
+		}
 	}
 
-	if v, ok := os.LookupEnv("HEROKUX_RESOURCE_GLOBAL_DELETE_TIMEOUT"); ok {
-		resourceDeleteTimeout, _ = strconv.Atoi(v)
-	}
+	return defaultTimeout
+}
 
-	return &schema.ResourceTimeout{
-		Create:  schema.DefaultTimeout(time.Duration(resourceCreateTimeout) * time.Minute),
-		Read:    schema.DefaultTimeout(time.Duration(resourceReadTimeout) * time.Minute),
-		Update:  schema.DefaultTimeout(time.Duration(resourceUpdateTimeout) * time.Minute),
-		Delete:  schema.DefaultTimeout(time.Duration(resourceDeleteTimeout) * time.Minute),
-		Default: schema.DefaultTimeout(45 * time.Minute),
-	}
+// verifyTimeout returns how long to wait for a change to take effect remotely. This is the value in minutes
+// of the deprecated provider timeouts attribute if set. Otherwise, it is zero and the wait only ends
+// when the create, update or delete timeout of the resource elapses.
+func verifyTimeout(minutes int64) time.Duration {
+	return time.Duration(minutes) * time.Minute
 }
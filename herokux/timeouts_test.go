@@ -0,0 +1,50 @@
+package herokux
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestVerifiedResourceTimeouts(t *testing.T) {
+	timeouts := verifiedResourceTimeouts(20*time.Minute, 15*time.Minute, 10*time.Minute)
+
+	assert.Equal(t, 20*time.Minute, *timeouts.Create)
+	assert.Equal(t, 10*time.Minute, *timeouts.Read)
+	assert.Equal(t, 15*time.Minute, *timeouts.Update)
+	assert.Equal(t, 10*time.Minute, *timeouts.Delete)
+}
+
+func TestVerifiedResourceTimeouts_Env(t *testing.T) {
+	t.Setenv("HEROKUX_RESOURCE_GLOBAL_CREATE_TIMEOUT", "120")
+	t.Setenv("HEROKUX_RESOURCE_GLOBAL_DELETE_TIMEOUT", "invalid")
+
+	timeouts := verifiedResourceTimeouts(20*time.Minute, 15*time.Minute, 10*time.Minute)
+
+	assert.Equal(t, 120*time.Minute, *timeouts.Create)
+	assert.Equal(t, 15*time.Minute, *timeouts.Update)
+	assert.Equal(t, 10*time.Minute, *timeouts.Delete)
+}
+
+func TestProvider_TimeoutsDeprecated(t *testing.T) {
+	timeouts := New().Schema["timeouts"].Elem.(*schema.Resource).Schema
+
+	for name, s := range timeouts {
+		assert.NotEmpty(t, s.Deprecated, name)
+		assert.Nil(t, s.Default, name)
+	}
+}
+
+func TestConfig_DeprecatedTimeouts(t *testing.T) {
+	config := NewConfig()
+	d := schema.TestResourceDataRaw(t, New().Schema, map[string]interface{}{
+		"timeouts": []interface{}{
+			map[string]interface{}{"kafka_topic_update_verify_timeout": 15},
+		},
+	})
+
+	assert.Nil(t, config.applySchema(d))
+	assert.Equal(t, 15*time.Minute, verifyTimeout(config.KafkaTopicUpdateVerifyTimeout))
+	assert.Zero(t, verifyTimeout(config.KafkaTopicCreateVerifyTimeout))
+}
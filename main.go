@@ -1,10 +1,21 @@
 package main
 
 import (
+	"context"
 	"github.com/davidji99/terraform-provider-herokux/herokux"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"log"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{ProviderFunc: herokux.New})
+	ctx := context.Background()
+
+	serverFunc, err := herokux.NewProviderServer(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := tf5server.Serve("registry.terraform.io/davidji99/herokux", serverFunc); err != nil {
+		log.Fatal(err)
+	}
 }